@@ -0,0 +1,90 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/heavy/backup"
+)
+
+// BackupArgs is arguments that Backup service accepts.
+type BackupArgs struct {
+	Until insolar.PulseNumber
+}
+
+// BackupService is a service that provides API for backups of heavy node storage.
+type BackupService struct {
+	runner *Runner
+}
+
+// NewBackupService creates new Backup service instance.
+func NewBackupService(runner *Runner) *BackupService {
+	return &BackupService{runner: runner}
+}
+
+// Make makes an incremental backup of data stored since the previous backup up to provided pulse. It is available
+// on heavy nodes with configured backup directory only.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "backup.Make",
+//     "params": {
+//       "Until": int // pulse the backup should cover, it should be stored already
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Version": int, // version of backup format
+// 			"From": int, // range of pulses stored after the previous backup
+// 			"To": int,
+// 			"Since": int, // storage version the backup continues
+// 			"Until": int, // storage version the next backup continues
+// 			"File": str, // name of the file in backup directory
+// 			"Checksum": str // hex encoded SHA-256 of the file
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *BackupService) Make(r *http.Request, args *BackupArgs, reply *backup.Manifest) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ BACKUP ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.Backuper == nil {
+		return errors.New("[ BACKUP ] backup is available on heavy nodes with configured backup directory only")
+	}
+
+	manifest, err := s.runner.Backuper.Make(ctx, args.Until)
+	if err != nil {
+		return errors.Wrap(err, "[ BACKUP ] failed to make backup")
+	}
+
+	*reply = *manifest
+	return nil
+}
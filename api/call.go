@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/insolar/insolar/api/seedmanager"
@@ -48,6 +49,11 @@ type answer struct {
 	Error   string      `json:"error,omitempty"`
 	Result  interface{} `json:"result,omitempty"`
 	TraceID string      `json:"traceID,omitempty"`
+	// Retryable is set when the request was declined because called object is overloaded
+	// and the same request can be sent again.
+	Retryable bool `json:"retryable,omitempty"`
+	// RetryAfter is a back-off in milliseconds suggested before sending the request again.
+	RetryAfter int64 `json:"retryAfter,omitempty"`
 }
 
 // UnmarshalRequest unmarshals request to api
@@ -118,6 +124,19 @@ func processError(err error, extraMsg string, resp *answer, insLog insolar.Logge
 	insLog.Error(errors.Wrapf(err, "[ CallHandler ] %s", extraMsg))
 }
 
+// processRetryable marks answer as retryable if the request was declined because of pending requests limits
+// on ledger and sets Retry-After header with the suggested back-off rounded up to seconds.
+func processRetryable(err error, resp *answer, response http.ResponseWriter) {
+	retryAfter, ok := insolar.RetryAfter(err)
+	if !ok {
+		return
+	}
+	resp.Retryable = true
+	resp.RetryAfter = int64(retryAfter / time.Millisecond)
+	seconds := int64((retryAfter + time.Second - 1) / time.Second)
+	response.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+}
+
 func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 	return func(response http.ResponseWriter, req *http.Request) {
 		traceID := utils.RandTraceID()
@@ -186,6 +205,7 @@ func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 		case <-ch:
 			if err != nil {
 				processError(err, "Can't makeCall", &resp, insLog)
+				processRetryable(err, &resp, response)
 				return
 			}
 			resp.Result = result
@@ -31,6 +31,7 @@ import (
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -43,6 +44,8 @@ type TimeoutSuite struct {
 	api   *Runner
 	user  *requester.UserConfigJSON
 	delay bool
+
+	overloaded bool
 }
 
 type APIresp struct {
@@ -93,6 +96,35 @@ func (suite *TimeoutSuite) TestRunner_callHandlerTimeout() {
 	suite.Equal("", result.Result)
 }
 
+func (suite *TimeoutSuite) TestRunner_callHandlerOverloaded() {
+	seed, err := suite.api.SeedGenerator.Next()
+	suite.NoError(err)
+	suite.api.SeedManager.Add(*seed)
+
+	suite.overloaded = true
+	defer func() { suite.overloaded = false }()
+
+	resp, err := requester.SendWithSeed(
+		suite.ctx,
+		CallUrl,
+		suite.user,
+		&requester.RequestConfigJSON{},
+		seed[:],
+	)
+	suite.NoError(err)
+
+	var result struct {
+		Error      string
+		Retryable  bool
+		RetryAfter int64
+	}
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	suite.Contains(result.Error, insolar.ErrTooManyPendingRequests.Error())
+	suite.True(result.Retryable)
+	suite.Equal(int64(1500), result.RetryAfter)
+}
+
 func TestTimeoutSuite(t *testing.T) {
 	timeoutSuite := new(TimeoutSuite)
 	timeoutSuite.ctx, _ = inslogger.WithTraceField(context.Background(), "APItests")
@@ -138,6 +170,10 @@ func TestTimeoutSuite(t *testing.T) {
 				Result: data,
 			}, nil
 		default:
+			if timeoutSuite.overloaded {
+				overloaded := &insolar.ObjectOverloadedError{Object: testutils.RandomRef(), RetryAfter: 1500 * time.Millisecond}
+				return nil, errors.Wrap(overloaded, "request is declined")
+			}
 			if timeoutSuite.delay {
 				time.Sleep(time.Second * 21)
 			}
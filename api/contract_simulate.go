@@ -0,0 +1,236 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/application/extractor"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// SimulateArgs is arguments that Contract.Simulate accepts.
+type SimulateArgs struct {
+	CallerRefString string
+	ObjectRefString string
+	Method          string
+	MethodArgs      []interface{}
+}
+
+// SimulatedCall is an outgoing call made by simulated method.
+type SimulatedCall struct {
+	CallType  string
+	Object    string
+	Prototype string
+	Method    string
+	Arguments interface{}
+	Wait      bool
+	Result    interface{}
+	Calls     []SimulatedCall
+}
+
+// StateChange is a change of one field of object state.
+type StateChange struct {
+	Field  string
+	Before interface{}
+	After  interface{}
+}
+
+// SimulateReply is reply that Contract.Simulate returns
+type SimulateReply struct {
+	ExtractedReply interface{}
+	ContractError  string
+	Calls          []SimulatedCall
+	StateDiff      []StateChange
+	Deactivated    bool
+}
+
+// Simulate executes method against current state of object without registering request,
+// updating object or saving result, and returns the would-be result, outgoing calls and
+// changes of object state.
+func (s *ContractService) Simulate(r *http.Request, args *SimulateArgs, re *SimulateReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ContractService.Simulate ] Incoming request: %s", r.RequestURI)
+
+	if len(args.ObjectRefString) == 0 {
+		return errors.New("params.ObjectRefString is missing")
+	}
+	if len(args.Method) == 0 {
+		return errors.New("params.Method is missing")
+	}
+
+	objectRef, err := insolar.NewReferenceFromBase58(args.ObjectRefString)
+	if err != nil {
+		return errors.Wrap(err, "can't get objectRef")
+	}
+
+	caller := insolar.Reference{}
+	if len(args.CallerRefString) != 0 {
+		callerRef, err := insolar.NewReferenceFromBase58(args.CallerRefString)
+		if err != nil {
+			return errors.Wrap(err, "can't get callerRef")
+		}
+		caller = *callerRef
+	}
+
+	argsSerialized, err := insolar.Serialize(args.MethodArgs)
+	if err != nil {
+		return errors.Wrap(err, "can't serialize arguments")
+	}
+
+	msg := &message.SimulateCall{
+		Request: record.Request{
+			Caller:    caller,
+			Object:    objectRef,
+			Method:    args.Method,
+			Arguments: argsSerialized,
+		},
+	}
+
+	res, err := s.runner.ContractRequester.Simulate(ctx, msg)
+	if err != nil {
+		return errors.Wrap(err, "Simulate failed with error")
+	}
+
+	return fillSimulateReply(res.(*reply.SimulateCall), re)
+}
+
+func fillSimulateReply(sim *reply.SimulateCall, re *SimulateReply) error {
+	extracted, contractErr, err := extractor.CallResponse(sim.Result)
+	if err != nil {
+		// method doesn't follow (result, error) convention, all results are returned as is
+		re.ExtractedReply = decodeValue(sim.Result)
+	} else {
+		re.ExtractedReply = jsonValue(extracted)
+	}
+	if contractErr != nil {
+		re.ContractError = contractErr.S
+	}
+
+	re.Calls = simulatedCalls(sim.Calls)
+	re.Deactivated = sim.Deactivated
+	re.StateDiff, err = stateDiff(sim.Memory, sim.NewMemory)
+	if err != nil {
+		return errors.Wrap(err, "Can't calculate state diff")
+	}
+	return nil
+}
+
+func simulatedCalls(calls []reply.SimulatedCall) []SimulatedCall {
+	res := make([]SimulatedCall, 0, len(calls))
+	for _, c := range calls {
+		call := SimulatedCall{
+			CallType:  c.CallType.String(),
+			Object:    c.Object.String(),
+			Prototype: c.Prototype.String(),
+			Method:    c.Method,
+			Arguments: decodeValue(c.Arguments),
+			Wait:      c.Wait,
+			Calls:     simulatedCalls(c.Calls),
+		}
+		if c.Wait {
+			call.Result = decodeValue(c.Result)
+		}
+		res = append(res, call)
+	}
+	return res
+}
+
+// stateDiff compares states of object field by field, state that isn't a struct is
+// compared as a whole and reported as change of field with empty name
+func stateDiff(before, after []byte) ([]StateChange, error) {
+	var oldState, newState interface{}
+	if len(before) > 0 {
+		if err := insolar.Deserialize(before, &oldState); err != nil {
+			return nil, errors.Wrap(err, "can't deserialize state before call")
+		}
+	}
+	if len(after) > 0 {
+		if err := insolar.Deserialize(after, &newState); err != nil {
+			return nil, errors.Wrap(err, "can't deserialize state after call")
+		}
+	}
+	oldState, newState = jsonValue(oldState), jsonValue(newState)
+
+	oldFields, oldOk := oldState.(map[string]interface{})
+	newFields, newOk := newState.(map[string]interface{})
+	if !oldOk || !newOk {
+		if reflect.DeepEqual(oldState, newState) {
+			return []StateChange{}, nil
+		}
+		return []StateChange{{Before: oldState, After: newState}}, nil
+	}
+
+	names := make([]string, 0, len(oldFields)+len(newFields))
+	for name := range oldFields {
+		names = append(names, name)
+	}
+	for name := range newFields {
+		if _, ok := oldFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	diff := []StateChange{}
+	for _, name := range names {
+		if !reflect.DeepEqual(oldFields[name], newFields[name]) {
+			diff = append(diff, StateChange{Field: name, Before: oldFields[name], After: newFields[name]})
+		}
+	}
+	return diff, nil
+}
+
+func decodeValue(data []byte) interface{} {
+	var v interface{}
+	if err := insolar.Deserialize(data, &v); err != nil {
+		return data
+	}
+	return jsonValue(v)
+}
+
+// jsonValue converts values decoded from CBOR to ones that can be marshaled to JSON
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			res[fmt.Sprint(key)] = jsonValue(value)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, value := range v {
+			res[i] = jsonValue(value)
+		}
+		return res
+	default:
+		return v
+	}
+}
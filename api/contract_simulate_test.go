@@ -0,0 +1,99 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/testutils"
+)
+
+type simulatedState struct {
+	Name    string
+	Counter int
+	Tags    []string
+}
+
+func TestStateDiff(t *testing.T) {
+	before, err := insolar.Serialize(simulatedState{Name: "a", Counter: 1, Tags: []string{"x"}})
+	require.NoError(t, err)
+	after, err := insolar.Serialize(simulatedState{Name: "a", Counter: 2, Tags: []string{"x", "y"}})
+	require.NoError(t, err)
+
+	diff, err := stateDiff(before, after)
+	require.NoError(t, err)
+	require.Len(t, diff, 2)
+	assert.Equal(t, "Counter", diff[0].Field)
+	assert.EqualValues(t, 1, diff[0].Before)
+	assert.EqualValues(t, 2, diff[0].After)
+	assert.Equal(t, "Tags", diff[1].Field)
+	assert.Equal(t, []interface{}{"x"}, diff[1].Before)
+	assert.Equal(t, []interface{}{"x", "y"}, diff[1].After)
+
+	diff, err = stateDiff(before, before)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+func TestStateDiff_NotStruct(t *testing.T) {
+	before, err := insolar.Serialize("a")
+	require.NoError(t, err)
+	after, err := insolar.Serialize("b")
+	require.NoError(t, err)
+
+	diff, err := stateDiff(before, after)
+	require.NoError(t, err)
+	require.Len(t, diff, 1)
+	assert.Equal(t, StateChange{Before: "a", After: "b"}, diff[0])
+}
+
+func TestFillSimulateReply(t *testing.T) {
+	result, err := insolar.Serialize([]interface{}{"done", nil})
+	require.NoError(t, err)
+	args, err := insolar.Serialize([]interface{}{"arg"})
+	require.NoError(t, err)
+	callResult, err := insolar.Serialize([]interface{}{42, nil})
+	require.NoError(t, err)
+
+	object := testutils.RandomRef()
+	sim := &reply.SimulateCall{
+		Result: result,
+		Calls: []reply.SimulatedCall{
+			{CallType: record.CTMethod, Object: object, Method: "Get", Arguments: args, Wait: true, Result: callResult},
+			{CallType: record.CTSaveAsChild, Object: object, Method: "New", Arguments: args},
+		},
+	}
+
+	re := &SimulateReply{}
+	require.NoError(t, fillSimulateReply(sim, re))
+	assert.Equal(t, "done", re.ExtractedReply)
+	assert.Empty(t, re.ContractError)
+	require.Len(t, re.Calls, 2)
+	assert.Equal(t, "CTMethod", re.Calls[0].CallType)
+	assert.Equal(t, object.String(), re.Calls[0].Object)
+	assert.Equal(t, []interface{}{"arg"}, re.Calls[0].Arguments)
+	assert.EqualValues(t, []interface{}{uint64(42), nil}, re.Calls[0].Result)
+	assert.Equal(t, "CTSaveAsChild", re.Calls[1].CallType)
+	assert.Nil(t, re.Calls[1].Result)
+	assert.Empty(t, re.StateDiff)
+}
@@ -0,0 +1,160 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+)
+
+// ExporterArgs is arguments that Exporter service accepts.
+type ExporterArgs struct {
+	From insolar.PulseNumber
+	Size int
+}
+
+// ExporterService is a service that provides API for exporting finalized ledger data.
+type ExporterService struct {
+	runner *Runner
+}
+
+// NewExporterService creates new Exporter service instance.
+func NewExporterService(runner *Runner) *ExporterService {
+	return &ExporterService{runner: runner}
+}
+
+// Export returns finalized pulses with their drops, records, blobs and changes of indexes. It is available
+// on heavy nodes only.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "exporter.Export",
+//     "params": {
+//       "From": int, // pulse to start export from, 0 means the genesis pulse
+//       "Size": int // max number of pulses to export
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Version": int, // version of export format
+// 			"Pulses": [
+// 				{
+// 					"PulseNumber": int,
+// 					"Timestamp": int, // pulse start in nanoseconds since Unix epoch
+// 					"Drops": [
+// 						{
+// 							"JetID": str,
+// 							"Hash": str, // base64 encoded
+// 							"PrevHash": str, // base64 encoded
+// 							"Records": [{"ID": str, "Type": str, "Payload": str}], // payload is base64 encoded protobuf
+// 							"Blobs": [{"ID": str, "Value": str}], // value is base64 encoded
+// 							"Indexes": [{"ObjectID": str, "Parent": str, "LatestState": str, ...}]
+// 						}
+// 					]
+// 				}
+// 			],
+// 			"NextFrom": int // pulse to continue export from
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *ExporterService) Export(r *http.Request, args *ExporterArgs, reply *exporter.Result) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ EXPORT ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.Exporter == nil {
+		return errors.New("[ EXPORT ] export is available on heavy nodes only")
+	}
+
+	result, err := s.runner.Exporter.Export(ctx, args.From, args.Size)
+	if err != nil {
+		return errors.Wrap(err, "[ EXPORT ] failed to export data")
+	}
+
+	*reply = *result
+	return nil
+}
+
+// ProofArgs is arguments that Proof method of Exporter service accepts.
+type ProofArgs struct {
+	RecordID string
+}
+
+// Proof returns a proof of inclusion of a record to its drop with the pulse signed by pulsars. It is available
+// on heavy nodes only. The proof can be checked by exporter.VerifyProof against a trusted hash of the drop, e.g.
+// the hash exported by several heavy nodes, because drop hashes aren't signed.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "exporter.Proof",
+//     "params": {
+//       "RecordID": str // base58 encoded record ID
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Version": int, // version of export format
+// 			"Record": {"ID": str, "Type": str, "Payload": str}, // payload is base64 encoded protobuf
+// 			"JetID": str,
+// 			"DropHash": str, // base64 encoded
+// 			"PrevHash": str, // base64 encoded
+// 			"Path": [{"Hash": str, "Left": bool}], // path from the record to Merkle root of the drop
+// 			"Pulse": {"PulseNumber": int, "Entropy": [int], "Signs": {...}, ...}
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *ExporterService) Proof(r *http.Request, args *ProofArgs, reply *exporter.Proof) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ PROOF ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.Exporter == nil {
+		return errors.New("[ PROOF ] proofs are available on heavy nodes only")
+	}
+
+	id, err := insolar.NewIDFromBase58(args.RecordID)
+	if err != nil {
+		return errors.Wrap(err, "[ PROOF ] failed to parse record ID")
+	}
+
+	proof, err := s.runner.Exporter.Proof(ctx, *id)
+	if err != nil {
+		return errors.Wrap(err, "[ PROOF ] failed to build proof")
+	}
+
+	*reply = *proof
+	return nil
+}
@@ -39,6 +39,8 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/heavy/backup"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
 	"github.com/insolar/insolar/logicrunner/artifacts"
 	"github.com/insolar/insolar/platformpolicy"
 )
@@ -60,6 +62,12 @@ type Runner struct {
 	cacheLock           *sync.RWMutex
 	SeedManager         *seedmanager.SeedManager
 	SeedGenerator       seedmanager.SeedGenerator
+	// CallTracer is set on virtual nodes only
+	CallTracer insolar.CallTracer
+	// Exporter is set on heavy nodes only
+	Exporter exporter.Exporter
+	// Backuper is set on heavy nodes with configured backup directory only
+	Backuper *backup.Backuper
 }
 
 func checkConfig(cfg *configuration.APIRunner) error {
@@ -108,6 +116,21 @@ func (ar *Runner) registerServices(rpcServer *rpc.Server) error {
 		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: contract")
 	}
 
+	err = rpcServer.RegisterService(NewTraceService(ar), "trace")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: trace")
+	}
+
+	err = rpcServer.RegisterService(NewExporterService(ar), "exporter")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: exporter")
+	}
+
+	err = rpcServer.RegisterService(NewBackupService(ar), "backup")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: backup")
+	}
+
 	return nil
 }
 
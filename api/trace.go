@@ -0,0 +1,168 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// TraceArgs is arguments that Trace service accepts.
+type TraceArgs struct {
+	TraceID string
+}
+
+// CallNode is a contract call with nested calls it made.
+type CallNode struct {
+	Request   string
+	Parent    string
+	Caller    string
+	Callee    string
+	Prototype string
+	Method    string
+	Pulse     insolar.PulseNumber
+	Node      string
+	Start     time.Time
+	Duration  string
+	Outcome   string
+	Error     string `json:",omitempty"`
+	Calls     []*CallNode
+}
+
+// TraceReply is reply for Trace service requests.
+type TraceReply struct {
+	Calls []*CallNode
+}
+
+// TraceService is a service that provides API for getting call trees of requests.
+type TraceService struct {
+	runner *Runner
+}
+
+// NewTraceService creates new Trace service instance.
+func NewTraceService(runner *Runner) *TraceService {
+	return &TraceService{runner: runner}
+}
+
+// Get returns call tree of contract calls made in scope of trace, i.e. of a request sent
+// to /call that returned provided traceID.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "trace.Get",
+//     "params": {
+//       "TraceID": str // traceID of request
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Calls": [ // top-level calls
+// 				{
+// 					"Request": str, // reference to request
+// 					"Parent": str, // reference to request in scope of which the call was made
+// 					"Caller": str, // reference to caller
+// 					"Callee": str, // reference to called object
+// 					"Prototype": str, // reference to prototype of called object
+// 					"Method": str, // called method or constructor
+// 					"Pulse": int, // pulse of execution
+// 					"Node": str, // reference to node that executed the call
+// 					"Start": str, // time of execution start
+// 					"Duration": str, // duration of execution
+// 					"Outcome": str, // "success", "contract error" or "system error"
+// 					"Error": str, // error of the call if any
+// 					"Calls": [...] // nested calls
+// 				}
+// 			]
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *TraceService) Get(r *http.Request, args *TraceArgs, reply *TraceReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ TRACE ] Incoming request: %s", r.RequestURI)
+
+	if len(args.TraceID) == 0 {
+		return errors.New("params.TraceID is missing")
+	}
+	if s.runner.CallTracer == nil {
+		return errors.New("[ TRACE ] call tracing is not available on this node")
+	}
+
+	records, err := s.runner.CallTracer.CallTree(ctx, args.TraceID)
+	if err != nil {
+		return errors.Wrap(err, "[ TRACE ] failed to get call tree")
+	}
+
+	reply.Calls = buildCallTree(records)
+	return nil
+}
+
+// buildCallTree links calls into trees. Parent of a call is the call of the request in scope of which it was made,
+// calls with unknown parent are top-level. Calls are ordered by start time only for presentation.
+func buildCallTree(records []insolar.CallRecord) []*CallNode {
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Start.Before(records[j].Start)
+	})
+
+	byRequest := make(map[insolar.Reference]*CallNode, len(records))
+	nodes := make([]*CallNode, len(records))
+	for i, rec := range records {
+		nodes[i] = &CallNode{
+			Request:   rec.Request.String(),
+			Parent:    rec.Parent.String(),
+			Caller:    rec.Caller.String(),
+			Callee:    rec.Callee.String(),
+			Prototype: rec.Prototype.String(),
+			Method:    rec.Method,
+			Pulse:     rec.Pulse,
+			Node:      rec.Node.String(),
+			Start:     rec.Start,
+			Duration:  rec.Duration.String(),
+			Outcome:   rec.Outcome,
+			Error:     rec.Error,
+			Calls:     []*CallNode{},
+		}
+		if !rec.Request.IsEmpty() {
+			byRequest[rec.Request] = nodes[i]
+		}
+	}
+
+	roots := []*CallNode{}
+	for i, rec := range records {
+		parent, ok := byRequest[rec.Parent]
+		if rec.Parent.IsEmpty() || !ok || parent == nodes[i] {
+			roots = append(roots, nodes[i])
+			continue
+		}
+		parent.Calls = append(parent.Calls, nodes[i])
+	}
+	return roots
+}
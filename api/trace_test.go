@@ -0,0 +1,99 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestBuildCallTree(t *testing.T) {
+	member, wallet, other := testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef()
+	transfer, greet := testutils.RandomRef(), testutils.RandomRef()
+	start := time.Now()
+
+	records := []insolar.CallRecord{
+		// clock of the node that executed Accept is behind
+		{Request: testutils.RandomRef(), Parent: transfer, Caller: wallet, Callee: other, Method: "Accept",
+			Start: start.Add(-time.Second), Duration: time.Millisecond},
+		{Request: transfer, Caller: member, Callee: wallet, Method: "Transfer", Start: start, Duration: 5 * time.Millisecond},
+		{Request: testutils.RandomRef(), Parent: transfer, Caller: wallet, Callee: other, Method: "GetBalance",
+			Start: start.Add(time.Millisecond), Duration: time.Millisecond},
+		{Request: greet, Caller: member, Callee: wallet, Method: "Greet", Start: start.Add(2 * time.Millisecond),
+			Duration: time.Millisecond},
+		// concurrent call to the same object made in scope of other request
+		{Request: testutils.RandomRef(), Parent: greet, Caller: wallet, Callee: other, Method: "Hello",
+			Start: start.Add(2 * time.Millisecond), Duration: time.Millisecond},
+	}
+
+	roots := buildCallTree(records)
+	require.Len(t, roots, 2)
+	assert.Equal(t, "Transfer", roots[0].Method)
+	assert.Equal(t, "Greet", roots[1].Method)
+
+	require.Len(t, roots[0].Calls, 2)
+	assert.Equal(t, "Accept", roots[0].Calls[0].Method)
+	assert.Equal(t, "GetBalance", roots[0].Calls[1].Method)
+	assert.Equal(t, transfer.String(), roots[0].Calls[1].Parent)
+	assert.Equal(t, other.String(), roots[0].Calls[1].Callee)
+
+	require.Len(t, roots[1].Calls, 1)
+	assert.Equal(t, "Hello", roots[1].Calls[0].Method)
+}
+
+func TestBuildCallTree_UnknownParent(t *testing.T) {
+	records := []insolar.CallRecord{
+		{Request: testutils.RandomRef(), Parent: testutils.RandomRef(), Method: "Orphan"},
+	}
+
+	roots := buildCallTree(records)
+	require.Len(t, roots, 1)
+	assert.Equal(t, "Orphan", roots[0].Method)
+}
+
+func TestTraceService_Get(t *testing.T) {
+	r := &http.Request{}
+
+	s := NewTraceService(&Runner{})
+	err := s.Get(r, &TraceArgs{TraceID: "trace"}, &TraceReply{})
+	require.Error(t, err)
+
+	tracer := testutils.NewCallTracerMock(t)
+	tracer.CallTreeFunc = func(ctx context.Context, traceID string) ([]insolar.CallRecord, error) {
+		assert.Equal(t, "trace", traceID)
+		return []insolar.CallRecord{{Method: "Call", Outcome: insolar.CallOutcomeSuccess}}, nil
+	}
+	s = NewTraceService(&Runner{CallTracer: tracer})
+
+	err = s.Get(r, &TraceArgs{}, &TraceReply{})
+	require.Error(t, err)
+
+	reply := &TraceReply{}
+	err = s.Get(r, &TraceArgs{TraceID: "trace"}, reply)
+	require.NoError(t, err)
+	require.Len(t, reply.Calls, 1)
+	assert.Equal(t, "Call", reply.Calls[0].Method)
+	assert.Equal(t, insolar.CallOutcomeSuccess, reply.Calls[0].Outcome)
+}
@@ -191,6 +191,11 @@ func (cert *Certificate) GetRootDomainReference() *insolar.Reference {
 	return ref
 }
 
+// GetPulsarPublicKeys returns public keys of trusted pulsars
+func (cert *Certificate) GetPulsarPublicKeys() []crypto.PublicKey {
+	return cert.pulsarPublicKey
+}
+
 // GetDiscoveryNodes return bootstrap nodes array
 func (cert *Certificate) GetDiscoveryNodes() []insolar.DiscoveryNode {
 	result := make([]insolar.DiscoveryNode, 0)
@@ -45,6 +45,7 @@ func main() {
 	metricsAddress := pflag.String("metrics", "", "address and port of prometheus metrics")
 	code := pflag.String("code", "", "add pre-compiled code to cache (<ref>:</path/to/plugin.so>)")
 	logLevel := pflag.String("log-level", "debug", "log level")
+	pluginsLimit := pflag.Int("plugins-limit", 0, "number of plugins kept in cache, 0 is unlimited")
 
 	pflag.Parse()
 
@@ -65,6 +66,7 @@ func main() {
 	}
 
 	insider := ginsider.NewGoInsider(*path, *rpcProtocol, *rpcAddress)
+	insider.PluginsLimit = *pluginsLimit
 
 	if *code != "" {
 		codeSlice := strings.Split(*code, ":")
@@ -0,0 +1,309 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/migration"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// ledgerCommand returns a group of commands for inspection of data directory of ledger node. Data directory is opened
+// in read-only mode, so a copy of data directory of a live node can be inspected.
+func ledgerCommand() *cobra.Command {
+	var (
+		dataDir    string
+		jsonOutput bool
+	)
+	var ledgerCmd = &cobra.Command{
+		Use:   "ledger",
+		Short: "inspects data directory of ledger node",
+	}
+	ledgerCmd.PersistentFlags().StringVarP(
+		&dataDir, "data-dir", "d", "", "path to data directory (or its copy) of stopped node")
+	ledgerCmd.PersistentFlags().BoolVarP(
+		&jsonOutput, "json", "j", false, "print output as JSON")
+	_ = ledgerCmd.MarkPersistentFlagRequired("data-dir")
+
+	inspect := func(fn func(i *inspector) error) {
+		ctx := context.Background()
+		db, err := store.NewReadOnlyBadgerDB(dataDir)
+		checkError("Failed to open storage", err)
+		checkError("Unsupported storage", migration.Default.CheckMigrated(db))
+
+		err = fn(&inspector{ctx: ctx, db: db, json: jsonOutput, out: os.Stdout})
+		stopErr := db.Stop(ctx)
+		checkError("Failed to inspect ledger", err)
+		checkError("Failed to close storage", stopErr)
+	}
+
+	var (
+		from  uint32
+		limit int
+	)
+	var pulsesCmd = &cobra.Command{
+		Use:   "pulses",
+		Short: "lists stored pulses",
+		Run: func(cmd *cobra.Command, args []string) {
+			inspect(func(i *inspector) error {
+				return i.pulses(insolar.PulseNumber(from), limit)
+			})
+		},
+	}
+	pulsesCmd.Flags().Uint32VarP(
+		&from, "from", "f", 0, "pulse to start from (default genesis pulse)")
+	pulsesCmd.Flags().IntVarP(
+		&limit, "limit", "l", 100, "max number of pulses to list")
+	ledgerCmd.AddCommand(pulsesCmd)
+
+	var dropsPulse uint32
+	var dropsCmd = &cobra.Command{
+		Use:   "drops",
+		Short: "lists jets and their drops for pulse",
+		Run: func(cmd *cobra.Command, args []string) {
+			inspect(func(i *inspector) error {
+				return i.drops(insolar.PulseNumber(dropsPulse))
+			})
+		},
+	}
+	dropsCmd.Flags().Uint32VarP(
+		&dropsPulse, "pulse", "p", 0, "pulse of drops")
+	_ = dropsCmd.MarkFlagRequired("pulse")
+	ledgerCmd.AddCommand(dropsCmd)
+
+	var recordCmd = &cobra.Command{
+		Use:   "record <id>",
+		Short: "prints record",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := insolar.NewIDFromBase58(args[0])
+			checkError("Failed to parse record ID", err)
+			inspect(func(i *inspector) error {
+				return i.record(*id)
+			})
+		},
+	}
+	ledgerCmd.AddCommand(recordCmd)
+
+	var lifelinePulse uint32
+	var lifelineCmd = &cobra.Command{
+		Use:   "lifeline <object id>",
+		Short: "prints lifeline and index bucket of object",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := insolar.NewIDFromBase58(args[0])
+			checkError("Failed to parse object ID", err)
+			inspect(func(i *inspector) error {
+				return i.lifeline(*id, insolar.PulseNumber(lifelinePulse))
+			})
+		},
+	}
+	lifelineCmd.Flags().Uint32VarP(
+		&lifelinePulse, "pulse", "p", 0, "pulse of index bucket (default last known pulse of object)")
+	ledgerCmd.AddCommand(lifelineCmd)
+
+	var blobCmd = &cobra.Command{
+		Use:   "blob <id>",
+		Short: "dumps blob",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := insolar.NewIDFromBase58(args[0])
+			checkError("Failed to parse blob ID", err)
+			inspect(func(i *inspector) error {
+				return i.blob(*id)
+			})
+		},
+	}
+	ledgerCmd.AddCommand(blobCmd)
+
+	return ledgerCmd
+}
+
+// inspector prints ledger data either as JSON or as text.
+type inspector struct {
+	ctx  context.Context
+	db   store.DB
+	json bool
+	out  io.Writer
+}
+
+// print prints v as JSON or calls text to print it as text.
+func (i *inspector) print(v interface{}, text func(w io.Writer)) error {
+	if i.json {
+		return i.printJSON(v)
+	}
+
+	w := tabwriter.NewWriter(i.out, 0, 8, 2, ' ', 0)
+	text(w)
+	return w.Flush()
+}
+
+func (i *inspector) printJSON(v interface{}) error {
+	enc := json.NewEncoder(i.out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+type pulseView struct {
+	PulseNumber     insolar.PulseNumber
+	PrevPulseNumber insolar.PulseNumber
+	NextPulseNumber insolar.PulseNumber
+	Timestamp       time.Time
+}
+
+func (i *inspector) pulses(from insolar.PulseNumber, limit int) error {
+	if from == 0 {
+		from = insolar.GenesisPulse.PulseNumber
+	}
+
+	pulses := pulse.NewDB(i.db)
+	views := []pulseView{}
+	p, err := pulses.ForPulseNumber(i.ctx, from)
+	for err == nil && len(views) < limit {
+		views = append(views, pulseView{
+			PulseNumber:     p.PulseNumber,
+			PrevPulseNumber: p.PrevPulseNumber,
+			NextPulseNumber: p.NextPulseNumber,
+			Timestamp:       time.Unix(0, p.PulseTimestamp).UTC(),
+		})
+		p, err = pulses.Forwards(i.ctx, p.PulseNumber, 1)
+	}
+	if err != nil && err != pulse.ErrNotFound {
+		return errors.Wrap(err, "failed to fetch pulse")
+	}
+
+	return i.print(views, func(w io.Writer) {
+		fmt.Fprintln(w, "PULSE\tPREV\tNEXT\tTIMESTAMP")
+		for _, v := range views {
+			fmt.Fprintf(w, "%d\t%d\t%d\t%s\n", v.PulseNumber, v.PrevPulseNumber, v.NextPulseNumber, v.Timestamp)
+		}
+	})
+}
+
+type dropView struct {
+	JetID    string
+	Jet      string
+	Hash     []byte
+	PrevHash []byte
+	Size     uint64
+	Split    bool
+	Load     drop.Load
+}
+
+func (i *inspector) drops(pn insolar.PulseNumber) error {
+	drops, err := drop.NewDB(i.db).All(i.ctx, pn)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch drops")
+	}
+
+	views := make([]dropView, 0, len(drops))
+	for _, d := range drops {
+		jetID := insolar.ID(d.JetID)
+		views = append(views, dropView{
+			JetID:    jetID.String(),
+			Jet:      d.JetID.DebugString(),
+			Hash:     d.Hash,
+			PrevHash: d.PrevHash,
+			Size:     d.Size,
+			Split:    d.Split,
+			Load:     d.Load,
+		})
+	}
+
+	return i.print(views, func(w io.Writer) {
+		fmt.Fprintln(w, "JET\tJET ID\tHASH\tPREV HASH\tSIZE\tSPLIT\tLOAD (SIZE/RECORDS/REQUESTS)")
+		for _, v := range views {
+			fmt.Fprintf(
+				w, "%s\t%s\t%x\t%x\t%d\t%t\t%d/%d/%d\n",
+				v.Jet, v.JetID, v.Hash, v.PrevHash, v.Size, v.Split,
+				v.Load.Size, v.Load.Records, v.Load.Requests,
+			)
+		}
+	})
+}
+
+type recordView struct {
+	ID        string
+	JetID     string
+	Jet       string
+	Virtual   *record.Virtual
+	Signature []byte
+}
+
+func (i *inspector) record(id insolar.ID) error {
+	rec, err := object.NewRecordDB(i.db).ForID(i.ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch record")
+	}
+
+	jetID := insolar.ID(rec.JetID)
+	// Record has no compact text form, so it's always printed as JSON.
+	return i.printJSON(recordView{
+		ID:        id.String(),
+		JetID:     jetID.String(),
+		Jet:       rec.JetID.DebugString(),
+		Virtual:   rec.Virtual,
+		Signature: rec.Signature,
+	})
+}
+
+func (i *inspector) lifeline(id insolar.ID, pn insolar.PulseNumber) error {
+	bucket, err := object.NewIndexDB(i.db).BucketForID(i.ctx, pn, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch index bucket")
+	}
+
+	// Bucket has no compact text form, so it's always printed as JSON.
+	return i.printJSON(&bucket)
+}
+
+type blobView struct {
+	ID    string
+	JetID string
+	Value []byte
+}
+
+func (i *inspector) blob(id insolar.ID) error {
+	b, err := blob.NewDB(i.db).ForID(i.ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch blob")
+	}
+
+	jetID := insolar.ID(b.JetID)
+	view := blobView{ID: id.String(), JetID: jetID.String(), Value: b.Value}
+	return i.print(view, func(w io.Writer) {
+		fmt.Fprintf(w, "ID:\t%s\nJET:\t%s\nSIZE:\t%d\n\n", view.ID, b.JetID.DebugString(), len(view.Value))
+		fmt.Fprint(w, hex.Dump(view.Value))
+	})
+}
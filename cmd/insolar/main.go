@@ -143,6 +143,42 @@ func main() {
 		&certFile, "node-cert", "c", "cert.json", "The OUT file the node certificate")
 	rootCmd.AddCommand(certgenCmd)
 
+	var dataDir string
+	var verifyLedgerCmd = &cobra.Command{
+		Use:   "verify-ledger",
+		Short: "verifies drops and indexes stored by stopped heavy node",
+		Run: func(cmd *cobra.Command, args []string) {
+			verifyLedger(dataDir)
+		},
+	}
+	verifyLedgerCmd.Flags().StringVarP(
+		&dataDir, "data-dir", "d", "", "path to data directory of heavy node")
+	_ = verifyLedgerCmd.MarkFlagRequired("data-dir")
+	rootCmd.AddCommand(verifyLedgerCmd)
+	rootCmd.AddCommand(ledgerCommand())
+
+	var (
+		restoreDataDir string
+		backupDir      string
+		restorePulse   uint32
+	)
+	var restoreCmd = &cobra.Command{
+		Use:   "restore",
+		Short: "restores data directory of heavy node from backups",
+		Run: func(cmd *cobra.Command, args []string) {
+			restoreLedger(restoreDataDir, backupDir, insolar.PulseNumber(restorePulse))
+		},
+	}
+	restoreCmd.Flags().StringVarP(
+		&restoreDataDir, "data-dir", "d", "", "path to empty data directory of heavy node")
+	restoreCmd.Flags().StringVarP(
+		&backupDir, "backup-dir", "b", "", "path to directory with backups")
+	restoreCmd.Flags().Uint32VarP(
+		&restorePulse, "pulse", "p", 0, "pulse to restore up to (default all backups)")
+	_ = restoreCmd.MarkFlagRequired("data-dir")
+	_ = restoreCmd.MarkFlagRequired("backup-dir")
+	rootCmd.AddCommand(restoreCmd)
+
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -0,0 +1,52 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/heavy/backup"
+)
+
+// restoreLedger rebuilds data directory of heavy node from backups up to provided pulse. Zero pulse means all
+// backups. Data directory should be empty, heavy node continues from the last restored pulse on start.
+func restoreLedger(dataDir, backupDir string, until insolar.PulseNumber) {
+	files, err := ioutil.ReadDir(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		checkError("Failed to read data directory", err)
+	}
+	if len(files) > 0 {
+		fmt.Fprintln(os.Stderr, "Data directory should be empty")
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	db, err := store.NewBadgerDB(dataDir)
+	checkError("Failed to open storage", err)
+
+	latest, err := backup.Restore(ctx, db, backupDir, until)
+	stopErr := db.Stop(ctx)
+	checkError("Failed to restore ledger", err)
+	checkError("Failed to close storage", stopErr)
+
+	fmt.Printf("Restored up to pulse %v\n", latest)
+}
@@ -0,0 +1,51 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/heavy/integrity"
+	"github.com/insolar/insolar/ledger/migration"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+// verifyLedger checks data directory of a stopped heavy node and prints the report as JSON. Process exits with
+// non-zero code if problems are found.
+func verifyLedger(dataDir string) {
+	ctx := context.Background()
+	db, err := store.NewBadgerDB(dataDir)
+	checkError("Failed to open storage", err)
+	// Hashes of drops are verified by the latest scheme, so data should be migrated.
+	checkError("Unsupported storage", migration.Default.CheckMigrated(db))
+
+	checker := integrity.NewChecker(db, platformpolicy.NewPlatformCryptographyScheme())
+	report, err := checker.Check(ctx)
+	stopErr := db.Stop(ctx)
+	checkError("Failed to verify ledger", err)
+	checkError("Failed to close storage", stopErr)
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	checkError("Failed to write report", enc.Encode(report))
+	if !report.OK() {
+		os.Exit(2)
+	}
+}
@@ -144,7 +144,10 @@ func initPulsar(ctx context.Context, cfg configuration.Configuration) (*componen
 	}
 
 	cm := &component.Manager{}
-	cm.Register(cryptographyScheme, keyStore, keyProcessor, transport.NewFactory(cfg.Pulsar.DistributionTransport))
+	transportFactory := transport.NewFactoryWithIdentity(
+		cfg.Pulsar.DistributionTransport, transport.NewIdentity(keyStore, nil, nil),
+	)
+	cm.Register(cryptographyScheme, keyStore, keyProcessor, transportFactory)
 	cm.Inject(cryptographyService, pulseDistributor)
 
 	if err = cm.Init(ctx); err != nil {
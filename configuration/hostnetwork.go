@@ -18,7 +18,7 @@ package configuration
 
 // Transport holds transport protocol configuration for HostNetwork
 type Transport struct {
-	// protocol type
+	// protocol type: TCP or TLS. TLS encrypts streams and authenticates nodes by their keys
 	Protocol string
 	// Address to listen
 	Address string
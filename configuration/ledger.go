@@ -27,12 +27,33 @@ type Storage struct {
 	// TxRetriesOnConflict defines how many retries on transaction conflicts
 	// storage update methods should do.
 	TxRetriesOnConflict int
+	// HotDataOnDisk makes light node keep records, blobs and indexes in a database in DataDirectory
+	// instead of memory.
+	HotDataOnDisk bool
+	// WriteAheadLog makes light node log hot data to a database in DataDirectory and replay it on start,
+	// so data not yet confirmed by heavy survives a restart.
+	WriteAheadLog bool
+	// BlobCompression is a codec new values of blobs are compressed with: "snappy", "zstd" or empty for no
+	// compression. Values compressed with another codec stay readable.
+	BlobCompression string
 }
 
 // PulseManager holds configuration for PulseManager.
 type PulseManager struct {
-	// SplitThreshold is a drop size threshold in bytes to perform split.
+	// SplitThreshold is a threshold of jet's averaged drop size in bytes to perform split.
+	// Zero disables the check.
 	SplitThreshold uint64
+	// SplitRecordsThreshold is a threshold of jet's averaged records count per pulse to perform split.
+	// Zero disables the check.
+	SplitRecordsThreshold uint64
+	// SplitRequestsThreshold is a threshold of jet's averaged requests count per pulse to perform split.
+	// Zero disables the check.
+	SplitRequestsThreshold uint64
+	// LoadWindow is a number of pulses jet's load is averaged over.
+	LoadWindow uint64
+	// MergeRatio is how many times combined load of two sibling jets should be below split thresholds
+	// to merge them. Zero disables merge.
+	MergeRatio uint64
 }
 
 // Backoff configures retry backoff algorithm
@@ -52,6 +73,19 @@ type Exporter struct {
 	ExportLag uint32
 }
 
+// Backup holds configuration of heavy node backups.
+type Backup struct {
+	// Directory is a directory backups are written to. Empty value disables backups.
+	Directory string
+}
+
+// Pruning holds configuration of pruning of object states on heavy node.
+type Pruning struct {
+	// Depth is a number of pulses after which memory of superseded states and deactivated objects is removed.
+	// It should be greater than LightChainLimit, heavy node doesn't start otherwise. Zero value disables pruning.
+	Depth int
+}
+
 // Ledger holds configuration for ledger.
 type Ledger struct {
 	// Storage defines storage configuration.
@@ -67,12 +101,34 @@ type Ledger struct {
 	// IMPORTANT: It should be the same on ALL nodes.
 	LightChainLimit int
 
+	// HeavyReplicaCount is a number of heavy nodes, which keep replicas of the data. Zero value means all the
+	// active heavy nodes. Light nodes clean data only after all the replicas have stored it.
+	//
+	// IMPORTANT: It should be the same on ALL nodes.
+	HeavyReplicaCount int
+
 	// Exporter holds configuration of Exporter
 	Exporter Exporter
 
-	// PendingRequestsLimit holds a number of pending requests, what can be stored in the system
-	// before they are declined
+	// Backup holds configuration of heavy node backups.
+	Backup Backup
+
+	// Pruning holds configuration of pruning of object states on heavy node.
+	Pruning Pruning
+
+	// PendingRequestsLimit holds a number of pending requests, what can be stored in a jet
+	// before they are declined. Executors are notified about the same number of abandoned requests in a single pulse
 	PendingRequestsLimit int
+
+	// ObjectPendingRequestsLimit holds a number of pending requests, what can be stored for a single object
+	// before they are declined
+	ObjectPendingRequestsLimit int
+
+	// PendingRetryDelay is a back-off suggested to a caller whose request was declined by pending requests limits.
+	PendingRetryDelay time.Duration
+
+	// HeavySyncBackoff configures retries of sending a replicated data from light to heavy.
+	HeavySyncBackoff Backoff
 }
 
 // NewLedger creates new default Ledger configuration.
@@ -84,14 +140,29 @@ func NewLedger() Ledger {
 		},
 
 		PulseManager: PulseManager{
-			SplitThreshold: 10 * 100, // 10 megabytes.
+			SplitThreshold:         10 * 1000 * 1000, // 10 megabytes.
+			SplitRecordsThreshold:  1000,
+			SplitRequestsThreshold: 500,
+			LoadWindow:             10, // 10 pulses
+			MergeRatio:             4,
 		},
-		LightChainLimit: 5, // 5 pulses
+		LightChainLimit:   5, // 5 pulses
+		HeavyReplicaCount: 2,
 
 		Exporter: Exporter{
 			ExportLag: 40, // 40 seconds
 		},
 
-		PendingRequestsLimit: 1000,
+		PendingRequestsLimit:       1000,
+		ObjectPendingRequestsLimit: 100,
+		PendingRetryDelay:          time.Second,
+
+		HeavySyncBackoff: Backoff{
+			Factor:      2,
+			Jitter:      true,
+			Min:         200 * time.Millisecond,
+			Max:         2 * time.Second,
+			MaxAttempts: 5,
+		},
 	}
 }
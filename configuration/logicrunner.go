@@ -27,6 +27,8 @@ type LogicRunner struct {
 	BuiltIn *BuiltIn
 	// GoPlugin - configuration of executor based on Go plugins
 	GoPlugin *GoPlugin
+	// CallTraceLimit - number of traces call records are kept for, 0 disables call tracing
+	CallTraceLimit int
 }
 
 // BuiltIn configuration, no options at the moment
@@ -39,6 +41,20 @@ type GoPlugin struct {
 	// RunnerProtocol - protocol (network) of above address,
 	// e.g. "tcp", "unix"... see `net.Dial`
 	RunnerProtocol string
+	// RunnerCount - number of Go plugins executors, calls are distributed between them by code reference.
+	// Executor N listens to RunnerListen with port increased by N, or with ".N" suffix for unix sockets
+	RunnerCount int
+	// RunnerPath - path to insgorund binary, if it's set executors are started and restarted by logic runner
+	RunnerPath string
+	// RunnerCodePath - directory where started executors cache code, every executor has own subdirectory
+	RunnerCodePath string
+	// RunnerPluginsLimit - number of plugins a started executor keeps opened, 0 is unlimited
+	RunnerPluginsLimit int
+	// HealthCheckPeriod - period of executors health check in milliseconds, 0 disables it
+	HealthCheckPeriod int
+	// HealthCheckCode - healthcheck contract loaded into started executors, in form <ref>:</path/to/plugin.so>.
+	// If it's empty, health check only checks that executor accepts connections
+	HealthCheckCode string
 }
 
 // NewLogicRunner - returns default config of the logic runner
@@ -48,8 +64,12 @@ func NewLogicRunner() LogicRunner {
 		RPCProtocol: "tcp",
 		BuiltIn:     &BuiltIn{},
 		GoPlugin: &GoPlugin{
-			RunnerListen:   "127.0.0.1:7777",
-			RunnerProtocol: "tcp",
+			RunnerListen:       "127.0.0.1:7777",
+			RunnerProtocol:     "tcp",
+			RunnerCount:        1,
+			RunnerPluginsLimit: 1000,
+			HealthCheckPeriod:  10000,
 		},
+		CallTraceLimit: 10000,
 	}
 }
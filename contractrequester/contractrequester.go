@@ -127,6 +127,13 @@ func (cr *ContractRequester) Call(ctx context.Context, inMsg insolar.Message) (i
 		return nil, errors.Wrap(err, "couldn't dispatch event")
 	}
 
+	if overloaded, ok := res.(*reply.ObjectOverloaded); ok {
+		cr.ResultMutex.Lock()
+		delete(cr.ResultMap, seq)
+		cr.ResultMutex.Unlock()
+		return nil, errors.Wrap(overloaded.Error(), "request is declined")
+	}
+
 	r, ok := res.(*reply.RegisterRequest)
 	if !ok {
 		return nil, errors.New("Got not reply.RegisterRequest in reply for CallMethod")
@@ -173,6 +180,28 @@ func (cr *ContractRequester) CallConstructor(ctx context.Context, inMsg insolar.
 	return rep.Object, nil
 }
 
+// Simulate sends message.SimulateCall to the executor of the object and returns reply.SimulateCall
+func (cr *ContractRequester) Simulate(ctx context.Context, inMsg insolar.Message) (insolar.Reply, error) {
+	ctx, span := instracer.StartSpan(ctx, "ContractRequester.Simulate")
+	defer span.End()
+
+	msg, ok := inMsg.(*message.SimulateCall)
+	if !ok {
+		return nil, errors.New("Simulate() accepts only message.SimulateCall")
+	}
+
+	sender := messagebus.BuildSender(cr.MessageBus.Send, messagebus.RetryIncorrectPulse(cr.PulseAccessor))
+	res, err := sender(ctx, msg, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't dispatch event")
+	}
+
+	if _, ok := res.(*reply.SimulateCall); !ok {
+		return nil, errors.Errorf("Got %T in reply for SimulateCall", res)
+	}
+	return res, nil
+}
+
 func (cr *ContractRequester) ReceiveResult(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
 	msg, ok := parcel.Message().(*message.ReturnResults)
 	if !ok {
@@ -146,6 +146,24 @@ func TestContractRequester_SendRequest_RouteError(t *testing.T) {
 	require.Nil(t, result)
 }
 
+func TestContractRequester_SendRequest_ObjectOverloaded(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	ref := testutils.RandomRef()
+
+	mbm := mockMessageBus(t, &reply.ObjectOverloaded{Object: ref, RetryAfter: time.Second})
+	cReq, err := New()
+	require.NoError(t, err)
+	cReq.MessageBus = mbm
+	cReq.PulseAccessor = mockPulseAccessor(t)
+
+	_, err = cReq.SendRequest(ctx, &ref, "TestMethod", []interface{}{})
+	require.Error(t, err)
+	retryAfter, ok := insolar.RetryAfter(err)
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, retryAfter)
+	assert.Empty(t, cReq.ResultMap)
+}
+
 func TestCallMethodCanceled(t *testing.T) {
 	ctx := context.Background()
 	ctx, cancelFunc := context.WithTimeout(ctx, time.Second)
@@ -0,0 +1,56 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package insolar
+
+import (
+	"context"
+	"time"
+)
+
+// Outcomes of contract calls.
+const (
+	CallOutcomeSuccess       = "success"
+	CallOutcomeContractError = "contract error"
+	CallOutcomeSystemError   = "system error"
+)
+
+// CallRecord is a record of a contract call executed by a logic runner. Calls made in scope of
+// one top-level request share TraceID, nested call is linked to the parent one through Parent,
+// it's the Request of the parent call.
+type CallRecord struct {
+	TraceID   string
+	Request   Reference
+	Parent    Reference
+	Caller    Reference
+	Callee    Reference
+	Prototype Reference
+	Method    string
+	Pulse     PulseNumber
+	Node      Reference
+	Start     time.Time
+	Duration  time.Duration
+	Outcome   string
+	Error     string
+}
+
+//go:generate minimock -i github.com/insolar/insolar/insolar.CallTracer -o ../testutils -s _mock.go
+
+// CallTracer provides records of contract calls.
+type CallTracer interface {
+	// CallTree returns calls made in scope of trace by all virtual nodes.
+	CallTree(ctx context.Context, traceID string) ([]CallRecord, error)
+}
@@ -33,6 +33,7 @@ type Certificate interface {
 
 	GetRootDomainReference() *Reference
 	GetDiscoveryNodes() []DiscoveryNode
+	GetPulsarPublicKeys() []crypto.PublicKey
 }
 
 //go:generate minimock -i github.com/insolar/insolar/insolar.DiscoveryNode -o ../testutils -s _mock.go
@@ -29,4 +29,6 @@ type ContractRequester interface {
 	// CallMethod - low level calls contract
 	CallMethod(ctx context.Context, msg Message) (Reply, error)
 	CallConstructor(ctx context.Context, msg Message) (*Reference, error)
+	// Simulate executes method call without side effects and returns would-be result
+	Simulate(ctx context.Context, msg Message) (Reply, error)
 }
@@ -16,7 +16,12 @@
 
 package insolar
 
-import "github.com/pkg/errors"
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 var (
 	// ErrUnknown is returned when error type cannot be defined.
@@ -33,4 +38,27 @@ var (
 	ErrNotFound = errors.New("not found")
 	// ErrTooManyPendingRequests is returned when a limit of pending requests has been reached on a current LME
 	ErrTooManyPendingRequests = errors.New("the limit of pending requests count has been reached")
+	// ErrStatePruned is returned when memory of requested object state is removed by pruning on heavy node.
+	ErrStatePruned = errors.New("object state is pruned")
 )
+
+// ObjectOverloadedError is returned when a request is declined because too many requests are pending for its object
+// or for the jet of the object on a current LME. The request can be registered again after RetryAfter.
+type ObjectOverloadedError struct {
+	Object     Reference
+	RetryAfter time.Duration
+}
+
+// Error implements error interface.
+func (e *ObjectOverloadedError) Error() string {
+	return fmt.Sprintf("%s for object %s, retry after %s", ErrTooManyPendingRequests, e.Object, e.RetryAfter)
+}
+
+// RetryAfter returns a back-off suggested by an error that was caused by ObjectOverloadedError. The error is sent
+// over the network as reply.ObjectOverloaded, so the back-off is kept between nodes.
+func RetryAfter(err error) (time.Duration, bool) {
+	if overloaded, ok := errors.Cause(err).(*ObjectOverloadedError); ok {
+		return overloaded.RetryAfter, true
+	}
+	return 0, false
+}
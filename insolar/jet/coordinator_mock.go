@@ -20,6 +20,11 @@ import (
 type CoordinatorMock struct {
 	t minimock.Tester
 
+	HeaviesFunc       func(p context.Context, p1 insolar.PulseNumber) (r []insolar.Reference, r1 error)
+	HeaviesCounter    uint64
+	HeaviesPreCounter uint64
+	HeaviesMock       mCoordinatorMockHeavies
+
 	HeavyFunc       func(p context.Context, p1 insolar.PulseNumber) (r *insolar.Reference, r1 error)
 	HeavyCounter    uint64
 	HeavyPreCounter uint64
@@ -94,6 +99,7 @@ func NewCoordinatorMock(t minimock.Tester) *CoordinatorMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.HeaviesMock = mCoordinatorMockHeavies{mock: m}
 	m.HeavyMock = mCoordinatorMockHeavy{mock: m}
 	m.IsAuthorizedMock = mCoordinatorMockIsAuthorized{mock: m}
 	m.IsBeyondLimitMock = mCoordinatorMockIsBeyondLimit{mock: m}
@@ -111,6 +117,157 @@ func NewCoordinatorMock(t minimock.Tester) *CoordinatorMock {
 	return m
 }
 
+type mCoordinatorMockHeavies struct {
+	mock              *CoordinatorMock
+	mainExpectation   *CoordinatorMockHeaviesExpectation
+	expectationSeries []*CoordinatorMockHeaviesExpectation
+}
+
+type CoordinatorMockHeaviesExpectation struct {
+	input  *CoordinatorMockHeaviesInput
+	result *CoordinatorMockHeaviesResult
+}
+
+type CoordinatorMockHeaviesInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+}
+
+type CoordinatorMockHeaviesResult struct {
+	r  []insolar.Reference
+	r1 error
+}
+
+//Expect specifies that invocation of Coordinator.Heavies is expected from 1 to Infinity times
+func (m *mCoordinatorMockHeavies) Expect(p context.Context, p1 insolar.PulseNumber) *mCoordinatorMockHeavies {
+	m.mock.HeaviesFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CoordinatorMockHeaviesExpectation{}
+	}
+	m.mainExpectation.input = &CoordinatorMockHeaviesInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Coordinator.Heavies
+func (m *mCoordinatorMockHeavies) Return(r []insolar.Reference, r1 error) *CoordinatorMock {
+	m.mock.HeaviesFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CoordinatorMockHeaviesExpectation{}
+	}
+	m.mainExpectation.result = &CoordinatorMockHeaviesResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Coordinator.Heavies is expected once
+func (m *mCoordinatorMockHeavies) ExpectOnce(p context.Context, p1 insolar.PulseNumber) *CoordinatorMockHeaviesExpectation {
+	m.mock.HeaviesFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &CoordinatorMockHeaviesExpectation{}
+	expectation.input = &CoordinatorMockHeaviesInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *CoordinatorMockHeaviesExpectation) Return(r []insolar.Reference, r1 error) {
+	e.result = &CoordinatorMockHeaviesResult{r, r1}
+}
+
+//Set uses given function f as a mock of Coordinator.Heavies method
+func (m *mCoordinatorMockHeavies) Set(f func(p context.Context, p1 insolar.PulseNumber) (r []insolar.Reference, r1 error)) *CoordinatorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.HeaviesFunc = f
+	return m.mock
+}
+
+//Heavies implements github.com/insolar/insolar/insolar/jet.Coordinator interface
+func (m *CoordinatorMock) Heavies(p context.Context, p1 insolar.PulseNumber) (r []insolar.Reference, r1 error) {
+	counter := atomic.AddUint64(&m.HeaviesPreCounter, 1)
+	defer atomic.AddUint64(&m.HeaviesCounter, 1)
+
+	if len(m.HeaviesMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.HeaviesMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to CoordinatorMock.Heavies. %v %v", p, p1)
+			return
+		}
+
+		input := m.HeaviesMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, CoordinatorMockHeaviesInput{p, p1}, "Coordinator.Heavies got unexpected parameters")
+
+		result := m.HeaviesMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the CoordinatorMock.Heavies")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.HeaviesMock.mainExpectation != nil {
+
+		input := m.HeaviesMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, CoordinatorMockHeaviesInput{p, p1}, "Coordinator.Heavies got unexpected parameters")
+		}
+
+		result := m.HeaviesMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the CoordinatorMock.Heavies")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.HeaviesFunc == nil {
+		m.t.Fatalf("Unexpected call to CoordinatorMock.Heavies. %v %v", p, p1)
+		return
+	}
+
+	return m.HeaviesFunc(p, p1)
+}
+
+//HeaviesMinimockCounter returns a count of CoordinatorMock.HeaviesFunc invocations
+func (m *CoordinatorMock) HeaviesMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.HeaviesCounter)
+}
+
+//HeaviesMinimockPreCounter returns the value of CoordinatorMock.Heavies invocations
+func (m *CoordinatorMock) HeaviesMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.HeaviesPreCounter)
+}
+
+//HeaviesFinished returns true if mock invocations count is ok
+func (m *CoordinatorMock) HeaviesFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.HeaviesMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.HeaviesCounter) == uint64(len(m.HeaviesMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.HeaviesMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.HeaviesCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.HeaviesFunc != nil {
+		return atomic.LoadUint64(&m.HeaviesCounter) > 0
+	}
+
+	return true
+}
+
 type mCoordinatorMockHeavy struct {
 	mock              *CoordinatorMock
 	mainExpectation   *CoordinatorMockHeavyExpectation
@@ -2077,6 +2234,10 @@ func (m *CoordinatorMock) VirtualValidatorsForObjectFinished() bool {
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *CoordinatorMock) ValidateCallCounters() {
 
+	if !m.HeaviesFinished() {
+		m.t.Fatal("Expected call to CoordinatorMock.Heavies")
+	}
+
 	if !m.HeavyFinished() {
 		m.t.Fatal("Expected call to CoordinatorMock.Heavy")
 	}
@@ -2146,6 +2307,10 @@ func (m *CoordinatorMock) Finish() {
 //MinimockFinish checks that all mocked methods of the interface have been called at least once
 func (m *CoordinatorMock) MinimockFinish() {
 
+	if !m.HeaviesFinished() {
+		m.t.Fatal("Expected call to CoordinatorMock.Heavies")
+	}
+
 	if !m.HeavyFinished() {
 		m.t.Fatal("Expected call to CoordinatorMock.Heavy")
 	}
@@ -2212,6 +2377,7 @@ func (m *CoordinatorMock) MinimockWait(timeout time.Duration) {
 	timeoutCh := time.After(timeout)
 	for {
 		ok := true
+		ok = ok && m.HeaviesFinished()
 		ok = ok && m.HeavyFinished()
 		ok = ok && m.IsAuthorizedFinished()
 		ok = ok && m.IsBeyondLimitFinished()
@@ -2233,6 +2399,10 @@ func (m *CoordinatorMock) MinimockWait(timeout time.Duration) {
 		select {
 		case <-timeoutCh:
 
+			if !m.HeaviesFinished() {
+				m.t.Error("Expected call to CoordinatorMock.Heavies")
+			}
+
 			if !m.HeavyFinished() {
 				m.t.Error("Expected call to CoordinatorMock.Heavy")
 			}
@@ -2297,6 +2467,10 @@ func (m *CoordinatorMock) MinimockWait(timeout time.Duration) {
 //it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
 func (m *CoordinatorMock) AllMocksCalled() bool {
 
+	if !m.HeaviesFinished() {
+		return false
+	}
+
 	if !m.HeavyFinished() {
 		return false
 	}
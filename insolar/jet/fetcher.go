@@ -150,7 +150,15 @@ func (tu *fetcher) Fetch(
 	}
 
 	// Updating local tree.
-	tu.JetStorage.Update(ctx, pulse, true, insolar.JetID(*resJet))
+	actualJet := insolar.JetID(*resJet)
+	tu.JetStorage.Update(ctx, pulse, true, actualJet)
+	if actualJet.Depth() < jetID.Depth() {
+		// Actual jet is higher in the tree than the local one, so its branches were merged.
+		err = tu.JetStorage.Merge(ctx, pulse, actualJet)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to merge jet in local tree")
+		}
+	}
 
 	return resJet, nil
 }
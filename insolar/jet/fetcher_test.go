@@ -235,6 +235,24 @@ func TestJetTreeUpdater_fetchJet(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, insolar.ID(*insolar.NewJetID(0, nil)), *jetID)
 	})
+
+	t.Run("fetched jet is merged in local tree", func(t *testing.T) {
+		parent := NewIDFromString("1")
+		mb.SendMock.Return(&reply.Jet{ID: insolar.ID(parent), Actual: true}, nil)
+		js.ForIDMock.Return(NewIDFromString("10"), false)
+		js.UpdateFunc = func(ctx context.Context, pn insolar.PulseNumber, actual bool, jets ...insolar.JetID) {
+			require.Equal(t, []insolar.JetID{parent}, jets)
+		}
+		js.MergeFunc = func(ctx context.Context, pn insolar.PulseNumber, id insolar.JetID) error {
+			require.Equal(t, insolar.PulseNumber(100), pn)
+			require.Equal(t, parent, id)
+			return nil
+		}
+
+		jetID, err := jtu.Fetch(ctx, target, insolar.PulseNumber(100))
+		require.NoError(t, err)
+		require.Equal(t, insolar.ID(parent), *jetID)
+	})
 }
 
 func TestJetTreeUpdater_Concurrency(t *testing.T) {
@@ -38,6 +38,7 @@ type Accessor interface {
 type Modifier interface {
 	Update(ctx context.Context, pulse insolar.PulseNumber, actual bool, ids ...insolar.JetID)
 	Split(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (insolar.JetID, insolar.JetID, error)
+	Merge(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) error
 	Clone(ctx context.Context, from, to insolar.PulseNumber)
 	DeleteForPN(ctx context.Context, pulse insolar.PulseNumber)
 }
@@ -80,6 +81,8 @@ type Coordinator interface {
 	LightValidatorsForJet(ctx context.Context, jetID insolar.ID, pulse insolar.PulseNumber) ([]insolar.Reference, error)
 
 	Heavy(ctx context.Context, pulse insolar.PulseNumber) (*insolar.Reference, error)
+	// Heavies returns heavy nodes, which keep replicas of the data. The first one is the same as Heavy returns.
+	Heavies(ctx context.Context, pulse insolar.PulseNumber) ([]insolar.Reference, error)
 
 	IsBeyondLimit(ctx context.Context, currentPN, targetPN insolar.PulseNumber) (bool, error)
 	NodeForJet(ctx context.Context, jetID insolar.ID, rootPN, targetPN insolar.PulseNumber) (*insolar.Reference, error)
@@ -98,6 +101,19 @@ func Parent(id insolar.JetID) insolar.JetID {
 	return *insolar.NewJetID(depth-1, resetBits(prefix, depth-1))
 }
 
+// Children returns left and right children of the jet, which are created when the jet is split.
+func Children(id insolar.JetID) (insolar.JetID, insolar.JetID) {
+	depth, prefix := id.Depth(), id.Prefix()
+
+	left := insolar.NewJetID(depth+1, resetBits(prefix, depth))
+
+	rightPrefix := resetBits(prefix, depth)
+	setBit(rightPrefix, depth)
+	right := insolar.NewJetID(depth+1, rightPrefix)
+
+	return *left, *right
+}
+
 // resetBits returns a new byte slice with all bits in 'value' reset,
 // starting from 'start' number of bit.
 //
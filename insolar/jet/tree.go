@@ -154,15 +154,31 @@ func (t *Tree) Split(id insolar.JetID) (insolar.JetID, insolar.JetID, error) {
 	}
 
 	j.Left = &jet{}
-	leftPrefix := resetBits(prefix, depth)
-	left := insolar.NewJetID(depth+1, leftPrefix)
-
 	j.Right = &jet{}
-	rightPrefix := resetBits(prefix, depth)
-	setBit(rightPrefix, depth)
-	right := insolar.NewJetID(depth+1, rightPrefix)
+	left, right := Children(id)
+
+	return left, right, nil
+}
+
+// Merge looks for provided jet and removes its branches, so it becomes a leaf again.
+// If provided jet is not found, an error will be returned.
+func (t *Tree) Merge(id insolar.JetID) error {
+	depth, prefix := id.Depth(), id.Prefix()
+	j := t.Head
+	for d := uint8(0); d < depth; d++ {
+		if getBit(prefix, d) {
+			j = j.Right
+		} else {
+			j = j.Left
+		}
+		if j == nil {
+			return errors.New("failed to merge: incorrect jet provided")
+		}
+	}
 
-	return *left, *right, nil
+	j.Left = nil
+	j.Right = nil
+	return nil
 }
 
 func (t *Tree) LeafIDs() []insolar.JetID {
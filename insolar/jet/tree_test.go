@@ -134,6 +134,37 @@ func TestTree_Split(t *testing.T) {
 	})
 }
 
+func TestTree_Merge(t *testing.T) {
+	tree := Tree{
+		Head: &jet{
+			Left: &jet{},
+			Right: &jet{
+				Left: &jet{},
+				Right: &jet{
+					Actual: true,
+					Left:   &jet{Actual: true},
+					Right:  &jet{Actual: true},
+				},
+			},
+		},
+	}
+
+	t.Run("not existing jet returns error", func(t *testing.T) {
+		err := tree.Merge(NewIDFromString("100"))
+		assert.Error(t, err)
+	})
+
+	t.Run("merges jet", func(t *testing.T) {
+		err := tree.Merge(NewIDFromString("11"))
+		require.NoError(t, err)
+
+		id, actual := tree.Find(*insolar.NewID(insolar.FirstPulseNumber, []byte{0xFF}))
+		assert.Equal(t, NewIDFromString("11"), id)
+		assert.True(t, actual)
+		assert.Equal(t, []insolar.JetID{NewIDFromString("0"), NewIDFromString("10"), NewIDFromString("11")}, tree.LeafIDs())
+	})
+}
+
 func TestTree_String(t *testing.T) {
 	tree := Tree{
 		Head: &jet{
@@ -42,12 +42,14 @@ type Coordinator struct {
 	JetAccessor jet.Accessor  `inject:""`
 	Nodes       node.Accessor `inject:""`
 
-	lightChainLimit int
+	lightChainLimit   int
+	heavyReplicaCount int
 }
 
-// NewJetCoordinator creates new coordinator instance.
-func NewJetCoordinator(lightChainLimit int) *Coordinator {
-	return &Coordinator{lightChainLimit: lightChainLimit}
+// NewJetCoordinator creates new coordinator instance. Data is replicated on heavyReplicaCount heavy nodes,
+// zero value means all the active heavy nodes.
+func NewJetCoordinator(lightChainLimit, heavyReplicaCount int) *Coordinator {
+	return &Coordinator{lightChainLimit: lightChainLimit, heavyReplicaCount: heavyReplicaCount}
 }
 
 // Hardcoded roles count for validation and execution
@@ -196,6 +198,18 @@ func (jc *Coordinator) LightValidatorsForObject(
 
 // Heavy returns *insolar.RecorRef to a heavy of specific pulse
 func (jc *Coordinator) Heavy(ctx context.Context, pulse insolar.PulseNumber) (*insolar.Reference, error) {
+	refs, err := jc.Heavies(ctx, pulse)
+	if err != nil {
+		return nil, err
+	}
+	return &refs[0], nil
+}
+
+// Heavies returns heavy nodes, which keep replicas of the data, for specific pulse. The first one is the primary heavy.
+//
+// Replicas are selected without entropy, so the same heavy nodes keep the data for all pulses
+// while the set of active heavy nodes is not changed.
+func (jc *Coordinator) Heavies(ctx context.Context, pulse insolar.PulseNumber) ([]insolar.Reference, error) {
 	candidates, err := jc.Nodes.InRole(pulse, insolar.StaticRoleHeavyMaterial)
 	if err == node.ErrNoNodes {
 		return nil, err
@@ -206,21 +220,18 @@ func (jc *Coordinator) Heavy(ctx context.Context, pulse insolar.PulseNumber) (*i
 	if len(candidates) == 0 {
 		return nil, errors.New(fmt.Sprintf("no active heavy nodes for pulse %d", pulse))
 	}
-	ent, err := jc.entropy(ctx, pulse)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to fetch entropy for pulse %v", pulse)
-	}
 
-	refs, err := getRefs(
-		jc.PlatformCryptographyScheme,
-		ent[:],
-		candidates,
-		1,
-	)
-	if err != nil {
-		return nil, err
+	refs := make([]insolar.Reference, 0, len(candidates))
+	for _, c := range candidates {
+		refs = append(refs, c.ID)
 	}
-	return &refs[0], nil
+	sort.Slice(refs, func(i, j int) bool {
+		return bytes.Compare(refs[i][:], refs[j][:]) < 0
+	})
+	if jc.heavyReplicaCount > 0 && len(refs) > jc.heavyReplicaCount {
+		refs = refs[:jc.heavyReplicaCount]
+	}
+	return refs, nil
 }
 
 // IsBeyondLimit calculates if target pulse is behind clean-up limit
@@ -17,7 +17,9 @@
 package jetcoordinator
 
 import (
+	"bytes"
 	"context"
+	"sort"
 	"testing"
 
 	"github.com/insolar/insolar/component"
@@ -70,7 +72,7 @@ func (s *jetCoordinatorSuite) BeforeTest(suiteName, testName string) {
 	storage := jet.NewStore()
 	s.jetStorage = storage
 	s.nodeStorage = node.NewAccessorMock(s.T())
-	s.coordinator = NewJetCoordinator(5)
+	s.coordinator = NewJetCoordinator(5, 2)
 	s.coordinator.NodeNet = network.NewNodeNetworkMock(s.T())
 
 	s.cm.Inject(
@@ -131,7 +133,7 @@ func TestJetCoordinator_Me(t *testing.T) {
 	node := network.NewNetworkNodeMock(t)
 	nodeNet.GetOriginMock.Return(node)
 	node.IDMock.Return(expectedID)
-	jc := NewJetCoordinator(1)
+	jc := NewJetCoordinator(1, 2)
 	jc.NodeNet = nodeNet
 
 	// Act
@@ -144,7 +146,7 @@ func TestJetCoordinator_Me(t *testing.T) {
 func TestNewJetCoordinator(t *testing.T) {
 	t.Parallel()
 	// Act
-	calc := NewJetCoordinator(12)
+	calc := NewJetCoordinator(12, 2)
 
 	// Assert
 	require.NotNil(t, calc)
@@ -157,7 +159,7 @@ func TestJetCoordinator_IsBeyondLimit_ProblemsWithTracker(t *testing.T) {
 	ctx := inslogger.TestContext(t)
 	pulseCalculator := pulse.NewCalculatorMock(t)
 	pulseCalculator.BackwardsMock.Return(insolar.Pulse{}, errors.New("it's expected"))
-	calc := NewJetCoordinator(12)
+	calc := NewJetCoordinator(12, 2)
 	calc.PulseCalculator = pulseCalculator
 
 	// Act
@@ -173,7 +175,7 @@ func TestJetCoordinator_IsBeyondLimit_OutsideOfLightChainLimit(t *testing.T) {
 	// Arrange
 	ctx := inslogger.TestContext(t)
 
-	coord := NewJetCoordinator(25)
+	coord := NewJetCoordinator(25, 2)
 	pulseCalculator := pulse.NewCalculatorMock(t)
 	pulseCalculator.BackwardsMock.Expect(ctx, insolar.FirstPulseNumber, 25).Return(insolar.Pulse{PulseNumber: 34}, nil)
 	coord.PulseCalculator = pulseCalculator
@@ -190,7 +192,7 @@ func TestJetCoordinator_IsBeyondLimit_InsideOfLightChainLimit(t *testing.T) {
 	t.Parallel()
 	// Arrange
 	ctx := inslogger.TestContext(t)
-	coord := NewJetCoordinator(25)
+	coord := NewJetCoordinator(25, 2)
 	pulseCalculator := pulse.NewCalculatorMock(t)
 	pulseCalculator.BackwardsMock.Expect(ctx, insolar.FirstPulseNumber, 25).Return(insolar.Pulse{PulseNumber: 15}, nil)
 	coord.PulseCalculator = pulseCalculator
@@ -210,7 +212,7 @@ func TestJetCoordinator_NodeForJet_CheckLimitFailed(t *testing.T) {
 	pulseCalculator := pulse.NewCalculatorMock(t)
 	pulseCalculator.BackwardsMock.Return(insolar.Pulse{}, errors.New("it's expected"))
 
-	calc := NewJetCoordinator(12)
+	calc := NewJetCoordinator(12, 2)
 	calc.PulseCalculator = pulseCalculator
 
 	// Act
@@ -240,7 +242,7 @@ func TestJetCoordinator_NodeForJet_GoToHeavy(t *testing.T) {
 		return []insolar.Node{{ID: *expectedID}}, nil
 	}
 
-	coord := NewJetCoordinator(25)
+	coord := NewJetCoordinator(25, 2)
 	coord.PulseCalculator = pulseCalculator
 	coord.Nodes = activeNodesStorageMock
 	coord.PlatformCryptographyScheme = platformpolicy.NewPlatformCryptographyScheme()
@@ -274,7 +276,7 @@ func TestJetCoordinator_NodeForJet_GoToLight(t *testing.T) {
 		return []insolar.Node{{ID: *expectedID}}, nil
 	}
 
-	coord := NewJetCoordinator(25)
+	coord := NewJetCoordinator(25, 2)
 	coord.PulseAccessor = pulseAccessor
 	coord.PulseCalculator = pulseCalculator
 	coord.Nodes = activeNodesStorageMock
@@ -287,3 +289,43 @@ func TestJetCoordinator_NodeForJet_GoToLight(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, expectedID, resNode)
 }
+
+func TestJetCoordinator_Heavies(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+
+	var refs []insolar.Reference
+	for i := 0; i < 3; i++ {
+		refs = append(refs, *insolar.NewReference(testutils.RandomID(), testutils.RandomID()))
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		return bytes.Compare(refs[i][:], refs[j][:]) < 0
+	})
+
+	nodes := node.NewAccessorMock(t)
+	nodes.InRoleFunc = func(p insolar.PulseNumber, role insolar.StaticRole) ([]insolar.Node, error) {
+		require.Equal(t, insolar.StaticRoleHeavyMaterial, role)
+		// Order of active nodes doesn't matter.
+		var res []insolar.Node
+		for i := len(refs) - 1; i >= 0; i-- {
+			res = append(res, insolar.Node{ID: refs[i]})
+		}
+		return res, nil
+	}
+	coord := NewJetCoordinator(25, 2)
+	coord.Nodes = nodes
+
+	heavies, err := coord.Heavies(ctx, insolar.FirstPulseNumber)
+	require.NoError(t, err)
+	require.Equal(t, refs[:2], heavies)
+
+	heavy, err := coord.Heavy(ctx, insolar.FirstPulseNumber+10)
+	require.NoError(t, err)
+	require.Equal(t, refs[0], *heavy)
+
+	all := NewJetCoordinator(25, 0)
+	all.Nodes = nodes
+	heavies, err = all.Heavies(ctx, insolar.FirstPulseNumber)
+	require.NoError(t, err)
+	require.Equal(t, refs, heavies, "zero count means all the heavies")
+}
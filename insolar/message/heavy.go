@@ -55,3 +55,35 @@ func (HeavyPayload) GetCaller() *insolar.Reference {
 func (hp *HeavyPayload) Type() insolar.MessageType {
 	return insolar.TypeHeavyPayload
 }
+
+// GetHeavyHistory requests drops with their data, that follow the drop of provided jet and pulse in storage order.
+// Zero jet and pulse request the history from the beginning.
+type GetHeavyHistory struct {
+	JetID    insolar.JetID
+	PulseNum insolar.PulseNumber
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (*GetHeavyHistory) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return nil, 0
+}
+
+// DefaultRole returns role for this event
+func (*GetHeavyHistory) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleHeavyExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (*GetHeavyHistory) DefaultTarget() *insolar.Reference {
+	return &insolar.Reference{}
+}
+
+// GetCaller implementation of Message interface.
+func (GetHeavyHistory) GetCaller() *insolar.Reference {
+	return nil
+}
+
+// Type implementation of Message interface.
+func (*GetHeavyHistory) Type() insolar.MessageType {
+	return insolar.TypeGetHeavyHistory
+}
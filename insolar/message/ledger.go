@@ -197,10 +197,12 @@ func (*RegisterChild) Type() insolar.MessageType {
 	return insolar.TypeRegisterChild
 }
 
-// GetChildren retrieves a chunk of children references.
+// GetChildren retrieves a chunk of children references. If Prototype is set, only children of the prototype are
+// retrieved.
 type GetChildren struct {
 	ledgerMessage
 	Parent    insolar.Reference
+	Prototype *insolar.Reference
 	FromChild *insolar.ID
 	FromPulse *insolar.PulseNumber
 	Amount    int
@@ -319,6 +321,9 @@ type HotData struct {
 	HotIndexes      []HotIndex
 	PendingRequests map[insolar.ID]recentstorage.PendingObjectContext
 	PulseNumber     insolar.PulseNumber
+
+	// Sibling is set when two sibling jets were merged into Jet. Drop and Sibling are the drops of merged jets.
+	Sibling *drop.Drop
 }
 
 // AllowedSenderObjectAndRole implements interface method
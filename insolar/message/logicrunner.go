@@ -64,6 +64,10 @@ type CallMethod struct {
 	record.Request
 
 	PulseNum insolar.PulseNumber // DIRTY: EVIL: HACK
+
+	// ParentRequest is a request in scope of which the call is made by a contract. It links calls
+	// into call trees and isn't a part of the registered request.
+	ParentRequest insolar.Reference
 }
 
 func (cm *CallMethod) GetCaller() *insolar.Reference {
@@ -297,3 +301,55 @@ func (se *StillExecuting) DefaultTarget() *insolar.Reference {
 func (se *StillExecuting) Type() insolar.MessageType {
 	return insolar.TypeStillExecuting
 }
+
+// SimulateCall asks executor to run a method against the current object state without
+// registering request, updating object or saving result.
+type SimulateCall struct {
+	record.Request
+}
+
+func (sc *SimulateCall) GetCaller() *insolar.Reference {
+	return &sc.Caller
+}
+
+func (sc *SimulateCall) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return nil, 0
+}
+
+func (sc *SimulateCall) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleVirtualExecutor
+}
+
+func (sc *SimulateCall) DefaultTarget() *insolar.Reference {
+	return sc.Object
+}
+
+func (sc *SimulateCall) Type() insolar.MessageType {
+	return insolar.TypeSimulateCall
+}
+
+// GetCallTree fetches records of contract calls made by the node in scope of trace.
+type GetCallTree struct {
+	TraceID string
+	Node    insolar.Reference
+}
+
+func (gct *GetCallTree) GetCaller() *insolar.Reference {
+	return nil
+}
+
+func (gct *GetCallTree) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return nil, 0
+}
+
+func (gct *GetCallTree) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleUndefined
+}
+
+func (gct *GetCallTree) DefaultTarget() *insolar.Reference {
+	return &gct.Node
+}
+
+func (gct *GetCallTree) Type() insolar.MessageType {
+	return insolar.TypeGetCallTree
+}
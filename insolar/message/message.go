@@ -49,6 +49,10 @@ func getEmptyMessage(mt insolar.MessageType) (insolar.Message, error) {
 		return &PendingFinished{}, nil
 	case insolar.TypeStillExecuting:
 		return &StillExecuting{}, nil
+	case insolar.TypeSimulateCall:
+		return &SimulateCall{}, nil
+	case insolar.TypeGetCallTree:
+		return &GetCallTree{}, nil
 
 	// Ledger
 	case insolar.TypeGetCode:
@@ -81,6 +85,8 @@ func getEmptyMessage(mt insolar.MessageType) (insolar.Message, error) {
 	// heavy sync
 	case insolar.TypeHeavyPayload:
 		return &HeavyPayload{}, nil
+	case insolar.TypeGetHeavyHistory:
+		return &GetHeavyHistory{}, nil
 	// Genesis
 	case insolar.TypeGenesisRequest:
 		return &GenesisRequest{}, nil
@@ -197,6 +203,8 @@ func init() {
 	gob.Register(&ValidationResults{})
 	gob.Register(&PendingFinished{})
 	gob.Register(&StillExecuting{})
+	gob.Register(&SimulateCall{})
+	gob.Register(&GetCallTree{})
 
 	// Ledger
 	gob.Register(&GetCode{})
@@ -217,6 +225,7 @@ func init() {
 
 	// heavy
 	gob.Register(&HeavyPayload{})
+	gob.Register(&GetHeavyHistory{})
 
 	// Bootstrap
 	gob.Register(&GenesisRequest{})
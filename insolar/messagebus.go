@@ -132,6 +132,8 @@ type RedirectReply interface {
 	GetReceiver() *Reference
 	// GetToken returns delegation token.
 	GetToken() DelegationToken
+	// GetReplicas returns nodes to send message to if the receiver fails.
+	GetReplicas() []Reference
 }
 
 // MessageSendOptions represents options for message sending.
@@ -172,6 +174,8 @@ type MessageBusLocker interface {
 // MessageHandler is a function for message handling. It should be registered via Register method.
 type MessageHandler func(context.Context, Parcel) (Reply, error)
 
+// Message types are sent over the network as numbers, so new types are added to the end of the list only.
+//
 //go:generate stringer -type=MessageType
 const (
 	// Logicrunner
@@ -243,6 +247,12 @@ const (
 
 	// TypeNodeSignRequest used to request sign for new node
 	TypeNodeSignRequest
+	// TypeSimulateCall executes method against current object state without registering request and saving results
+	TypeSimulateCall
+	// TypeGetCallTree fetches records of contract calls made in scope of trace
+	TypeGetCallTree
+	// TypeGetHeavyHistory requests history of Heavy Material node for bootstrap of another one.
+	TypeGetHeavyHistory
 )
 
 // DelegationTokenType is an enum type of delegation token
@@ -35,11 +35,14 @@ func _() {
 	_ = x[TypeHeavyPayload-24]
 	_ = x[TypeGenesisRequest-25]
 	_ = x[TypeNodeSignRequest-26]
+	_ = x[TypeSimulateCall-27]
+	_ = x[TypeGetCallTree-28]
+	_ = x[TypeGetHeavyHistory-29]
 }
 
-const _MessageType_name = "TypeCallMethodTypeReturnResultsTypeExecutorResultsTypeValidateCaseBindTypeValidationResultsTypePendingFinishedTypeStillExecutingTypeGetCodeTypeGetObjectTypeGetDelegateTypeGetChildrenTypeUpdateObjectTypeRegisterChildTypeSetRecordTypeValidateRecordTypeSetBlobTypeGetObjectIndexTypeGetPendingRequestsTypeHotRecordsTypeGetJetTypeAbandonedRequestsNotificationTypeGetRequestTypeGetPendingRequestIDTypeHeavyStartStopTypeHeavyPayloadTypeGenesisRequestTypeNodeSignRequest"
+const _MessageType_name = "TypeCallMethodTypeReturnResultsTypeExecutorResultsTypeValidateCaseBindTypeValidationResultsTypePendingFinishedTypeStillExecutingTypeGetCodeTypeGetObjectTypeGetDelegateTypeGetChildrenTypeUpdateObjectTypeRegisterChildTypeSetRecordTypeValidateRecordTypeSetBlobTypeGetObjectIndexTypeGetPendingRequestsTypeHotRecordsTypeGetJetTypeAbandonedRequestsNotificationTypeGetRequestTypeGetPendingRequestIDTypeHeavyStartStopTypeHeavyPayloadTypeGenesisRequestTypeNodeSignRequestTypeSimulateCallTypeGetCallTreeTypeGetHeavyHistory"
 
-var _MessageType_index = [...]uint16{0, 14, 31, 50, 70, 91, 110, 128, 139, 152, 167, 182, 198, 215, 228, 246, 257, 275, 297, 311, 321, 354, 368, 391, 409, 425, 443, 462}
+var _MessageType_index = [...]uint16{0, 14, 31, 50, 70, 91, 110, 128, 139, 152, 167, 182, 198, 215, 228, 246, 257, 275, 297, 311, 321, 354, 368, 391, 409, 425, 443, 462, 478, 493, 512}
 
 func (i MessageType) String() string {
 	if i >= MessageType(len(_MessageType_index)-1) {
@@ -74,7 +74,7 @@ func NewDB(db store.DB) *DB {
 
 // ForPulseNumber returns pulse for provided a pulse number. If not found, ErrNotFound will be returned.
 func (s *DB) ForPulseNumber(ctx context.Context, pn insolar.PulseNumber) (pulse insolar.Pulse, err error) {
-	nd, err := s.get(pn)
+	nd, err := s.get(s.db, pn)
 	if err != nil {
 		return
 	}
@@ -86,11 +86,25 @@ func (s *DB) Latest(ctx context.Context) (pulse insolar.Pulse, err error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
-	head, err := s.head()
+	head, err := s.head(s.db)
 	if err != nil {
 		return
 	}
-	nd, err := s.get(head)
+	nd, err := s.get(s.db, head)
+	if err != nil {
+		return
+	}
+	return nd.Pulse, nil
+}
+
+// LatestIn returns a latest pulse saved in provided reader, e.g. a snapshot of the storage. If not found, ErrNotFound
+// will be returned.
+func (s *DB) LatestIn(ctx context.Context, r store.Reader) (pulse insolar.Pulse, err error) {
+	head, err := s.head(r)
+	if err != nil {
+		return
+	}
+	nd, err := s.get(r, head)
 	if err != nil {
 		return
 	}
@@ -103,50 +117,52 @@ func (s *DB) Append(ctx context.Context, pulse insolar.Pulse) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	var insertWithHead = func(head insolar.PulseNumber) error {
-		oldHead, err := s.get(head)
-		if err != nil {
-			return err
-		}
-		oldHead.Next = &pulse.PulseNumber
-
-		// Set new pulse.
-		err = s.set(pulse.PulseNumber, dbNode{
-			Prev:  &oldHead.Pulse.PulseNumber,
-			Pulse: pulse,
-		})
-		if err != nil {
-			return err
-		}
-		// Set old updated tail.
-		err = s.set(oldHead.Pulse.PulseNumber, oldHead)
-		if err != nil {
-			return err
+	return s.db.Update(func(txn store.Transaction) error {
+		var insertWithHead = func(head insolar.PulseNumber) error {
+			oldHead, err := s.get(txn, head)
+			if err != nil {
+				return err
+			}
+			oldHead.Next = &pulse.PulseNumber
+
+			// Set new pulse.
+			err = s.set(txn, pulse.PulseNumber, dbNode{
+				Prev:  &oldHead.Pulse.PulseNumber,
+				Pulse: pulse,
+			})
+			if err != nil {
+				return err
+			}
+			// Set old updated tail.
+			err = s.set(txn, oldHead.Pulse.PulseNumber, oldHead)
+			if err != nil {
+				return err
+			}
+			// Set head meta record.
+			return s.setHead(txn, pulse.PulseNumber)
 		}
-		// Set head meta record.
-		return s.setHead(pulse.PulseNumber)
-	}
-	var insertWithoutHead = func() error {
-		// Set new pulse.
-		err := s.set(pulse.PulseNumber, dbNode{
-			Pulse: pulse,
-		})
-		if err != nil {
-			return err
+		var insertWithoutHead = func() error {
+			// Set new pulse.
+			err := s.set(txn, pulse.PulseNumber, dbNode{
+				Pulse: pulse,
+			})
+			if err != nil {
+				return err
+			}
+			// Set head meta record.
+			return s.setHead(txn, pulse.PulseNumber)
 		}
-		// Set head meta record.
-		return s.setHead(pulse.PulseNumber)
-	}
 
-	head, err := s.head()
-	if err == ErrNotFound {
-		return insertWithoutHead()
-	}
+		head, err := s.head(txn)
+		if err == ErrNotFound {
+			return insertWithoutHead()
+		}
 
-	if pulse.PulseNumber <= head {
-		return ErrBadPulse
-	}
-	return insertWithHead(head)
+		if pulse.PulseNumber <= head {
+			return ErrBadPulse
+		}
+		return insertWithHead(head)
+	})
 }
 
 // Forwards calculates steps pulses forwards from provided pulse. If calculated pulse does not exist, ErrNotFound will
@@ -155,7 +171,7 @@ func (s *DB) Forwards(ctx context.Context, pn insolar.PulseNumber, steps int) (p
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
-	node, err := s.get(pn)
+	node, err := s.get(s.db, pn)
 	if err != nil {
 		return
 	}
@@ -166,7 +182,7 @@ func (s *DB) Forwards(ctx context.Context, pn insolar.PulseNumber, steps int) (p
 			err = ErrNotFound
 			return
 		}
-		iterator, err = s.get(*iterator.Next)
+		iterator, err = s.get(s.db, *iterator.Next)
 		if err != nil {
 			return
 		}
@@ -181,7 +197,7 @@ func (s *DB) Backwards(ctx context.Context, pn insolar.PulseNumber, steps int) (
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
-	node, err := s.get(pn)
+	node, err := s.get(s.db, pn)
 	if err != nil {
 		return
 	}
@@ -192,7 +208,7 @@ func (s *DB) Backwards(ctx context.Context, pn insolar.PulseNumber, steps int) (
 			err = ErrNotFound
 			return
 		}
-		iterator, err = s.get(*iterator.Prev)
+		iterator, err = s.get(s.db, *iterator.Prev)
 		if err != nil {
 			return
 		}
@@ -201,8 +217,8 @@ func (s *DB) Backwards(ctx context.Context, pn insolar.PulseNumber, steps int) (
 	return iterator.Pulse, nil
 }
 
-func (s *DB) get(pn insolar.PulseNumber) (nd dbNode, err error) {
-	buf, err := s.db.Get(pulseKey(pn))
+func (s *DB) get(r store.Reader, pn insolar.PulseNumber) (nd dbNode, err error) {
+	buf, err := r.Get(pulseKey(pn))
 	if err == store.ErrNotFound {
 		err = ErrNotFound
 		return
@@ -214,12 +230,12 @@ func (s *DB) get(pn insolar.PulseNumber) (nd dbNode, err error) {
 	return
 }
 
-func (s *DB) set(pn insolar.PulseNumber, nd dbNode) error {
-	return s.db.Set(pulseKey(pn), serialize(nd))
+func (s *DB) set(txn store.Transaction, pn insolar.PulseNumber, nd dbNode) error {
+	return txn.Set(pulseKey(pn), serialize(nd))
 }
 
-func (s *DB) head() (pn insolar.PulseNumber, err error) {
-	buf, err := s.db.Get(keyHead)
+func (s *DB) head(r store.Reader) (pn insolar.PulseNumber, err error) {
+	buf, err := r.Get(keyHead)
 	if err == store.ErrNotFound {
 		err = ErrNotFound
 		return
@@ -231,8 +247,8 @@ func (s *DB) head() (pn insolar.PulseNumber, err error) {
 	return
 }
 
-func (s *DB) setHead(pn insolar.PulseNumber) error {
-	return s.db.Set(keyHead, pn.Bytes())
+func (s *DB) setHead(txn store.Transaction, pn insolar.PulseNumber) error {
+	return txn.Set(keyHead, pn.Bytes())
 }
 
 func serialize(nd dbNode) []byte {
@@ -116,9 +116,10 @@ func (m *Genesis) XXX_DiscardUnknown() {
 var xxx_messageInfo_Genesis proto.InternalMessageInfo
 
 type Child struct {
-	Polymorph int32                                        `protobuf:"varint,16,opt,name=polymorph,proto3" json:"polymorph,omitempty"`
-	PrevChild github_com_insolar_insolar_insolar.ID        `protobuf:"bytes,20,opt,name=PrevChild,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"PrevChild"`
-	Ref       github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,21,opt,name=Ref,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Ref"`
+	Polymorph int32                                         `protobuf:"varint,16,opt,name=polymorph,proto3" json:"polymorph,omitempty"`
+	PrevChild github_com_insolar_insolar_insolar.ID         `protobuf:"bytes,20,opt,name=PrevChild,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"PrevChild"`
+	Ref       github_com_insolar_insolar_insolar.Reference  `protobuf:"bytes,21,opt,name=Ref,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Ref"`
+	Prototype *github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,22,opt,name=Prototype,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Prototype,omitempty"`
 }
 
 func (m *Child) Reset()      { *m = Child{} }
@@ -939,75 +940,75 @@ func init() {
 func init() { proto.RegisterFile("insolar/record/record.proto", fileDescriptor_0c86cc3f6f53fe45) }
 
 var fileDescriptor_0c86cc3f6f53fe45 = []byte{
-	// 1076 bytes of a gzipped FileDescriptorProto
+	// 1079 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xde, 0x89, 0x7f, 0xc4, 0x79, 0x49, 0x5a, 0x33, 0x0a, 0x61, 0x9a, 0xb6, 0x1b, 0xcb, 0x52,
-	0x24, 0x57, 0xa5, 0x4e, 0x15, 0x2a, 0x84, 0xb8, 0x39, 0x76, 0x8b, 0x1d, 0xea, 0x10, 0x4d, 0x2c,
-	0xe0, 0x84, 0x34, 0xb1, 0x27, 0xf6, 0x96, 0xf5, 0x6e, 0xd8, 0x9d, 0x8d, 0x94, 0x1b, 0x7f, 0x02,
-	0x17, 0x4e, 0x5c, 0x38, 0xf6, 0x6f, 0xe0, 0xc4, 0x05, 0x29, 0xc7, 0x1c, 0x2b, 0x24, 0x2a, 0xe2,
-	0x08, 0x09, 0x6e, 0x11, 0x7f, 0x01, 0x9a, 0x1f, 0xbb, 0xeb, 0x44, 0xa8, 0x4e, 0x1d, 0x84, 0x54,
-	0xc4, 0xc9, 0x3b, 0x6f, 0xbf, 0xf9, 0xbc, 0xdf, 0x37, 0xf3, 0xde, 0xbc, 0x81, 0xdb, 0x8e, 0x17,
-	0xfa, 0x2e, 0x0b, 0xd6, 0x03, 0xde, 0xf5, 0x83, 0x9e, 0xf9, 0xa9, 0x1e, 0x04, 0xbe, 0xf0, 0x71,
-	0x5e, 0x8f, 0x56, 0x1e, 0xf4, 0x1d, 0x31, 0x88, 0xf6, 0xaa, 0x5d, 0x7f, 0xb8, 0xde, 0xf7, 0xfb,
-	0xfe, 0xba, 0x7a, 0xbd, 0x17, 0xed, 0xab, 0x91, 0x1a, 0xa8, 0x27, 0x3d, 0xad, 0x5c, 0x83, 0xd9,
-	0x8f, 0xb8, 0xc7, 0x43, 0x27, 0xc4, 0x77, 0x60, 0xee, 0xc0, 0x77, 0x8f, 0x86, 0x7e, 0x70, 0x30,
-	0x20, 0xc5, 0x12, 0xaa, 0xe4, 0x68, 0x1a, 0xc0, 0x18, 0xb2, 0x4d, 0x16, 0x0e, 0xc8, 0x52, 0x09,
-	0x55, 0x16, 0xa8, 0x7a, 0xfe, 0x30, 0xfb, 0xfc, 0xfb, 0x55, 0x54, 0xfe, 0x11, 0x41, 0xae, 0x3e,
-	0x70, 0xdc, 0xde, 0x04, 0x86, 0x8f, 0x61, 0x6e, 0x27, 0xe0, 0x87, 0x0a, 0xaa, 0x69, 0x36, 0x1f,
-	0x1c, 0xbf, 0x5c, 0xb5, 0x7e, 0x7e, 0xb9, 0xba, 0x36, 0xf6, 0xd1, 0xb1, 0xc8, 0x4b, 0xbf, 0xd5,
-	0x56, 0x83, 0xa6, 0xf3, 0xf1, 0x13, 0xc8, 0x50, 0xbe, 0x4f, 0xde, 0x56, 0x34, 0x8f, 0x0c, 0xcd,
-	0xbb, 0x57, 0xa0, 0xa1, 0x7c, 0x9f, 0x07, 0xdc, 0xeb, 0x72, 0x2a, 0x09, 0x8c, 0x84, 0x7b, 0x90,
-	0xd9, 0xe2, 0xe2, 0xd5, 0xdf, 0x6f, 0xa0, 0xdf, 0xe5, 0x61, 0x96, 0xf2, 0xaf, 0x22, 0x1e, 0x4e,
-	0xc0, 0xe3, 0x2a, 0x14, 0xea, 0xcc, 0x75, 0x3b, 0x47, 0x07, 0x5c, 0xc9, 0xbd, 0xb1, 0x81, 0xab,
-	0x66, 0xc9, 0x0c, 0x41, 0xb5, 0xde, 0xa1, 0x09, 0x06, 0x3f, 0x85, 0xbc, 0x7c, 0xe6, 0xc1, 0xb5,
-	0x54, 0x19, 0x0e, 0xfc, 0x05, 0xdc, 0xd4, 0x4f, 0x3b, 0x72, 0x9d, 0x85, 0xfc, 0x88, 0xe5, 0x6b,
-	0xd0, 0x5e, 0x26, 0xc3, 0x4b, 0x90, 0xdb, 0xf6, 0xbd, 0x2e, 0x27, 0xef, 0x94, 0x50, 0x25, 0x4b,
-	0xf5, 0x00, 0xaf, 0x40, 0x61, 0x57, 0x6a, 0x93, 0x2f, 0x88, 0x7a, 0x91, 0x8c, 0xf1, 0x06, 0x00,
-	0xe5, 0x22, 0x0a, 0xbc, 0xb6, 0xdf, 0xe3, 0xe4, 0xd6, 0xdf, 0x3b, 0x42, 0xdb, 0x74, 0x0c, 0x25,
-	0x1d, 0x6e, 0x0d, 0x87, 0x91, 0x60, 0x7b, 0x2e, 0x27, 0x2b, 0x25, 0x54, 0x29, 0xd0, 0x34, 0x80,
-	0x1b, 0x90, 0xdd, 0x64, 0x21, 0x27, 0xb7, 0x95, 0xb0, 0x87, 0xaf, 0x2d, 0x4a, 0xcd, 0xc6, 0x4d,
-	0xc8, 0x7f, 0xb2, 0xf7, 0x8c, 0x77, 0x05, 0xb9, 0x33, 0x25, 0x8f, 0x99, 0x8f, 0xb7, 0xe5, 0x0e,
-	0x8f, 0xdd, 0xbe, 0x3b, 0x25, 0x59, 0x4a, 0x81, 0x97, 0x21, 0xdf, 0xe6, 0x62, 0xe0, 0xf7, 0x88,
-	0x5d, 0x42, 0x95, 0x39, 0x6a, 0x46, 0xd2, 0x95, 0x5a, 0xd0, 0x8f, 0x86, 0xdc, 0x13, 0x21, 0x59,
-	0x55, 0x09, 0x99, 0x06, 0xca, 0x5b, 0x30, 0x53, 0xef, 0xe0, 0x05, 0x28, 0xd4, 0x3b, 0x1a, 0x5f,
-	0xb4, 0xf0, 0x5b, 0xb0, 0x58, 0xef, 0xec, 0xb2, 0x43, 0x5e, 0x0b, 0x55, 0xfe, 0x14, 0x11, 0x5e,
-	0x82, 0x62, 0x1c, 0x6a, 0x70, 0x97, 0xf7, 0x99, 0xe0, 0xc5, 0x19, 0xbc, 0x08, 0x73, 0xf5, 0x8e,
-	0xa9, 0x08, 0xc5, 0x4c, 0xb9, 0x02, 0x33, 0xb4, 0x8d, 0x8b, 0xb0, 0xa0, 0xd7, 0x84, 0xf2, 0x30,
-	0x72, 0x45, 0xd1, 0x4a, 0x23, 0xdb, 0xfe, 0x67, 0xcc, 0x11, 0x45, 0x64, 0xb2, 0xe3, 0x17, 0x04,
-	0x79, 0x0d, 0x9a, 0x90, 0x1c, 0x8f, 0x13, 0xd3, 0xa7, 0xaa, 0x04, 0xa9, 0xe3, 0x71, 0x32, 0x5e,
-	0x2b, 0x69, 0x92, 0x8c, 0x26, 0x30, 0xbb, 0xc3, 0x8e, 0x5c, 0x9f, 0xf5, 0x74, 0xb6, 0xd0, 0x78,
-	0x68, 0xf4, 0xfd, 0x89, 0x20, 0xab, 0x92, 0xf5, 0xd5, 0xea, 0x9e, 0x42, 0xbe, 0xe1, 0x0f, 0x99,
-	0xe3, 0x19, 0x75, 0x53, 0xa6, 0xb2, 0xe6, 0xf8, 0xc7, 0x45, 0x56, 0xe0, 0xa6, 0xd4, 0xd0, 0xe0,
-	0x5d, 0x97, 0x05, 0x4c, 0x38, 0xbe, 0x67, 0xc4, 0x5e, 0x0e, 0x1b, 0xd1, 0xbf, 0xcd, 0x40, 0xb6,
-	0x6e, 0xb2, 0xf1, 0x8d, 0x15, 0x5d, 0xd3, 0x1a, 0x4c, 0x11, 0x7c, 0xcd, 0xed, 0xa6, 0xe5, 0x7f,
-	0x0e, 0xf3, 0x6d, 0xd6, 0x1d, 0x38, 0x1e, 0x57, 0x35, 0x5d, 0x16, 0xbe, 0xc5, 0xcd, 0xf7, 0x0d,
-	0x53, 0xf5, 0x0a, 0x4c, 0x63, 0xb3, 0xe9, 0x38, 0x95, 0xf1, 0xf9, 0x8f, 0x0c, 0x14, 0x6a, 0x5d,
-	0xe1, 0x1c, 0x32, 0xf1, 0x66, 0x7b, 0xfd, 0x58, 0xd6, 0xad, 0xa1, 0x1f, 0x1c, 0x4d, 0xe7, 0xb6,
-	0x99, 0x8c, 0xb7, 0x20, 0xd7, 0x1a, 0xb2, 0xbe, 0x76, 0x7a, 0xda, 0x8f, 0xd2, 0x14, 0xb8, 0x04,
-	0xf3, 0xad, 0x30, 0x2d, 0xce, 0x44, 0x1d, 0x25, 0xe3, 0x21, 0x69, 0xe9, 0x0e, 0x0b, 0xb8, 0x27,
-	0xd4, 0xd1, 0x34, 0xb5, 0xa5, 0x9a, 0x03, 0xdb, 0x00, 0xad, 0xa4, 0xae, 0x9a, 0x93, 0x6b, 0x2c,
-	0x52, 0xfe, 0x29, 0x03, 0xb9, 0xda, 0x90, 0x7b, 0xbd, 0xff, 0x17, 0xfa, 0xdf, 0x5e, 0x68, 0xd3,
-	0x87, 0xee, 0x0a, 0xb9, 0x32, 0xb7, 0xa6, 0xee, 0x43, 0xd5, 0xfc, 0xf2, 0xb7, 0x33, 0x00, 0x0d,
-	0xce, 0xfe, 0x0b, 0x59, 0x7b, 0xc1, 0x97, 0xe5, 0x6b, 0xfa, 0x72, 0x9e, 0x81, 0xd9, 0x4f, 0x9d,
-	0x40, 0x44, 0xcc, 0x9d, 0x60, 0xca, 0xfd, 0xe4, 0x06, 0x42, 0x78, 0x09, 0x55, 0xe6, 0x37, 0x6e,
-	0xc6, 0x3d, 0xa1, 0x09, 0x37, 0x2d, 0x9a, 0xdc, 0x51, 0xd6, 0xcc, 0x55, 0x83, 0xec, 0x2b, 0xe8,
-	0x62, 0x0c, 0x55, 0xc1, 0xa6, 0x45, 0xcd, 0x45, 0x64, 0x55, 0xf5, 0xf3, 0xa4, 0xaf, 0x40, 0xf3,
-	0x31, 0x68, 0x8b, 0x8b, 0xa6, 0x45, 0x55, 0xa7, 0x7f, 0x3f, 0xf5, 0x6e, 0x70, 0xf1, 0x4f, 0x4d,
-	0x58, 0xfe, 0x69, 0x7a, 0x5e, 0x9a, 0x9e, 0x86, 0x38, 0x0a, 0x7b, 0x23, 0xc5, 0xca, 0x68, 0xd3,
-	0xa2, 0x71, 0xcf, 0x53, 0xd6, 0xdd, 0x01, 0x79, 0xa6, 0x70, 0x0b, 0x31, 0x4e, 0xc6, 0x9a, 0x16,
-	0xd5, 0x9d, 0x43, 0xd9, 0x1c, 0x44, 0x5f, 0x5e, 0xc4, 0xc8, 0x98, 0xc4, 0xa8, 0x93, 0xa6, 0x9a,
-	0x1e, 0x04, 0xc4, 0x55, 0xb8, 0x62, 0x8c, 0x8b, 0xe3, 0x4d, 0x8b, 0xa6, 0x87, 0xc5, 0x9a, 0x29,
-	0x26, 0x64, 0x78, 0xd1, 0x16, 0x15, 0x94, 0xb6, 0xe8, 0x52, 0xf3, 0x68, 0x7c, 0xaf, 0x12, 0x4f,
-	0x61, 0x93, 0x0e, 0x3c, 0x7d, 0xd3, 0xb4, 0xe8, 0xf8, 0x9e, 0xbe, 0x0b, 0x73, 0xbb, 0x4e, 0xdf,
-	0x63, 0x22, 0x0a, 0x38, 0x39, 0x46, 0xba, 0xdd, 0x4c, 0x22, 0x9b, 0xb3, 0x90, 0x8b, 0x3c, 0xc7,
-	0xf7, 0xca, 0x3f, 0x20, 0x28, 0xb4, 0x99, 0xe0, 0x81, 0x33, 0x71, 0xcd, 0xef, 0x25, 0x9b, 0x43,
-	0x65, 0xc2, 0x98, 0xfd, 0x26, 0x4c, 0x93, 0xcd, 0xf3, 0x04, 0x72, 0x5b, 0x5c, 0xb4, 0x1a, 0x66,
-	0x8f, 0x3f, 0x34, 0x3b, 0xb2, 0x72, 0x85, 0x1d, 0xa9, 0xe6, 0x51, 0x3d, 0x7d, 0x92, 0x8a, 0x0f,
-	0x8e, 0x4f, 0x6d, 0xeb, 0xe4, 0xd4, 0xb6, 0x5e, 0x9c, 0xda, 0xd6, 0xf9, 0xa9, 0x8d, 0xbe, 0x1e,
-	0xd9, 0xe8, 0xf9, 0xc8, 0x46, 0xc7, 0x23, 0x1b, 0x9d, 0x8c, 0x6c, 0xf4, 0xeb, 0xc8, 0x46, 0xbf,
-	0x8f, 0x6c, 0xeb, 0x7c, 0x64, 0xa3, 0x6f, 0xce, 0x6c, 0xeb, 0xe4, 0xcc, 0xb6, 0x5e, 0x9c, 0xd9,
-	0xd6, 0x5e, 0x5e, 0x5d, 0xa4, 0xdf, 0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x75, 0x52, 0x0c, 0x3f,
-	0x9e, 0x0f, 0x00, 0x00,
+	0x14, 0xde, 0xf1, 0xaf, 0x38, 0x2f, 0x49, 0x6b, 0x46, 0x21, 0x4c, 0xd2, 0x76, 0x63, 0x59, 0x8a,
+	0xe4, 0x0a, 0xea, 0x54, 0xa1, 0x42, 0x88, 0x9b, 0x63, 0xb7, 0xd8, 0xa1, 0x0e, 0xd1, 0xc4, 0x02,
+	0x4e, 0x48, 0x13, 0x7b, 0x62, 0x6f, 0x59, 0xef, 0x84, 0xf5, 0x6c, 0xa4, 0xdc, 0xf8, 0x13, 0x38,
+	0xc0, 0x89, 0x0b, 0xc7, 0xfe, 0x0d, 0xdc, 0x91, 0x72, 0xcc, 0xb1, 0x42, 0xa2, 0x22, 0x8e, 0x90,
+	0xe0, 0x16, 0xf1, 0x17, 0xa0, 0x99, 0x9d, 0xdd, 0x75, 0xa2, 0xaa, 0x4e, 0x1c, 0x84, 0x54, 0xc4,
+	0x69, 0x77, 0xbe, 0xf9, 0xe6, 0xdb, 0x79, 0xdf, 0xbc, 0x37, 0x33, 0x0b, 0x77, 0x1c, 0x6f, 0x28,
+	0x5c, 0xe6, 0xaf, 0xfb, 0xbc, 0x23, 0xfc, 0xae, 0x79, 0x54, 0x0e, 0x7c, 0x21, 0x05, 0xce, 0x85,
+	0xad, 0x95, 0x07, 0x3d, 0x47, 0xf6, 0x83, 0xbd, 0x4a, 0x47, 0x0c, 0xd6, 0x7b, 0xa2, 0x27, 0xd6,
+	0x75, 0xf7, 0x5e, 0xb0, 0xaf, 0x5b, 0xba, 0xa1, 0xdf, 0xc2, 0x61, 0xa5, 0x2a, 0xcc, 0x7c, 0xcc,
+	0x3d, 0x3e, 0x74, 0x86, 0xf8, 0x2e, 0xcc, 0x1e, 0x08, 0xf7, 0x68, 0x20, 0xfc, 0x83, 0x3e, 0x29,
+	0x14, 0x51, 0x39, 0x4b, 0x13, 0x00, 0x63, 0xc8, 0x34, 0xd8, 0xb0, 0x4f, 0x16, 0x8b, 0xa8, 0x3c,
+	0x4f, 0xf5, 0xfb, 0x47, 0x99, 0xe7, 0x3f, 0xae, 0xa2, 0xd2, 0x77, 0x29, 0xc8, 0xd6, 0xfa, 0x8e,
+	0xdb, 0x9d, 0xa0, 0xf0, 0x09, 0xcc, 0xee, 0xf8, 0xfc, 0x50, 0x53, 0x43, 0x99, 0xcd, 0x07, 0xc7,
+	0x2f, 0x57, 0xad, 0x5f, 0x5e, 0xae, 0xae, 0x8d, 0x4d, 0x3a, 0x0a, 0xf2, 0xd2, 0xb3, 0xd2, 0xac,
+	0xd3, 0x64, 0x3c, 0x7e, 0x02, 0x69, 0xca, 0xf7, 0xc9, 0xdb, 0x5a, 0xe6, 0x91, 0x91, 0x79, 0xef,
+	0x0a, 0x32, 0x94, 0xef, 0x73, 0x9f, 0x7b, 0x1d, 0x4e, 0x95, 0x00, 0xa6, 0x6a, 0x52, 0x42, 0x0a,
+	0x79, 0x74, 0xc0, 0xc9, 0x52, 0xac, 0x86, 0xae, 0xad, 0x96, 0xc8, 0x18, 0x5b, 0xee, 0x43, 0x7a,
+	0x8b, 0xcb, 0xd7, 0x7b, 0x62, 0xa8, 0x3f, 0xe4, 0x60, 0x86, 0xf2, 0xaf, 0x03, 0x3e, 0x9c, 0xc0,
+	0xc7, 0x15, 0xc8, 0xd7, 0x98, 0xeb, 0xb6, 0xd5, 0x6c, 0x95, 0x85, 0xb7, 0x36, 0x70, 0xc5, 0xa4,
+	0x81, 0x11, 0xa8, 0xd4, 0xda, 0x34, 0xe6, 0xe0, 0xa7, 0x90, 0x53, 0xef, 0xdc, 0xbf, 0x91, 0x53,
+	0x46, 0x03, 0x7f, 0x09, 0xb7, 0xc3, 0xb7, 0x57, 0x59, 0x76, 0x7d, 0xd9, 0xcb, 0x62, 0x78, 0x11,
+	0xb2, 0xdb, 0xc2, 0xeb, 0x70, 0xf2, 0x4e, 0x11, 0x95, 0x33, 0x34, 0x6c, 0xe0, 0x15, 0xc8, 0xef,
+	0xaa, 0xd8, 0x54, 0x07, 0xd1, 0x1d, 0x71, 0x1b, 0x6f, 0x00, 0x50, 0x2e, 0x03, 0xdf, 0x6b, 0x89,
+	0x2e, 0x27, 0xcb, 0xaf, 0x76, 0x84, 0xb6, 0xe8, 0x18, 0x4b, 0x39, 0xdc, 0x1c, 0x0c, 0x02, 0xc9,
+	0xf6, 0x5c, 0x4e, 0x56, 0x8a, 0xa8, 0x9c, 0xa7, 0x09, 0x80, 0xeb, 0x90, 0xd9, 0x64, 0x43, 0x4e,
+	0xee, 0xe8, 0xc0, 0x1e, 0x5e, 0x3b, 0x28, 0x3d, 0x1a, 0x37, 0x20, 0xf7, 0xe9, 0xde, 0x33, 0xde,
+	0x91, 0xe4, 0xee, 0x94, 0x3a, 0x66, 0x3c, 0xde, 0x1e, 0x4f, 0xd0, 0x7b, 0x53, 0x8a, 0x25, 0x12,
+	0x78, 0x09, 0x72, 0x2d, 0x2e, 0xfb, 0xa2, 0x4b, 0xec, 0x22, 0x2a, 0xcf, 0x52, 0xd3, 0x52, 0xae,
+	0x54, 0xfd, 0x5e, 0x30, 0xe0, 0x9e, 0x1c, 0x92, 0x55, 0x5d, 0xe4, 0x09, 0x50, 0xda, 0x82, 0x54,
+	0xad, 0x8d, 0xe7, 0x21, 0x5f, 0x6b, 0x87, 0xfc, 0x82, 0x85, 0xdf, 0x82, 0x85, 0x5a, 0x7b, 0x97,
+	0x1d, 0xf2, 0xea, 0x50, 0xd7, 0x64, 0x01, 0xe1, 0x45, 0x28, 0x44, 0x50, 0x9d, 0xbb, 0xbc, 0xc7,
+	0x24, 0x2f, 0xa4, 0xf0, 0x02, 0xcc, 0xd6, 0xda, 0x66, 0x97, 0x29, 0xa4, 0x4b, 0x65, 0x48, 0xd1,
+	0x16, 0x2e, 0xc0, 0x7c, 0xb8, 0x26, 0x94, 0x0f, 0x03, 0x57, 0x16, 0xac, 0x04, 0xd9, 0x16, 0x9f,
+	0x33, 0x47, 0x16, 0x90, 0xa9, 0x8e, 0x5f, 0x11, 0xe4, 0x42, 0xd2, 0x84, 0xe2, 0x78, 0x1c, 0x9b,
+	0x3e, 0xd5, 0xee, 0x92, 0x38, 0x1e, 0x15, 0xe3, 0x8d, 0x8a, 0x26, 0xae, 0x68, 0x02, 0x33, 0x3b,
+	0xec, 0xc8, 0x15, 0xac, 0x1b, 0x56, 0x0b, 0x8d, 0x9a, 0x26, 0xbe, 0xbf, 0x10, 0x64, 0x74, 0xb1,
+	0xbe, 0x3e, 0xba, 0xa7, 0x90, 0xab, 0x8b, 0x01, 0x73, 0x3c, 0xb2, 0x78, 0x83, 0x59, 0x19, 0x8d,
+	0x7f, 0x3c, 0xc8, 0x32, 0xdc, 0x56, 0x31, 0xd4, 0x79, 0xc7, 0x65, 0x3e, 0x93, 0x8e, 0xf0, 0x4c,
+	0xb0, 0x97, 0x61, 0x13, 0xf4, 0xef, 0x29, 0xc8, 0xd4, 0x4c, 0x35, 0xbe, 0xb1, 0x41, 0x57, 0xc3,
+	0x18, 0xc8, 0xd2, 0x34, 0xe9, 0x16, 0x86, 0xff, 0x05, 0xcc, 0xb5, 0x58, 0xa7, 0xef, 0x78, 0x5c,
+	0xef, 0xe9, 0x6a, 0xe3, 0x5b, 0xd8, 0xfc, 0xc0, 0x28, 0x55, 0xae, 0xa0, 0x34, 0x36, 0x9a, 0x8e,
+	0x4b, 0x19, 0x9f, 0xff, 0x4c, 0x43, 0xbe, 0xda, 0x91, 0xce, 0x21, 0x93, 0x6f, 0xb6, 0xd7, 0x8f,
+	0xd5, 0xbe, 0x35, 0x10, 0xfe, 0xd1, 0x74, 0x6e, 0x9b, 0xc1, 0x78, 0x0b, 0xb2, 0xcd, 0x01, 0xeb,
+	0x85, 0x4e, 0x4f, 0x3b, 0xa9, 0x50, 0x02, 0x17, 0x61, 0xae, 0x39, 0x4c, 0x36, 0x67, 0xa2, 0x8f,
+	0x92, 0x71, 0x48, 0x59, 0xba, 0xc3, 0x7c, 0xee, 0x49, 0xb2, 0x7c, 0x83, 0xcf, 0x19, 0x0d, 0x6c,
+	0x03, 0x34, 0xe3, 0x7d, 0xd5, 0x9c, 0x5c, 0x63, 0x48, 0xe9, 0xe7, 0x34, 0x64, 0xab, 0x03, 0xee,
+	0x75, 0xff, 0x5f, 0xe8, 0x7f, 0x7b, 0xa1, 0xcd, 0xdd, 0x76, 0x57, 0xaa, 0x95, 0x59, 0x9e, 0xfa,
+	0x6e, 0xab, 0xc7, 0x97, 0xbe, 0x4f, 0x01, 0xd4, 0x39, 0xfb, 0x2f, 0x54, 0xed, 0x05, 0x5f, 0x96,
+	0x6e, 0xe8, 0xcb, 0x79, 0x1a, 0x66, 0x3e, 0x73, 0x7c, 0x19, 0x30, 0x77, 0x82, 0x29, 0xef, 0xc6,
+	0x7f, 0x35, 0x84, 0x17, 0x51, 0x79, 0x6e, 0xe3, 0x76, 0x74, 0x27, 0x34, 0x70, 0xc3, 0xa2, 0x11,
+	0x03, 0xaf, 0x99, 0xdf, 0x17, 0xb2, 0xaf, 0xa9, 0x0b, 0x11, 0x55, 0x83, 0x0d, 0x8b, 0x86, 0xbd,
+	0x78, 0x55, 0xdf, 0xe7, 0x49, 0x4f, 0x93, 0xe6, 0x22, 0xd2, 0x16, 0x97, 0x0d, 0x8b, 0xaa, 0x1e,
+	0xf5, 0xd1, 0xc8, 0xbb, 0xfe, 0xc5, 0x8f, 0x1a, 0x58, 0x7d, 0x34, 0x39, 0x2f, 0xcd, 0x9d, 0x86,
+	0x38, 0x9a, 0x7b, 0x2b, 0xe1, 0x2a, 0xb4, 0x61, 0x51, 0xd3, 0x8f, 0x4b, 0xe1, 0xed, 0x80, 0x3c,
+	0xd3, 0xbc, 0xf9, 0x88, 0xa7, 0xb0, 0x86, 0x45, 0x75, 0x9f, 0xe2, 0xe8, 0x83, 0xe8, 0xab, 0x8b,
+	0x1c, 0x85, 0x29, 0x8e, 0x7a, 0xe2, 0x4a, 0x72, 0x10, 0x10, 0x57, 0xf3, 0x0a, 0x11, 0x2f, 0xc2,
+	0x1b, 0x16, 0x4d, 0x0e, 0x8b, 0x35, 0xb3, 0x99, 0x90, 0xc1, 0x45, 0x5b, 0x34, 0xa8, 0x6c, 0xd1,
+	0x2f, 0xf8, 0xd1, 0x78, 0xae, 0x12, 0x4f, 0x73, 0xe3, 0x1b, 0x78, 0xd2, 0xd3, 0xb0, 0xe8, 0x78,
+	0x4e, 0xdf, 0x83, 0xd9, 0x5d, 0xa7, 0xe7, 0x31, 0x19, 0xf8, 0x9c, 0x1c, 0xa3, 0xf0, 0xba, 0x19,
+	0x23, 0x9b, 0x33, 0x90, 0x0d, 0x3c, 0x47, 0x78, 0xa5, 0x9f, 0x10, 0xe4, 0x5b, 0x4c, 0x72, 0xdf,
+	0x99, 0xb8, 0xe6, 0xf7, 0xe3, 0xe4, 0x20, 0x8b, 0x17, 0xed, 0x37, 0x30, 0x8d, 0x93, 0xe7, 0x09,
+	0x64, 0xb7, 0xb8, 0x6c, 0xd6, 0x4d, 0x8e, 0x3f, 0x34, 0x19, 0x59, 0xbe, 0x42, 0x46, 0xea, 0x71,
+	0x34, 0x1c, 0x3e, 0x29, 0x8a, 0x0f, 0x8f, 0x4f, 0x6d, 0xeb, 0xe4, 0xd4, 0xb6, 0x5e, 0x9c, 0xda,
+	0xd6, 0xf9, 0xa9, 0x8d, 0xbe, 0x19, 0xd9, 0xe8, 0xf9, 0xc8, 0x46, 0xc7, 0x23, 0x1b, 0x9d, 0x8c,
+	0x6c, 0xf4, 0xdb, 0xc8, 0x46, 0x7f, 0x8c, 0x6c, 0xeb, 0x7c, 0x64, 0xa3, 0x6f, 0xcf, 0x6c, 0xeb,
+	0xe4, 0xcc, 0xb6, 0x5e, 0x9c, 0xd9, 0xd6, 0x5e, 0x4e, 0xff, 0x9c, 0xbf, 0xff, 0xf7, 0x00, 0x4e,
+	0xee, 0x61, 0xba, 0xf2, 0x0f, 0x00, 0x00,
 }
 
 func (x Request_CT) String() string {
@@ -1079,6 +1080,13 @@ func (this *Child) Equal(that interface{}) bool {
 	if !this.Ref.Equal(that1.Ref) {
 		return false
 	}
+	if that1.Prototype == nil {
+		if this.Prototype != nil {
+			return false
+		}
+	} else if !this.Prototype.Equal(*that1.Prototype) {
+		return false
+	}
 	return true
 }
 func (this *Jet) Equal(that interface{}) bool {
@@ -1743,6 +1751,7 @@ type ChildFace interface {
 	GetPolymorph() int32
 	GetPrevChild() github_com_insolar_insolar_insolar.ID
 	GetRef() github_com_insolar_insolar_insolar.Reference
+	GetPrototype() *github_com_insolar_insolar_insolar.Reference
 }
 
 func (this *Child) Proto() github_com_gogo_protobuf_proto.Message {
@@ -1765,11 +1774,16 @@ func (this *Child) GetRef() github_com_insolar_insolar_insolar.Reference {
 	return this.Ref
 }
 
+func (this *Child) GetPrototype() *github_com_insolar_insolar_insolar.Reference {
+	return this.Prototype
+}
+
 func NewChildFromFace(that ChildFace) *Child {
 	this := &Child{}
 	this.Polymorph = that.GetPolymorph()
 	this.PrevChild = that.GetPrevChild()
 	this.Ref = that.GetRef()
+	this.Prototype = that.GetPrototype()
 	return this
 }
 
@@ -2035,11 +2049,12 @@ func (this *Child) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&record.Child{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "PrevChild: "+fmt.Sprintf("%#v", this.PrevChild)+",\n")
 	s = append(s, "Ref: "+fmt.Sprintf("%#v", this.Ref)+",\n")
+	s = append(s, "Prototype: "+fmt.Sprintf("%#v", this.Prototype)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2353,6 +2368,18 @@ func (m *Child) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n2
+	if m.Prototype != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.Prototype.Size()))
+		n3, err := m.Prototype.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
 	return i, nil
 }
 
@@ -2415,21 +2442,21 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Caller.Size()))
-	n3, err := m.Caller.MarshalTo(dAtA[i:])
+	n4, err := m.Caller.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n4
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.CallerPrototype.Size()))
-	n4, err := m.CallerPrototype.MarshalTo(dAtA[i:])
+	n5, err := m.CallerPrototype.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	if m.Nonce != 0 {
 		dAtA[i] = 0xb8
 		i++
@@ -2469,11 +2496,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Base.Size()))
-		n5, err := m.Base.MarshalTo(dAtA[i:])
+		n6, err := m.Base.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	if m.Object != nil {
 		dAtA[i] = 0xe2
@@ -2481,11 +2508,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Object.Size()))
-		n6, err := m.Object.MarshalTo(dAtA[i:])
+		n7, err := m.Object.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	if m.Prototype != nil {
 		dAtA[i] = 0xea
@@ -2493,11 +2520,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Prototype.Size()))
-		n7, err := m.Prototype.MarshalTo(dAtA[i:])
+		n8, err := m.Prototype.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	if len(m.Method) > 0 {
 		dAtA[i] = 0xf2
@@ -2545,21 +2572,21 @@ func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Object.Size()))
-	n8, err := m.Object.MarshalTo(dAtA[i:])
+	n9, err := m.Object.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-	n9, err := m.Request.MarshalTo(dAtA[i:])
+	n10, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n10
 	if len(m.Payload) > 0 {
 		dAtA[i] = 0xb2
 		i++
@@ -2598,21 +2625,21 @@ func (m *Type) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Domain.Size()))
-	n10, err := m.Domain.MarshalTo(dAtA[i:])
+	n11, err := m.Domain.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n11
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-	n11, err := m.Request.MarshalTo(dAtA[i:])
+	n12, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n12
 	if len(m.TypeDeclaration) > 0 {
 		dAtA[i] = 0xb2
 		i++
@@ -2651,31 +2678,31 @@ func (m *Code) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Domain.Size()))
-	n12, err := m.Domain.MarshalTo(dAtA[i:])
+	n13, err := m.Domain.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n13
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-	n13, err := m.Request.MarshalTo(dAtA[i:])
+	n14, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n14
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Code.Size()))
-	n14, err := m.Code.MarshalTo(dAtA[i:])
+	n15, err := m.Code.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n15
 	if m.MachineType != 0 {
 		dAtA[i] = 0xb8
 		i++
@@ -2713,41 +2740,41 @@ func (m *Activate) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Domain.Size()))
-	n15, err := m.Domain.MarshalTo(dAtA[i:])
+	n16, err := m.Domain.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n16
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-	n16, err := m.Request.MarshalTo(dAtA[i:])
+	n17, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n17
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Memory.Size()))
-	n17, err := m.Memory.MarshalTo(dAtA[i:])
+	n18, err := m.Memory.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n18
 	dAtA[i] = 0xba
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Image.Size()))
-	n18, err := m.Image.MarshalTo(dAtA[i:])
+	n19, err := m.Image.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n19
 	if m.IsPrototype {
 		dAtA[i] = 0xc0
 		i++
@@ -2765,11 +2792,11 @@ func (m *Activate) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Parent.Size()))
-	n19, err := m.Parent.MarshalTo(dAtA[i:])
+	n20, err := m.Parent.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n20
 	if m.IsDelegate {
 		dAtA[i] = 0xd0
 		i++
@@ -2812,41 +2839,41 @@ func (m *Amend) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Domain.Size()))
-	n20, err := m.Domain.MarshalTo(dAtA[i:])
+	n21, err := m.Domain.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n21
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-	n21, err := m.Request.MarshalTo(dAtA[i:])
+	n22, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n22
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Memory.Size()))
-	n22, err := m.Memory.MarshalTo(dAtA[i:])
+	n23, err := m.Memory.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n23
 	dAtA[i] = 0xba
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Image.Size()))
-	n23, err := m.Image.MarshalTo(dAtA[i:])
+	n24, err := m.Image.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n24
 	if m.IsPrototype {
 		dAtA[i] = 0xc0
 		i++
@@ -2864,11 +2891,11 @@ func (m *Amend) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.PrevState.Size()))
-	n24, err := m.PrevState.MarshalTo(dAtA[i:])
+	n25, err := m.PrevState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n25
 	return i, nil
 }
 
@@ -2899,31 +2926,31 @@ func (m *Deactivate) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Domain.Size()))
-	n25, err := m.Domain.MarshalTo(dAtA[i:])
+	n26, err := m.Domain.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n26
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-	n26, err := m.Request.MarshalTo(dAtA[i:])
+	n27, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n27
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.PrevState.Size()))
-	n27, err := m.PrevState.MarshalTo(dAtA[i:])
+	n28, err := m.PrevState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n27
+	i += n28
 	return i, nil
 }
 
@@ -2950,11 +2977,11 @@ func (m *Virtual) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRecord(dAtA, i, uint64(m.Polymorph))
 	}
 	if m.Union != nil {
-		nn28, err := m.Union.MarshalTo(dAtA[i:])
+		nn29, err := m.Union.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn28
+		i += nn29
 	}
 	if len(m.Signature) > 0 {
 		dAtA[i] = 0xc2
@@ -2975,11 +3002,11 @@ func (m *Virtual_Genesis) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Genesis.Size()))
-		n29, err := m.Genesis.MarshalTo(dAtA[i:])
+		n30, err := m.Genesis.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n30
 	}
 	return i, nil
 }
@@ -2991,11 +3018,11 @@ func (m *Virtual_Child) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Child.Size()))
-		n30, err := m.Child.MarshalTo(dAtA[i:])
+		n31, err := m.Child.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n31
 	}
 	return i, nil
 }
@@ -3007,11 +3034,11 @@ func (m *Virtual_Jet) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Jet.Size()))
-		n31, err := m.Jet.MarshalTo(dAtA[i:])
+		n32, err := m.Jet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n32
 	}
 	return i, nil
 }
@@ -3023,11 +3050,11 @@ func (m *Virtual_Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
-		n32, err := m.Request.MarshalTo(dAtA[i:])
+		n33, err := m.Request.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n33
 	}
 	return i, nil
 }
@@ -3039,11 +3066,11 @@ func (m *Virtual_Result) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Result.Size()))
-		n33, err := m.Result.MarshalTo(dAtA[i:])
+		n34, err := m.Result.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n34
 	}
 	return i, nil
 }
@@ -3055,11 +3082,11 @@ func (m *Virtual_Type) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Type.Size()))
-		n34, err := m.Type.MarshalTo(dAtA[i:])
+		n35, err := m.Type.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n35
 	}
 	return i, nil
 }
@@ -3071,11 +3098,11 @@ func (m *Virtual_Code) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Code.Size()))
-		n35, err := m.Code.MarshalTo(dAtA[i:])
+		n36, err := m.Code.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n36
 	}
 	return i, nil
 }
@@ -3087,11 +3114,11 @@ func (m *Virtual_Activate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Activate.Size()))
-		n36, err := m.Activate.MarshalTo(dAtA[i:])
+		n37, err := m.Activate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n37
 	}
 	return i, nil
 }
@@ -3103,11 +3130,11 @@ func (m *Virtual_Amend) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Amend.Size()))
-		n37, err := m.Amend.MarshalTo(dAtA[i:])
+		n38, err := m.Amend.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n38
 	}
 	return i, nil
 }
@@ -3119,11 +3146,11 @@ func (m *Virtual_Deactivate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Deactivate.Size()))
-		n38, err := m.Deactivate.MarshalTo(dAtA[i:])
+		n39, err := m.Deactivate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n39
 	}
 	return i, nil
 }
@@ -3155,22 +3182,22 @@ func (m *Material) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Virtual.Size()))
-		n39, err := m.Virtual.MarshalTo(dAtA[i:])
+		n40, err := m.Virtual.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n40
 	}
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.JetID.Size()))
-	n40, err := m.JetID.MarshalTo(dAtA[i:])
+	n41, err := m.JetID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n41
 	if len(m.Signature) > 0 {
 		dAtA[i] = 0xc2
 		i++
@@ -3220,6 +3247,10 @@ func (m *Child) Size() (n int) {
 	n += 2 + l + sovRecord(uint64(l))
 	l = m.Ref.Size()
 	n += 2 + l + sovRecord(uint64(l))
+	if m.Prototype != nil {
+		l = m.Prototype.Size()
+		n += 2 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -3611,6 +3642,7 @@ func (this *Child) String() string {
 		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
 		`PrevChild:` + fmt.Sprintf("%v", this.PrevChild) + `,`,
 		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
+		`Prototype:` + fmt.Sprintf("%v", this.Prototype) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4086,6 +4118,41 @@ func (m *Child) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prototype", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.Reference
+			m.Prototype = &v
+			if err := m.Prototype.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
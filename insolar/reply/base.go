@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 )
 
+// Reply types are sent over the network as numbers, so new types are added to the end of the list only.
 const (
 	// Generic
 
@@ -80,6 +81,14 @@ const (
 	TypeHeavyError
 
 	TypeNodeSign
+	// TypeObjectOverloaded is returned when too many requests are pending for an object.
+	TypeObjectOverloaded
+	// TypeSimulateCall - would-be result of a call, outgoing calls and states of the object
+	TypeSimulateCall
+	// TypeCallTree - records of contract calls made in scope of trace
+	TypeCallTree
+	// TypeHeavyHistory carries a page of heavy history.
+	TypeHeavyHistory
 )
 
 // ErrType is used to determine and compare reply errors.
@@ -96,6 +105,8 @@ const (
 	ErrNoPendingRequests
 	// ErrTooManyPendingRequests is returned when a limit of pending requests has been reached
 	ErrTooManyPendingRequests
+	// ErrStatePruned is returned when memory of requested object state is removed by pruning
+	ErrStatePruned
 )
 
 func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
@@ -106,6 +117,10 @@ func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
 		return &CallConstructor{}, nil
 	case TypeRegisterRequest:
 		return &RegisterRequest{}, nil
+	case TypeSimulateCall:
+		return &SimulateCall{}, nil
+	case TypeCallTree:
+		return &CallTree{}, nil
 	case TypeCode:
 		return &Code{}, nil
 	case TypeObject:
@@ -120,6 +135,8 @@ func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
 		return &Error{}, nil
 	case TypeHeavyError:
 		return &HeavyError{}, nil
+	case TypeHeavyHistory:
+		return &HeavyHistory{}, nil
 	case TypeOK:
 		return &OK{}, nil
 	case TypeObjectIndex:
@@ -139,6 +156,8 @@ func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
 
 	case TypeNodeSign:
 		return &NodeSign{}, nil
+	case TypeObjectOverloaded:
+		return &ObjectOverloaded{}, nil
 
 	default:
 		return nil, errors.Errorf("unimplemented reply type: '%d'", t)
@@ -188,6 +207,8 @@ func init() {
 	gob.Register(&CallMethod{})
 	gob.Register(&CallConstructor{})
 	gob.Register(&RegisterRequest{})
+	gob.Register(&SimulateCall{})
+	gob.Register(&CallTree{})
 	gob.Register(&Code{})
 	gob.Register(&Object{})
 	gob.Register(&Delegate{})
@@ -199,6 +220,7 @@ func init() {
 	gob.Register(&GetCodeRedirectReply{})
 	gob.Register(&GetChildrenRedirectReply{})
 	gob.Register(&HeavyError{})
+	gob.Register(&HeavyHistory{})
 	gob.Register(&JetMiss{})
 	gob.Register(&NodeSign{})
 	gob.Register(&HasPendingRequests{})
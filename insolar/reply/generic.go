@@ -16,7 +16,11 @@
 
 package reply
 
-import "github.com/insolar/insolar/insolar"
+import (
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+)
 
 // OK is a generic reply for signaling a positive result.
 type OK struct {
@@ -59,7 +63,26 @@ func (e *Error) Error() error {
 		return insolar.ErrNoPendingRequest
 	case ErrTooManyPendingRequests:
 		return insolar.ErrTooManyPendingRequests
+	case ErrStatePruned:
+		return insolar.ErrStatePruned
 	}
 
 	return insolar.ErrUnknown
 }
+
+// ObjectOverloaded is returned instead of registering a request when too many requests are pending for the object
+// or for its jet. RetryAfter holds a back-off the caller should wait before registering the request again.
+type ObjectOverloaded struct {
+	Object     insolar.Reference
+	RetryAfter time.Duration
+}
+
+// Type implementation of Reply interface.
+func (e *ObjectOverloaded) Type() insolar.ReplyType {
+	return TypeObjectOverloaded
+}
+
+// Error returns concrete error for overloaded object.
+func (e *ObjectOverloaded) Error() error {
+	return &insolar.ObjectOverloadedError{Object: e.Object, RetryAfter: e.RetryAfter}
+}
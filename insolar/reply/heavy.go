@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
 )
 
 // HeavyError carries heavy sync error information.
@@ -38,3 +39,15 @@ func (e *HeavyError) Type() insolar.ReplyType {
 func (e *HeavyError) Error() string {
 	return fmt.Sprintf("%v (JetID=%v, PulseNum=%v)", e.Message, e.JetID, e.PulseNum)
 }
+
+// HeavyHistory carries a page of history of heavy node.
+type HeavyHistory struct {
+	Payloads []message.HeavyPayload
+	// Done is set if there is no history after the page.
+	Done bool
+}
+
+// Type implementation of Reply interface.
+func (e *HeavyHistory) Type() insolar.ReplyType {
+	return TypeHeavyHistory
+}
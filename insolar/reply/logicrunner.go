@@ -18,6 +18,7 @@ package reply
 
 import (
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
 )
 
 // CallMethod - the most common reply
@@ -47,3 +48,42 @@ type RegisterRequest struct {
 func (r *RegisterRequest) Type() insolar.ReplyType {
 	return TypeRegisterRequest
 }
+
+// SimulateCall is a would-be result of a method call executed in simulation mode
+type SimulateCall struct {
+	Result []byte
+	// Memory and NewMemory are states of the object before and after the call
+	Memory      []byte
+	NewMemory   []byte
+	Deactivated bool
+	Calls       []SimulatedCall
+}
+
+// Type returns type of the reply
+func (r *SimulateCall) Type() insolar.ReplyType {
+	return TypeSimulateCall
+}
+
+// SimulatedCall is an outgoing call made by a contract in simulation mode. Object is
+// a parent of new object for constructor calls. Calls holds outgoing calls of the callee
+// if the call was simulated too, i.e. it's a method call with waiting for result.
+type SimulatedCall struct {
+	CallType  record.Request_CT
+	Object    insolar.Reference
+	Prototype insolar.Reference
+	Method    string
+	Arguments []byte
+	Wait      bool
+	Result    []byte
+	Calls     []SimulatedCall
+}
+
+// CallTree contains records of contract calls made by the node in scope of trace
+type CallTree struct {
+	Calls []insolar.CallRecord
+}
+
+// Type returns type of the reply
+func (r *CallTree) Type() insolar.ReplyType {
+	return TypeCallTree
+}
@@ -24,6 +24,7 @@ import (
 // GetChildrenRedirectReply is a redirect reply for get children.
 type GetChildrenRedirectReply struct {
 	Receiver *insolar.Reference
+	Replicas []insolar.Reference
 	Token    insolar.DelegationToken
 
 	FromChild insolar.ID
@@ -52,6 +53,11 @@ func (r *GetChildrenRedirectReply) GetReceiver() *insolar.Reference {
 	return r.Receiver
 }
 
+// GetReplicas returns nodes to send message to if the receiver fails.
+func (r *GetChildrenRedirectReply) GetReplicas() []insolar.Reference {
+	return r.Replicas
+}
+
 // GetToken returns delegation token.
 func (r *GetChildrenRedirectReply) GetToken() insolar.DelegationToken {
 	return r.Token
@@ -67,6 +73,7 @@ func (r *GetChildrenRedirectReply) Redirected(genericMsg insolar.Message) insola
 	msg := genericMsg.(*message.GetChildren)
 	return &message.GetChildren{
 		Parent:    msg.Parent,
+		Prototype: msg.Prototype,
 		FromChild: &r.FromChild,
 		FromPulse: msg.FromPulse,
 		Amount:    msg.Amount,
@@ -76,6 +83,7 @@ func (r *GetChildrenRedirectReply) Redirected(genericMsg insolar.Message) insola
 // GetCodeRedirectReply is a redirect reply for get children.
 type GetCodeRedirectReply struct {
 	Receiver *insolar.Reference
+	Replicas []insolar.Reference
 	Token    insolar.DelegationToken
 }
 
@@ -101,6 +109,11 @@ func (r *GetCodeRedirectReply) GetReceiver() *insolar.Reference {
 	return r.Receiver
 }
 
+// GetReplicas returns nodes to send message to if the receiver fails.
+func (r *GetCodeRedirectReply) GetReplicas() []insolar.Reference {
+	return r.Replicas
+}
+
 // GetToken returns delegation token.
 func (r *GetCodeRedirectReply) GetToken() insolar.DelegationToken {
 	return r.Token
@@ -69,7 +69,7 @@ type LogicRunner interface {
 
 // LogicCallContext is a context of contract execution
 type LogicCallContext struct {
-	Mode            string     // either "execution" or "validation"
+	Mode            string     // either "execution", "validation" or "simulation"
 	Callee          *Reference // Contract that was called
 	Request         *Reference // ref of request
 	Prototype       *Reference // Image of the callee
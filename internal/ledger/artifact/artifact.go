@@ -213,6 +213,7 @@ func (m *Scope) activateObject(
 		ctx,
 		obj,
 		parent,
+		prototype,
 		parentIdx.ChildPointer,
 		asType,
 	)
@@ -319,6 +320,7 @@ func (m *Scope) registerChild(
 	ctx context.Context,
 	obj insolar.Reference,
 	parent insolar.Reference,
+	prototype insolar.Reference,
 	prevChild *insolar.ID,
 	asType *insolar.Reference,
 ) error {
@@ -328,7 +330,7 @@ func (m *Scope) registerChild(
 		return err
 	}
 
-	childRec := record.Child{Ref: obj}
+	childRec := record.Child{Ref: obj, Prototype: &prototype}
 	if prevChild != nil && prevChild.NotEmpty() {
 		childRec.PrevChild = *prevChild
 	}
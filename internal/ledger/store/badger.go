@@ -17,10 +17,15 @@
 package store
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
+	"io"
+	"os"
 	"path/filepath"
 
 	"github.com/dgraph-io/badger"
+	"github.com/dgraph-io/badger/protos"
 	"github.com/pkg/errors"
 )
 
@@ -48,53 +53,234 @@ func NewBadgerDB(dir string) (*BadgerDB, error) {
 	return &BadgerDB{backend: bdb}, nil
 }
 
+// NewReadOnlyBadgerDB opens badger DB in provided dir in read-only mode, e.g. for inspection of a copy of node's data.
+// Open fails if the DB wasn't closed properly and needs replay of its value log.
+func NewReadOnlyBadgerDB(dir string) (*BadgerDB, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	// Badger doesn't return an error for missing dir in read-only mode.
+	if _, err := os.Stat(dir); err != nil {
+		return nil, errors.Wrap(err, "failed to open badger in read-only mode")
+	}
+
+	ops := badger.DefaultOptions
+	ops.ValueDir = dir
+	ops.Dir = dir
+	ops.ReadOnly = true
+	bdb, err := badger.Open(ops)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open badger in read-only mode")
+	}
+
+	return &BadgerDB{backend: bdb}, nil
+}
+
 // Get returns value for specified key or an error. A copy of a value will be returned (i.e. getting large value can be
 // long).
 func (b *BadgerDB) Get(key Key) (value []byte, err error) {
-	fullKey := append(key.Scope().Bytes(), key.ID()...)
-
 	err = b.backend.View(func(txn *badger.Txn) error {
-		item, err := txn.Get(fullKey)
-		if err != nil {
-			return err
-		}
-		value, err = item.ValueCopy(nil)
-		if err != nil {
-			return err
-		}
+		value, err = badgerGet(txn, key)
+		return err
+	})
+	return
+}
 
-		return nil
+// Set stores a value for a key.
+func (b *BadgerDB) Set(key Key, value []byte) error {
+	return b.backend.Update(func(txn *badger.Txn) error {
+		return txn.Set(fullKey(key), value)
 	})
+}
 
-	if err != nil {
-		if err == badger.ErrKeyNotFound {
-			return nil, ErrNotFound
-		}
-		return nil, err
+// NewIterator returns an iterator over values of scope with IDs starting with prefix.
+func (b *BadgerDB) NewIterator(scope Scope, prefix []byte) Iterator {
+	return b.NewRangeIterator(scope, prefix, prefixEnd(prefix))
+}
+
+// NewRangeIterator returns an iterator over values of scope with IDs in [from, to) range.
+func (b *BadgerDB) NewRangeIterator(scope Scope, from, to []byte) Iterator {
+	return newBadgerIterator(b.backend.NewTransaction(false), true, scope, from, to)
+}
+
+// Update executes fn in a badger read-write transaction.
+func (b *BadgerDB) Update(fn func(txn Transaction) error) error {
+	err := b.backend.Update(func(txn *badger.Txn) error {
+		return fn(&badgerTxn{txn: txn})
+	})
+	if err == badger.ErrConflict {
+		return ErrConflict
 	}
+	return err
+}
 
-	return
+// Snapshot returns a read-only view of the store backed by a badger read-only transaction.
+func (b *BadgerDB) Snapshot() Snapshot {
+	return &badgerTxn{txn: b.backend.NewTransaction(false)}
 }
 
-// Set stores value for a key.
-func (b *BadgerDB) Set(key Key, value []byte) error {
-	fullKey := append(key.Scope().Bytes(), key.ID()...)
+// Backup writes entries changed since provided version to w in badger backup format. Backup is made from a
+// consistent snapshot without blocking writes. If read isn't nil, it's called with the snapshot before entries are
+// written, so the caller can describe the backed up data. Returned version should be passed to the next call to make
+// an incremental backup.
+func (b *BadgerDB) Backup(w io.Writer, since uint64, read func(snap Snapshot) error) (uint64, error) {
+	txn := b.backend.NewTransaction(false)
+	defer txn.Discard()
 
-	err := b.backend.Update(func(txn *badger.Txn) error {
-		err := txn.Set(fullKey, value)
+	if read != nil {
+		if err := read(&badgerTxn{txn: txn}); err != nil {
+			return 0, err
+		}
+	}
+
+	opts := badger.DefaultIteratorOptions
+	opts.AllVersions = true
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	// Entries committed after the snapshot have greater versions, so the next backup continues this one.
+	until := since
+	for it.Rewind(); it.Valid(); it.Next() {
+		item := it.Item()
+		if item.Version() >= until {
+			until = item.Version() + 1
+		}
+		if item.Version() < since {
+			continue
+		}
+		value, err := item.ValueCopy(nil)
 		if err != nil {
-			return err
+			return 0, errors.Wrapf(err, "failed to read value of key %x", item.Key())
 		}
-		return nil
-	})
+		entry := &protos.KVPair{
+			Key:       item.KeyCopy(nil),
+			Value:     value,
+			UserMeta:  []byte{item.UserMeta()},
+			Version:   item.Version(),
+			ExpiresAt: item.ExpiresAt(),
+		}
+		if err := writeBackupEntry(w, entry); err != nil {
+			return 0, errors.Wrap(err, "failed to write backup entry")
+		}
+	}
+	return until, nil
+}
+
+// writeBackupEntry writes entry in the format of badger backup, so it's read by Load.
+func writeBackupEntry(w io.Writer, entry *protos.KVPair) error {
+	if err := binary.Write(w, binary.LittleEndian, uint64(entry.Size())); err != nil {
+		return err
+	}
+	buf, err := entry.Marshal()
 	if err != nil {
 		return err
 	}
+	_, err = w.Write(buf)
+	return err
+}
 
-	return nil
+// Load writes entries from the backup made by Backup. The database shouldn't be used by anything else during load.
+func (b *BadgerDB) Load(r io.Reader) error {
+	return b.backend.Load(r)
 }
 
 // Stop gracefully stops all disk writes. After calling this, it's safe to kill the process without losing data.
 func (b *BadgerDB) Stop(ctx context.Context) error {
 	return b.backend.Close()
 }
+
+func badgerGet(txn *badger.Txn, key Key) ([]byte, error) {
+	item, err := txn.Get(fullKey(key))
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return item.ValueCopy(nil)
+}
+
+// badgerTxn implements both Transaction and Snapshot on top of badger transaction.
+type badgerTxn struct {
+	txn *badger.Txn
+}
+
+func (t *badgerTxn) Get(key Key) ([]byte, error) {
+	return badgerGet(t.txn, key)
+}
+
+func (t *badgerTxn) Set(key Key, value []byte) error {
+	return t.txn.Set(fullKey(key), value)
+}
+
+func (t *badgerTxn) Delete(key Key) error {
+	return t.txn.Delete(fullKey(key))
+}
+
+func (t *badgerTxn) NewIterator(scope Scope, prefix []byte) Iterator {
+	return t.NewRangeIterator(scope, prefix, prefixEnd(prefix))
+}
+
+func (t *badgerTxn) NewRangeIterator(scope Scope, from, to []byte) Iterator {
+	return newBadgerIterator(t.txn, false, scope, from, to)
+}
+
+func (t *badgerTxn) Discard() {
+	t.txn.Discard()
+}
+
+type badgerIterator struct {
+	txn *badger.Txn
+	// ownTxn is true when transaction was created for the iterator and should be discarded with it
+	ownTxn bool
+	it     *badger.Iterator
+
+	scope   []byte
+	from    []byte
+	to      []byte
+	started bool
+}
+
+func newBadgerIterator(txn *badger.Txn, ownTxn bool, scope Scope, from, to []byte) *badgerIterator {
+	it := &badgerIterator{
+		txn:    txn,
+		ownTxn: ownTxn,
+		it:     txn.NewIterator(badger.DefaultIteratorOptions),
+		scope:  scope.Bytes(),
+		from:   append(scope.Bytes(), from...),
+	}
+	if to != nil {
+		it.to = append(scope.Bytes(), to...)
+	}
+	return it
+}
+
+func (i *badgerIterator) Next() bool {
+	if i.started {
+		i.it.Next()
+	} else {
+		i.it.Seek(i.from)
+		i.started = true
+	}
+
+	if !i.it.ValidForPrefix(i.scope) {
+		return false
+	}
+	return i.to == nil || bytes.Compare(i.it.Item().Key(), i.to) < 0
+}
+
+func (i *badgerIterator) ID() []byte {
+	return i.it.Item().KeyCopy(nil)[len(i.scope):]
+}
+
+func (i *badgerIterator) Value() ([]byte, error) {
+	return i.it.Item().ValueCopy(nil)
+}
+
+func (i *badgerIterator) Close() {
+	i.it.Close()
+	if i.ownTxn {
+		i.txn.Discard()
+	}
+}
@@ -17,6 +17,8 @@
 package store
 
 import (
+	"bytes"
+	"context"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -97,3 +99,48 @@ func TestBadgerDB_Set(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedValue, value)
 }
+
+func TestBadgerDB_Backup(t *testing.T) {
+	t.Parallel()
+
+	newDB := func() (*BadgerDB, func()) {
+		tmpdir, err := ioutil.TempDir("", "bdb-test-")
+		require.NoError(t, err)
+		db, err := NewBadgerDB(tmpdir)
+		require.NoError(t, err)
+		return db, func() {
+			_ = db.Stop(context.Background())
+			_ = os.RemoveAll(tmpdir)
+		}
+	}
+	db, cleanup := newDB()
+	defer cleanup()
+
+	first := testBadgerKey{id: []byte{1}, scope: ScopeRecord}
+	second := testBadgerKey{id: []byte{2}, scope: ScopeRecord}
+	require.NoError(t, db.Set(first, []byte{1}))
+
+	var full bytes.Buffer
+	version, err := db.Backup(&full, 0, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, db.Set(second, []byte{2}))
+	var incremental bytes.Buffer
+	_, err = db.Backup(&incremental, version, nil)
+	require.NoError(t, err)
+
+	restored, cleanupRestored := newDB()
+	defer cleanupRestored()
+
+	require.NoError(t, restored.Load(bytes.NewReader(full.Bytes())))
+	_, err = restored.Get(second)
+	assert.Equal(t, ErrNotFound, err, "second value isn't in full backup")
+
+	require.NoError(t, restored.Load(bytes.NewReader(incremental.Bytes())))
+	value, err := restored.Get(first)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1}, value)
+	value, err = restored.Get(second)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{2}, value)
+}
@@ -20,8 +20,59 @@ package store
 
 // DB provides a simple key-value store interface for persisting data.
 type DB interface {
+	Reader
+
+	Set(key Key, value []byte) error
+
+	// Update executes fn in a read-write transaction. Writes made by fn are applied atomically if fn returns nil and
+	// discarded otherwise. ErrConflict is returned if data read by fn was changed by a concurrent transaction.
+	Update(fn func(txn Transaction) error) error
+
+	// Snapshot returns a read-only view of the store at the moment of the call. Snapshot should be discarded after use.
+	Snapshot() Snapshot
+}
+
+// Reader provides read access to the key-value store.
+type Reader interface {
 	Get(key Key) (value []byte, err error)
+
+	// NewIterator returns an iterator over values of scope with IDs starting with prefix. Values are iterated in
+	// ascending order of IDs. Iterator should be closed after use.
+	NewIterator(scope Scope, prefix []byte) Iterator
+
+	// NewRangeIterator returns an iterator over values of scope with IDs in [from, to) range. Nil to means the end of
+	// the scope. Values are iterated in ascending order of IDs. Iterator should be closed after use.
+	NewRangeIterator(scope Scope, from, to []byte) Iterator
+}
+
+// Transaction is a set of reads and writes applied atomically. Only one iterator can be open in a transaction
+// at a time.
+type Transaction interface {
+	Reader
+
 	Set(key Key, value []byte) error
+	// Delete removes value for a key. Removing of a missing key is not an error.
+	Delete(key Key) error
+}
+
+// Snapshot is a consistent read-only view of the store. Only one iterator can be open in a snapshot at a time.
+type Snapshot interface {
+	Reader
+
+	// Discard releases resources held by snapshot.
+	Discard()
+}
+
+// Iterator iterates over values of a scope.
+type Iterator interface {
+	// Next moves iterator to the next value. It returns false when there are no more values.
+	Next() bool
+	// ID returns an ID part of the key of current value.
+	ID() []byte
+	// Value returns current value.
+	Value() ([]byte, error)
+	// Close releases resources held by iterator.
+	Close()
 }
 
 // Key represents a key for the key-value store. Scope is required to separate different DB clients and should be
@@ -58,4 +109,87 @@ const (
 
 	// ScopeGenesis is the scope for a genesis records.
 	ScopeGenesis Scope = 8
+	// ScopeJetDropPulse is the scope for an index of jet drops by pulse.
+	ScopeJetDropPulse Scope = 9
+	// ScopeJetIndex is the scope for an index of record IDs by jet.
+	ScopeJetIndex Scope = 10
+	// ScopeWAL is the scope for a write-ahead log of light node hot data.
+	ScopeWAL Scope = 11
+	// ScopeWALPending is the scope for pending requests of light node.
+	ScopeWALPending Scope = 12
+	// ScopeBlobValue is the scope for values of blobs shared by blobs of different pulses.
+	ScopeBlobValue Scope = 13
+	// ScopeSchema is the scope for a version of data format.
+	ScopeSchema Scope = 14
+	// ScopeChildIndex is the scope for an index of children by prototype.
+	ScopeChildIndex Scope = 15
+	// ScopeChildPosition is the scope for positions of children in the list of children of their parents.
+	ScopeChildPosition Scope = 16
+	// ScopeSyncState is the scope for a progress of background processes of heavy node.
+	ScopeSyncState Scope = 17
+	// ScopeWALConfirmed is the scope for the last pulses of jets confirmed by heavy nodes on light node.
+	ScopeWALConfirmed Scope = 18
 )
+
+// prefixEnd returns the first ID that is greater than all IDs starting with prefix. Nil is returned if there is no
+// such ID, i.e. prefix consists of 0xff bytes only.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+func fullKey(key Key) []byte {
+	return append(key.Scope().Bytes(), key.ID()...)
+}
+
+// deleteBatch is the number of values removed in one transaction by DeletePrefix.
+const deleteBatch = 1000
+
+type scopedKey struct {
+	scope Scope
+	id    []byte
+}
+
+func (k scopedKey) Scope() Scope {
+	return k.scope
+}
+
+func (k scopedKey) ID() []byte {
+	return k.id
+}
+
+// DeletePrefix removes all values of scope with IDs starting with prefix and returns the number of removed values.
+// Values are removed by batches, so removal is not atomic.
+func DeletePrefix(db DB, scope Scope, prefix []byte) (int, error) {
+	var ids [][]byte
+	it := db.NewIterator(scope, prefix)
+	for it.Next() {
+		ids = append(ids, append([]byte{}, it.ID()...))
+	}
+	it.Close()
+
+	for from := 0; from < len(ids); from += deleteBatch {
+		to := from + deleteBatch
+		if to > len(ids) {
+			to = len(ids)
+		}
+		err := db.Update(func(txn Transaction) error {
+			for _, id := range ids[from:to] {
+				if err := txn.Delete(scopedKey{scope: scope, id: id}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return from, err
+		}
+	}
+	return len(ids), nil
+}
@@ -17,6 +17,9 @@
 package store
 
 import (
+	"bytes"
+	"context"
+	"github.com/pkg/errors"
 	"io/ioutil"
 	"math/rand"
 	"os"
@@ -94,3 +97,143 @@ func TestDB_Components(t *testing.T) {
 		}
 	}
 }
+
+func withDBs(t *testing.T, f func(t *testing.T, db DB)) {
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	badger, err := NewBadgerDB(tmpdir)
+	require.NoError(t, err)
+	defer badger.Stop(context.Background())
+
+	t.Run("badger", func(t *testing.T) { f(t, badger) })
+	t.Run("memory", func(t *testing.T) { f(t, NewMemoryMockDB()) })
+}
+
+func collect(t *testing.T, it Iterator) map[string]string {
+	defer it.Close()
+
+	res := map[string]string{}
+	var prev []byte
+	for it.Next() {
+		id := it.ID()
+		require.True(t, prev == nil || bytes.Compare(prev, id) < 0, "ids should be in ascending order")
+		prev = id
+
+		val, err := it.Value()
+		require.NoError(t, err)
+		res[string(id)] = string(val)
+	}
+	return res
+}
+
+func TestDB_Iterators(t *testing.T) {
+	t.Parallel()
+
+	withDBs(t, func(t *testing.T, db DB) {
+		for _, k := range []testKey{
+			{scope: 1, id: []byte{1, 1}},
+			{scope: 1, id: []byte{1, 2}},
+			{scope: 1, id: []byte{1, 0xff}},
+			{scope: 1, id: []byte{2, 1}},
+			{scope: 2, id: []byte{1, 1}},
+			{scope: 0xff, id: []byte{1}},
+		} {
+			require.NoError(t, db.Set(k, append([]byte{byte(k.scope)}, k.id...)))
+		}
+
+		assert.Equal(t, map[string]string{
+			"\x01\x01": "\x01\x01\x01",
+			"\x01\x02": "\x01\x01\x02",
+			"\x01\xff": "\x01\x01\xff",
+		}, collect(t, db.NewIterator(1, []byte{1})))
+		assert.Len(t, collect(t, db.NewIterator(1, nil)), 4)
+		assert.Empty(t, collect(t, db.NewIterator(1, []byte{3})))
+		assert.Len(t, collect(t, db.NewIterator(0xff, nil)), 1)
+
+		assert.Equal(t, map[string]string{
+			"\x01\x02": "\x01\x01\x02",
+			"\x01\xff": "\x01\x01\xff",
+		}, collect(t, db.NewRangeIterator(1, []byte{1, 2}, []byte{2})))
+		assert.Len(t, collect(t, db.NewRangeIterator(1, []byte{1, 2}, nil)), 3)
+	})
+}
+
+func TestDB_Update(t *testing.T) {
+	t.Parallel()
+
+	withDBs(t, func(t *testing.T, db DB) {
+		a := testKey{scope: 1, id: []byte{1}}
+		b := testKey{scope: 1, id: []byte{2}}
+		c := testKey{scope: 1, id: []byte{3}}
+		require.NoError(t, db.Set(a, []byte("a")))
+
+		err := db.Update(func(txn Transaction) error {
+			require.NoError(t, txn.Set(b, []byte("b")))
+			require.NoError(t, txn.Delete(a))
+
+			_, err := txn.Get(a)
+			assert.Equal(t, ErrNotFound, err)
+			val, err := txn.Get(b)
+			require.NoError(t, err)
+			assert.Equal(t, []byte("b"), val)
+
+			return errors.New("rollback")
+		})
+		require.Error(t, err)
+
+		val, err := db.Get(a)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("a"), val)
+		_, err = db.Get(b)
+		assert.Equal(t, ErrNotFound, err)
+
+		err = db.Update(func(txn Transaction) error {
+			if err := txn.Set(b, []byte("b")); err != nil {
+				return err
+			}
+			if err := txn.Set(c, []byte("c")); err != nil {
+				return err
+			}
+			if err := txn.Delete(a); err != nil {
+				return err
+			}
+			assert.Equal(t, map[string]string{"\x02": "b", "\x03": "c"}, collect(t, txn.NewIterator(1, nil)))
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]string{"\x02": "b", "\x03": "c"}, collect(t, db.NewIterator(1, nil)))
+	})
+}
+
+func TestDB_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	withDBs(t, func(t *testing.T, db DB) {
+		a := testKey{scope: 1, id: []byte{1}}
+		b := testKey{scope: 1, id: []byte{2}}
+		require.NoError(t, db.Set(a, []byte("a")))
+
+		snapshot := db.Snapshot()
+		defer snapshot.Discard()
+
+		require.NoError(t, db.Set(a, []byte("new")))
+		require.NoError(t, db.Set(b, []byte("b")))
+
+		val, err := snapshot.Get(a)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("a"), val)
+		_, err = snapshot.Get(b)
+		assert.Equal(t, ErrNotFound, err)
+		assert.Equal(t, map[string]string{"\x01": "a"}, collect(t, snapshot.NewIterator(1, nil)))
+	})
+}
+
+func TestPrefixEnd(t *testing.T) {
+	assert.Equal(t, []byte{1, 3}, prefixEnd([]byte{1, 2}))
+	assert.Equal(t, []byte{2}, prefixEnd([]byte{1, 0xff}))
+	assert.Nil(t, prefixEnd([]byte{0xff, 0xff}))
+	assert.Nil(t, prefixEnd(nil))
+}
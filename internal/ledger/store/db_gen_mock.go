@@ -22,10 +22,30 @@ type DBMock struct {
 	GetPreCounter uint64
 	GetMock       mDBMockGet
 
+	NewIteratorFunc       func(p Scope, p1 []byte) (r Iterator)
+	NewIteratorCounter    uint64
+	NewIteratorPreCounter uint64
+	NewIteratorMock       mDBMockNewIterator
+
+	NewRangeIteratorFunc       func(p Scope, p1 []byte, p2 []byte) (r Iterator)
+	NewRangeIteratorCounter    uint64
+	NewRangeIteratorPreCounter uint64
+	NewRangeIteratorMock       mDBMockNewRangeIterator
+
 	SetFunc       func(p Key, p1 []byte) (r error)
 	SetCounter    uint64
 	SetPreCounter uint64
 	SetMock       mDBMockSet
+
+	SnapshotFunc       func() (r Snapshot)
+	SnapshotCounter    uint64
+	SnapshotPreCounter uint64
+	SnapshotMock       mDBMockSnapshot
+
+	UpdateFunc       func(p func(txn Transaction) error) (r error)
+	UpdateCounter    uint64
+	UpdatePreCounter uint64
+	UpdateMock       mDBMockUpdate
 }
 
 //NewDBMock returns a mock for github.com/insolar/insolar/internal/ledger/store.DB
@@ -37,7 +57,11 @@ func NewDBMock(t minimock.Tester) *DBMock {
 	}
 
 	m.GetMock = mDBMockGet{mock: m}
+	m.NewIteratorMock = mDBMockNewIterator{mock: m}
+	m.NewRangeIteratorMock = mDBMockNewRangeIterator{mock: m}
 	m.SetMock = mDBMockSet{mock: m}
+	m.SnapshotMock = mDBMockSnapshot{mock: m}
+	m.UpdateMock = mDBMockUpdate{mock: m}
 
 	return m
 }
@@ -192,6 +216,303 @@ func (m *DBMock) GetFinished() bool {
 	return true
 }
 
+type mDBMockNewIterator struct {
+	mock              *DBMock
+	mainExpectation   *DBMockNewIteratorExpectation
+	expectationSeries []*DBMockNewIteratorExpectation
+}
+
+type DBMockNewIteratorExpectation struct {
+	input  *DBMockNewIteratorInput
+	result *DBMockNewIteratorResult
+}
+
+type DBMockNewIteratorInput struct {
+	p  Scope
+	p1 []byte
+}
+
+type DBMockNewIteratorResult struct {
+	r Iterator
+}
+
+//Expect specifies that invocation of DB.NewIterator is expected from 1 to Infinity times
+func (m *mDBMockNewIterator) Expect(p Scope, p1 []byte) *mDBMockNewIterator {
+	m.mock.NewIteratorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockNewIteratorExpectation{}
+	}
+	m.mainExpectation.input = &DBMockNewIteratorInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of DB.NewIterator
+func (m *mDBMockNewIterator) Return(r Iterator) *DBMock {
+	m.mock.NewIteratorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockNewIteratorExpectation{}
+	}
+	m.mainExpectation.result = &DBMockNewIteratorResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DB.NewIterator is expected once
+func (m *mDBMockNewIterator) ExpectOnce(p Scope, p1 []byte) *DBMockNewIteratorExpectation {
+	m.mock.NewIteratorFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DBMockNewIteratorExpectation{}
+	expectation.input = &DBMockNewIteratorInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DBMockNewIteratorExpectation) Return(r Iterator) {
+	e.result = &DBMockNewIteratorResult{r}
+}
+
+//Set uses given function f as a mock of DB.NewIterator method
+func (m *mDBMockNewIterator) Set(f func(p Scope, p1 []byte) (r Iterator)) *DBMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.NewIteratorFunc = f
+	return m.mock
+}
+
+//NewIterator implements github.com/insolar/insolar/internal/ledger/store.DB interface
+func (m *DBMock) NewIterator(p Scope, p1 []byte) (r Iterator) {
+	counter := atomic.AddUint64(&m.NewIteratorPreCounter, 1)
+	defer atomic.AddUint64(&m.NewIteratorCounter, 1)
+
+	if len(m.NewIteratorMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.NewIteratorMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBMock.NewIterator. %v %v", p, p1)
+			return
+		}
+
+		input := m.NewIteratorMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DBMockNewIteratorInput{p, p1}, "DB.NewIterator got unexpected parameters")
+
+		result := m.NewIteratorMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.NewIterator")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.NewIteratorMock.mainExpectation != nil {
+
+		input := m.NewIteratorMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DBMockNewIteratorInput{p, p1}, "DB.NewIterator got unexpected parameters")
+		}
+
+		result := m.NewIteratorMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.NewIterator")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.NewIteratorFunc == nil {
+		m.t.Fatalf("Unexpected call to DBMock.NewIterator. %v %v", p, p1)
+		return
+	}
+
+	return m.NewIteratorFunc(p, p1)
+}
+
+//NewIteratorMinimockCounter returns a count of DBMock.NewIteratorFunc invocations
+func (m *DBMock) NewIteratorMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.NewIteratorCounter)
+}
+
+//NewIteratorMinimockPreCounter returns the value of DBMock.NewIterator invocations
+func (m *DBMock) NewIteratorMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.NewIteratorPreCounter)
+}
+
+//NewIteratorFinished returns true if mock invocations count is ok
+func (m *DBMock) NewIteratorFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.NewIteratorMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.NewIteratorCounter) == uint64(len(m.NewIteratorMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.NewIteratorMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.NewIteratorCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.NewIteratorFunc != nil {
+		return atomic.LoadUint64(&m.NewIteratorCounter) > 0
+	}
+
+	return true
+}
+
+type mDBMockNewRangeIterator struct {
+	mock              *DBMock
+	mainExpectation   *DBMockNewRangeIteratorExpectation
+	expectationSeries []*DBMockNewRangeIteratorExpectation
+}
+
+type DBMockNewRangeIteratorExpectation struct {
+	input  *DBMockNewRangeIteratorInput
+	result *DBMockNewRangeIteratorResult
+}
+
+type DBMockNewRangeIteratorInput struct {
+	p  Scope
+	p1 []byte
+	p2 []byte
+}
+
+type DBMockNewRangeIteratorResult struct {
+	r Iterator
+}
+
+//Expect specifies that invocation of DB.NewRangeIterator is expected from 1 to Infinity times
+func (m *mDBMockNewRangeIterator) Expect(p Scope, p1 []byte, p2 []byte) *mDBMockNewRangeIterator {
+	m.mock.NewRangeIteratorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockNewRangeIteratorExpectation{}
+	}
+	m.mainExpectation.input = &DBMockNewRangeIteratorInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of DB.NewRangeIterator
+func (m *mDBMockNewRangeIterator) Return(r Iterator) *DBMock {
+	m.mock.NewRangeIteratorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockNewRangeIteratorExpectation{}
+	}
+	m.mainExpectation.result = &DBMockNewRangeIteratorResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DB.NewRangeIterator is expected once
+func (m *mDBMockNewRangeIterator) ExpectOnce(p Scope, p1 []byte, p2 []byte) *DBMockNewRangeIteratorExpectation {
+	m.mock.NewRangeIteratorFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DBMockNewRangeIteratorExpectation{}
+	expectation.input = &DBMockNewRangeIteratorInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DBMockNewRangeIteratorExpectation) Return(r Iterator) {
+	e.result = &DBMockNewRangeIteratorResult{r}
+}
+
+//Set uses given function f as a mock of DB.NewRangeIterator method
+func (m *mDBMockNewRangeIterator) Set(f func(p Scope, p1 []byte, p2 []byte) (r Iterator)) *DBMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.NewRangeIteratorFunc = f
+	return m.mock
+}
+
+//NewRangeIterator implements github.com/insolar/insolar/internal/ledger/store.DB interface
+func (m *DBMock) NewRangeIterator(p Scope, p1 []byte, p2 []byte) (r Iterator) {
+	counter := atomic.AddUint64(&m.NewRangeIteratorPreCounter, 1)
+	defer atomic.AddUint64(&m.NewRangeIteratorCounter, 1)
+
+	if len(m.NewRangeIteratorMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.NewRangeIteratorMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBMock.NewRangeIterator. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.NewRangeIteratorMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DBMockNewRangeIteratorInput{p, p1, p2}, "DB.NewRangeIterator got unexpected parameters")
+
+		result := m.NewRangeIteratorMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.NewRangeIterator")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.NewRangeIteratorMock.mainExpectation != nil {
+
+		input := m.NewRangeIteratorMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DBMockNewRangeIteratorInput{p, p1, p2}, "DB.NewRangeIterator got unexpected parameters")
+		}
+
+		result := m.NewRangeIteratorMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.NewRangeIterator")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.NewRangeIteratorFunc == nil {
+		m.t.Fatalf("Unexpected call to DBMock.NewRangeIterator. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.NewRangeIteratorFunc(p, p1, p2)
+}
+
+//NewRangeIteratorMinimockCounter returns a count of DBMock.NewRangeIteratorFunc invocations
+func (m *DBMock) NewRangeIteratorMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.NewRangeIteratorCounter)
+}
+
+//NewRangeIteratorMinimockPreCounter returns the value of DBMock.NewRangeIterator invocations
+func (m *DBMock) NewRangeIteratorMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.NewRangeIteratorPreCounter)
+}
+
+//NewRangeIteratorFinished returns true if mock invocations count is ok
+func (m *DBMock) NewRangeIteratorFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.NewRangeIteratorMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.NewRangeIteratorCounter) == uint64(len(m.NewRangeIteratorMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.NewRangeIteratorMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.NewRangeIteratorCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.NewRangeIteratorFunc != nil {
+		return atomic.LoadUint64(&m.NewRangeIteratorCounter) > 0
+	}
+
+	return true
+}
+
 type mDBMockSet struct {
 	mock              *DBMock
 	mainExpectation   *DBMockSetExpectation
@@ -340,6 +661,287 @@ func (m *DBMock) SetFinished() bool {
 	return true
 }
 
+type mDBMockSnapshot struct {
+	mock              *DBMock
+	mainExpectation   *DBMockSnapshotExpectation
+	expectationSeries []*DBMockSnapshotExpectation
+}
+
+type DBMockSnapshotExpectation struct {
+	result *DBMockSnapshotResult
+}
+
+type DBMockSnapshotResult struct {
+	r Snapshot
+}
+
+//Expect specifies that invocation of DB.Snapshot is expected from 1 to Infinity times
+func (m *mDBMockSnapshot) Expect() *mDBMockSnapshot {
+	m.mock.SnapshotFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockSnapshotExpectation{}
+	}
+
+	return m
+}
+
+//Return specifies results of invocation of DB.Snapshot
+func (m *mDBMockSnapshot) Return(r Snapshot) *DBMock {
+	m.mock.SnapshotFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockSnapshotExpectation{}
+	}
+	m.mainExpectation.result = &DBMockSnapshotResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DB.Snapshot is expected once
+func (m *mDBMockSnapshot) ExpectOnce() *DBMockSnapshotExpectation {
+	m.mock.SnapshotFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DBMockSnapshotExpectation{}
+
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DBMockSnapshotExpectation) Return(r Snapshot) {
+	e.result = &DBMockSnapshotResult{r}
+}
+
+//Set uses given function f as a mock of DB.Snapshot method
+func (m *mDBMockSnapshot) Set(f func() (r Snapshot)) *DBMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.SnapshotFunc = f
+	return m.mock
+}
+
+//Snapshot implements github.com/insolar/insolar/internal/ledger/store.DB interface
+func (m *DBMock) Snapshot() (r Snapshot) {
+	counter := atomic.AddUint64(&m.SnapshotPreCounter, 1)
+	defer atomic.AddUint64(&m.SnapshotCounter, 1)
+
+	if len(m.SnapshotMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.SnapshotMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBMock.Snapshot.")
+			return
+		}
+
+		result := m.SnapshotMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.Snapshot")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.SnapshotMock.mainExpectation != nil {
+
+		result := m.SnapshotMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.Snapshot")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.SnapshotFunc == nil {
+		m.t.Fatalf("Unexpected call to DBMock.Snapshot.")
+		return
+	}
+
+	return m.SnapshotFunc()
+}
+
+//SnapshotMinimockCounter returns a count of DBMock.SnapshotFunc invocations
+func (m *DBMock) SnapshotMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.SnapshotCounter)
+}
+
+//SnapshotMinimockPreCounter returns the value of DBMock.Snapshot invocations
+func (m *DBMock) SnapshotMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.SnapshotPreCounter)
+}
+
+//SnapshotFinished returns true if mock invocations count is ok
+func (m *DBMock) SnapshotFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.SnapshotMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.SnapshotCounter) == uint64(len(m.SnapshotMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.SnapshotMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.SnapshotCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.SnapshotFunc != nil {
+		return atomic.LoadUint64(&m.SnapshotCounter) > 0
+	}
+
+	return true
+}
+
+type mDBMockUpdate struct {
+	mock              *DBMock
+	mainExpectation   *DBMockUpdateExpectation
+	expectationSeries []*DBMockUpdateExpectation
+}
+
+type DBMockUpdateExpectation struct {
+	input  *DBMockUpdateInput
+	result *DBMockUpdateResult
+}
+
+type DBMockUpdateInput struct {
+	p func(txn Transaction) error
+}
+
+type DBMockUpdateResult struct {
+	r error
+}
+
+//Expect specifies that invocation of DB.Update is expected from 1 to Infinity times
+func (m *mDBMockUpdate) Expect(p func(txn Transaction) error) *mDBMockUpdate {
+	m.mock.UpdateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockUpdateExpectation{}
+	}
+	m.mainExpectation.input = &DBMockUpdateInput{p}
+	return m
+}
+
+//Return specifies results of invocation of DB.Update
+func (m *mDBMockUpdate) Return(r error) *DBMock {
+	m.mock.UpdateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockUpdateExpectation{}
+	}
+	m.mainExpectation.result = &DBMockUpdateResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DB.Update is expected once
+func (m *mDBMockUpdate) ExpectOnce(p func(txn Transaction) error) *DBMockUpdateExpectation {
+	m.mock.UpdateFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DBMockUpdateExpectation{}
+	expectation.input = &DBMockUpdateInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DBMockUpdateExpectation) Return(r error) {
+	e.result = &DBMockUpdateResult{r}
+}
+
+//Set uses given function f as a mock of DB.Update method
+func (m *mDBMockUpdate) Set(f func(p func(txn Transaction) error) (r error)) *DBMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.UpdateFunc = f
+	return m.mock
+}
+
+//Update implements github.com/insolar/insolar/internal/ledger/store.DB interface
+func (m *DBMock) Update(p func(txn Transaction) error) (r error) {
+	counter := atomic.AddUint64(&m.UpdatePreCounter, 1)
+	defer atomic.AddUint64(&m.UpdateCounter, 1)
+
+	if len(m.UpdateMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.UpdateMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBMock.Update. %v", p)
+			return
+		}
+
+		input := m.UpdateMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DBMockUpdateInput{p}, "DB.Update got unexpected parameters")
+
+		result := m.UpdateMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.Update")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.UpdateMock.mainExpectation != nil {
+
+		input := m.UpdateMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DBMockUpdateInput{p}, "DB.Update got unexpected parameters")
+		}
+
+		result := m.UpdateMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.Update")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.UpdateFunc == nil {
+		m.t.Fatalf("Unexpected call to DBMock.Update. %v", p)
+		return
+	}
+
+	return m.UpdateFunc(p)
+}
+
+//UpdateMinimockCounter returns a count of DBMock.UpdateFunc invocations
+func (m *DBMock) UpdateMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.UpdateCounter)
+}
+
+//UpdateMinimockPreCounter returns the value of DBMock.Update invocations
+func (m *DBMock) UpdateMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.UpdatePreCounter)
+}
+
+//UpdateFinished returns true if mock invocations count is ok
+func (m *DBMock) UpdateFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.UpdateMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.UpdateCounter) == uint64(len(m.UpdateMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.UpdateCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.UpdateFunc != nil {
+		return atomic.LoadUint64(&m.UpdateCounter) > 0
+	}
+
+	return true
+}
+
 //ValidateCallCounters checks that all mocked methods of the interface have been called at least once
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *DBMock) ValidateCallCounters() {
@@ -348,10 +950,26 @@ func (m *DBMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to DBMock.Get")
 	}
 
+	if !m.NewIteratorFinished() {
+		m.t.Fatal("Expected call to DBMock.NewIterator")
+	}
+
+	if !m.NewRangeIteratorFinished() {
+		m.t.Fatal("Expected call to DBMock.NewRangeIterator")
+	}
+
 	if !m.SetFinished() {
 		m.t.Fatal("Expected call to DBMock.Set")
 	}
 
+	if !m.SnapshotFinished() {
+		m.t.Fatal("Expected call to DBMock.Snapshot")
+	}
+
+	if !m.UpdateFinished() {
+		m.t.Fatal("Expected call to DBMock.Update")
+	}
+
 }
 
 //CheckMocksCalled checks that all mocked methods of the interface have been called at least once
@@ -373,10 +991,26 @@ func (m *DBMock) MinimockFinish() {
 		m.t.Fatal("Expected call to DBMock.Get")
 	}
 
+	if !m.NewIteratorFinished() {
+		m.t.Fatal("Expected call to DBMock.NewIterator")
+	}
+
+	if !m.NewRangeIteratorFinished() {
+		m.t.Fatal("Expected call to DBMock.NewRangeIterator")
+	}
+
 	if !m.SetFinished() {
 		m.t.Fatal("Expected call to DBMock.Set")
 	}
 
+	if !m.SnapshotFinished() {
+		m.t.Fatal("Expected call to DBMock.Snapshot")
+	}
+
+	if !m.UpdateFinished() {
+		m.t.Fatal("Expected call to DBMock.Update")
+	}
+
 }
 
 //Wait waits for all mocked methods to be called at least once
@@ -392,7 +1026,11 @@ func (m *DBMock) MinimockWait(timeout time.Duration) {
 	for {
 		ok := true
 		ok = ok && m.GetFinished()
+		ok = ok && m.NewIteratorFinished()
+		ok = ok && m.NewRangeIteratorFinished()
 		ok = ok && m.SetFinished()
+		ok = ok && m.SnapshotFinished()
+		ok = ok && m.UpdateFinished()
 
 		if ok {
 			return
@@ -405,10 +1043,26 @@ func (m *DBMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to DBMock.Get")
 			}
 
+			if !m.NewIteratorFinished() {
+				m.t.Error("Expected call to DBMock.NewIterator")
+			}
+
+			if !m.NewRangeIteratorFinished() {
+				m.t.Error("Expected call to DBMock.NewRangeIterator")
+			}
+
 			if !m.SetFinished() {
 				m.t.Error("Expected call to DBMock.Set")
 			}
 
+			if !m.SnapshotFinished() {
+				m.t.Error("Expected call to DBMock.Snapshot")
+			}
+
+			if !m.UpdateFinished() {
+				m.t.Error("Expected call to DBMock.Update")
+			}
+
 			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
 			return
 		default:
@@ -425,9 +1079,25 @@ func (m *DBMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.NewIteratorFinished() {
+		return false
+	}
+
+	if !m.NewRangeIteratorFinished() {
+		return false
+	}
+
 	if !m.SetFinished() {
 		return false
 	}
 
+	if !m.SnapshotFinished() {
+		return false
+	}
+
+	if !m.UpdateFinished() {
+		return false
+	}
+
 	return true
 }
@@ -17,6 +17,8 @@
 package store
 
 import (
+	"sort"
+	"strings"
 	"sync"
 )
 
@@ -36,22 +38,185 @@ func NewMemoryMockDB() *MockDB {
 
 // Get returns a copy of the value for specified key from memory.
 func (b *MockDB) Get(key Key) (value []byte, err error) {
-	fullKey := append(key.Scope().Bytes(), key.ID()...)
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	return memoryGet(b.backend, key)
+}
+
+// Set stores value for a key in memory storage.
+func (b *MockDB) Set(key Key, value []byte) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.backend[string(fullKey(key))] = append([]byte{}, value...)
+	return nil
+}
 
+// NewIterator returns an iterator over values of scope with IDs starting with prefix. Iterator works on a copy of
+// values, so it's not affected by writes made after its creation.
+func (b *MockDB) NewIterator(scope Scope, prefix []byte) Iterator {
+	return b.NewRangeIterator(scope, prefix, prefixEnd(prefix))
+}
+
+// NewRangeIterator returns an iterator over values of scope with IDs in [from, to) range.
+func (b *MockDB) NewRangeIterator(scope Scope, from, to []byte) Iterator {
 	b.lock.RLock()
 	defer b.lock.RUnlock()
-	value, ok := b.backend[string(fullKey)]
+
+	return newMemoryIterator(b.backend, nil, scope, from, to)
+}
+
+// Update executes fn with exclusive access to the store, so transactions never conflict.
+func (b *MockDB) Update(fn func(txn Transaction) error) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	txn := &memoryTxn{
+		backend: b.backend,
+		writes:  map[string][]byte{},
+	}
+	if err := fn(txn); err != nil {
+		return err
+	}
+
+	for k, v := range txn.writes {
+		if v == nil {
+			delete(b.backend, k)
+			continue
+		}
+		b.backend[k] = v
+	}
+	return nil
+}
+
+// Snapshot returns a copy of the store.
+func (b *MockDB) Snapshot() Snapshot {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	snapshot := make(map[string][]byte, len(b.backend))
+	for k, v := range b.backend {
+		snapshot[k] = v
+	}
+	return &memorySnapshot{backend: snapshot}
+}
+
+func memoryGet(backend map[string][]byte, key Key) ([]byte, error) {
+	value, ok := backend[string(fullKey(key))]
 	if !ok {
 		return nil, ErrNotFound
 	}
 	return append([]byte{}, value...), nil
 }
 
-// Set stores value for a key in memory storage.
-func (b *MockDB) Set(key Key, value []byte) error {
-	fullKey := append(key.Scope().Bytes(), key.ID()...)
-	b.lock.Lock()
-	defer b.lock.Unlock()
-	b.backend[string(fullKey)] = append([]byte{}, value...)
+// memoryTxn keeps writes of transaction until it's committed. Deleted keys are kept with nil values.
+type memoryTxn struct {
+	backend map[string][]byte
+	writes  map[string][]byte
+}
+
+func (t *memoryTxn) Get(key Key) ([]byte, error) {
+	value, ok := t.writes[string(fullKey(key))]
+	if !ok {
+		return memoryGet(t.backend, key)
+	}
+	if value == nil {
+		return nil, ErrNotFound
+	}
+	return append([]byte{}, value...), nil
+}
+
+func (t *memoryTxn) Set(key Key, value []byte) error {
+	t.writes[string(fullKey(key))] = append([]byte{}, value...)
 	return nil
 }
+
+func (t *memoryTxn) Delete(key Key) error {
+	t.writes[string(fullKey(key))] = nil
+	return nil
+}
+
+func (t *memoryTxn) NewIterator(scope Scope, prefix []byte) Iterator {
+	return t.NewRangeIterator(scope, prefix, prefixEnd(prefix))
+}
+
+func (t *memoryTxn) NewRangeIterator(scope Scope, from, to []byte) Iterator {
+	return newMemoryIterator(t.backend, t.writes, scope, from, to)
+}
+
+type memorySnapshot struct {
+	backend map[string][]byte
+}
+
+func (s *memorySnapshot) Get(key Key) ([]byte, error) {
+	return memoryGet(s.backend, key)
+}
+
+func (s *memorySnapshot) NewIterator(scope Scope, prefix []byte) Iterator {
+	return s.NewRangeIterator(scope, prefix, prefixEnd(prefix))
+}
+
+func (s *memorySnapshot) NewRangeIterator(scope Scope, from, to []byte) Iterator {
+	return newMemoryIterator(s.backend, nil, scope, from, to)
+}
+
+func (s *memorySnapshot) Discard() {}
+
+type memoryPair struct {
+	key   string
+	value []byte
+}
+
+// memoryIterator iterates over pairs collected on its creation.
+type memoryIterator struct {
+	scope int
+	pairs []memoryPair
+	pos   int
+}
+
+// newMemoryIterator collects pairs of backend overridden by writes that are in the range.
+func newMemoryIterator(backend, writes map[string][]byte, scope Scope, from, to []byte) *memoryIterator {
+	start := string(append(scope.Bytes(), from...))
+	prefix := string(scope.Bytes())
+	var end string
+	if to != nil {
+		end = string(append(scope.Bytes(), to...))
+	}
+	inRange := func(k string) bool {
+		return strings.HasPrefix(k, prefix) && k >= start && (to == nil || k < end)
+	}
+
+	var pairs []memoryPair
+	for k, v := range backend {
+		if _, ok := writes[k]; ok || !inRange(k) {
+			continue
+		}
+		pairs = append(pairs, memoryPair{key: k, value: v})
+	}
+	for k, v := range writes {
+		if v == nil || !inRange(k) {
+			continue
+		}
+		pairs = append(pairs, memoryPair{key: k, value: v})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].key < pairs[j].key
+	})
+
+	return &memoryIterator{scope: len(prefix), pairs: pairs, pos: -1}
+}
+
+func (i *memoryIterator) Next() bool {
+	i.pos++
+	return i.pos < len(i.pairs)
+}
+
+func (i *memoryIterator) ID() []byte {
+	return []byte(i.pairs[i.pos].key[i.scope:])
+}
+
+func (i *memoryIterator) Value() ([]byte, error) {
+	return append([]byte{}, i.pairs[i.pos].value...), nil
+}
+
+func (i *memoryIterator) Close() {}
@@ -23,4 +23,6 @@ import (
 var (
 	// ErrNotFound is returned when value was not found.
 	ErrNotFound = errors.New("value not found")
+	// ErrConflict is returned when transaction conflicts with a concurrent one.
+	ErrConflict = errors.New("transaction conflict")
 )
@@ -19,6 +19,8 @@ package store
 import (
 	"sync"
 
+	"github.com/pkg/errors"
+
 	"github.com/insolar/insolar/insolar"
 )
 
@@ -37,8 +39,8 @@ type JetIndexAccessor interface {
 	For(jetID insolar.JetID) map[insolar.ID]struct{}
 }
 
-// JetIndex contains methods to implement quick access to data by jet. Indexes are stored in memory. Consider disk
-// implementation for large collections.
+// JetIndex contains methods to implement quick access to data by jet. Indexes are stored in memory. Use JetIndexDB
+// for large collections.
 type JetIndex struct {
 	lock    sync.Mutex
 	storage map[insolar.JetID]recordSet
@@ -97,3 +99,64 @@ func (i *JetIndex) For(jetID insolar.JetID) map[insolar.ID]struct{} {
 
 	return res
 }
+
+type jetIndexKey struct {
+	jetID insolar.JetID
+	id    insolar.ID
+}
+
+func (k jetIndexKey) Scope() Scope {
+	return ScopeJetIndex
+}
+
+func (k jetIndexKey) ID() []byte {
+	return append(k.jetID[:], k.id[:]...)
+}
+
+// JetIndexDB contains methods to implement quick access to data by jet. Indexes are stored in a db.
+type JetIndexDB struct {
+	db DB
+}
+
+// NewJetIndexDB creates new index instance.
+func NewJetIndexDB(db DB) *JetIndexDB {
+	return &JetIndexDB{db: db}
+}
+
+// Add creates index record for specified id and jet. To remove clean up index, use "Delete" method.
+// It panics if the db fails, because the index can't stay consistent with indexed data.
+func (i *JetIndexDB) Add(id insolar.ID, jetID insolar.JetID) {
+	err := i.db.Set(jetIndexKey{jetID: jetID, id: id}, []byte{})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to add jet index record"))
+	}
+}
+
+// Delete removes specified id - jet record from index.
+// It panics if the db fails, because the index can't stay consistent with indexed data.
+func (i *JetIndexDB) Delete(id insolar.ID, jetID insolar.JetID) {
+	err := i.db.Update(func(txn Transaction) error {
+		return txn.Delete(jetIndexKey{jetID: jetID, id: id})
+	})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to delete jet index record"))
+	}
+}
+
+// For returns a collection of ids, that are stored for a specific jetID
+func (i *JetIndexDB) For(jetID insolar.JetID) map[insolar.ID]struct{} {
+	it := i.db.NewIterator(ScopeJetIndex, jetID[:])
+	defer it.Close()
+
+	var res map[insolar.ID]struct{}
+	for it.Next() {
+		if res == nil {
+			res = map[insolar.ID]struct{}{}
+		}
+		var id insolar.ID
+		copy(id[:], it.ID()[len(jetID):])
+		res[id] = struct{}{}
+	}
+
+	return res
+}
@@ -0,0 +1,81 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+)
+
+func TestJetIndex_Components(t *testing.T) {
+	t.Parallel()
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+	badger, err := NewBadgerDB(tmpdir)
+	require.NoError(t, err)
+
+	type index interface {
+		JetIndexAccessor
+		JetIndexModifier
+	}
+	indexes := map[string]index{
+		"memory": NewJetIndex(),
+		"mock":   NewJetIndexDB(NewMemoryMockDB()),
+		"badger": NewJetIndexDB(badger),
+	}
+
+	type entry struct {
+		id    insolar.ID
+		jetID insolar.JetID
+	}
+	jets := []insolar.JetID{gen.JetID(), gen.JetID(), gen.JetID()}
+	var entries []entry
+	f := fuzz.New().Funcs(func(e *entry, c fuzz.Continue) {
+		e.id = gen.ID()
+		e.jetID = jets[c.Intn(len(jets))]
+	})
+	f.NumElements(10, 20).NilChance(0).Fuzz(&entries)
+
+	for _, e := range entries {
+		for _, idx := range indexes {
+			idx.Add(e.id, e.jetID)
+		}
+	}
+	// Every second entry is deleted.
+	for i := 0; i < len(entries); i += 2 {
+		for _, idx := range indexes {
+			idx.Delete(entries[i].id, entries[i].jetID)
+		}
+	}
+
+	for _, jetID := range append(jets, gen.JetID()) {
+		expected := indexes["memory"].For(jetID)
+		for name, idx := range indexes {
+			assert.Equal(t, expected, idx.For(jetID), name)
+		}
+	}
+}
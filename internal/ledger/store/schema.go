@@ -0,0 +1,66 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+type schemaVersionKey struct{}
+
+func (schemaVersionKey) Scope() Scope {
+	return ScopeSchema
+}
+
+func (schemaVersionKey) ID() []byte {
+	return []byte("version")
+}
+
+// SchemaVersion returns a version of data format stored in the store. ErrNotFound is returned if the version is not
+// stored, i.e. the store is new or it was created before versions were introduced.
+func SchemaVersion(r Reader) (uint32, error) {
+	buf, err := r.Get(schemaVersionKey{})
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) != 4 {
+		return 0, errors.Errorf("malformed schema version %x", buf)
+	}
+	return binary.BigEndian.Uint32(buf), nil
+}
+
+// SetSchemaVersion stores a version of data format.
+func SetSchemaVersion(txn Transaction, version uint32) error {
+	buf := make([]byte, 4)
+	binary.BigEndian.PutUint32(buf, version)
+	return txn.Set(schemaVersionKey{}, buf)
+}
+
+// Empty checks that there are no values in the store.
+func Empty(r Reader) bool {
+	for s := 0; s <= 0xff; s++ {
+		it := r.NewIterator(Scope(s), nil)
+		found := it.Next()
+		it.Close()
+		if found {
+			return false
+		}
+	}
+	return true
+}
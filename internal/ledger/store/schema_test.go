@@ -0,0 +1,42 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaVersion(t *testing.T) {
+	db := NewMemoryMockDB()
+	assert.True(t, Empty(db))
+
+	_, err := SchemaVersion(db)
+	assert.Equal(t, ErrNotFound, err)
+
+	err = db.Update(func(txn Transaction) error {
+		return SetSchemaVersion(txn, 42)
+	})
+	require.NoError(t, err)
+
+	version, err := SchemaVersion(db)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(42), version)
+	assert.False(t, Empty(db))
+}
@@ -0,0 +1,72 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+// TransactionDB is a DB that works inside of a transaction. It allows to combine writes of several storages built
+// on top of DB into one atomic transaction:
+//
+//	err := db.Update(func(txn store.Transaction) error {
+//		tdb := store.NewTransactionDB(txn)
+//		...
+//	})
+//
+// Update of TransactionDB joins the transaction, so its writes are applied when the outer transaction is committed.
+type TransactionDB struct {
+	txn Transaction
+}
+
+// NewTransactionDB creates DB that reads and writes through provided transaction.
+func NewTransactionDB(txn Transaction) *TransactionDB {
+	return &TransactionDB{txn: txn}
+}
+
+// Get returns value for a key from transaction.
+func (t *TransactionDB) Get(key Key) ([]byte, error) {
+	return t.txn.Get(key)
+}
+
+// Set stores value for a key in transaction.
+func (t *TransactionDB) Set(key Key, value []byte) error {
+	return t.txn.Set(key, value)
+}
+
+// NewIterator returns an iterator of transaction.
+func (t *TransactionDB) NewIterator(scope Scope, prefix []byte) Iterator {
+	return t.txn.NewIterator(scope, prefix)
+}
+
+// NewRangeIterator returns a range iterator of transaction.
+func (t *TransactionDB) NewRangeIterator(scope Scope, from, to []byte) Iterator {
+	return t.txn.NewRangeIterator(scope, from, to)
+}
+
+// Update executes fn in the transaction. Error of fn doesn't discard writes made by fn, the outer transaction should
+// be failed instead.
+func (t *TransactionDB) Update(fn func(txn Transaction) error) error {
+	return fn(t.txn)
+}
+
+// Snapshot returns a view of the transaction.
+func (t *TransactionDB) Snapshot() Snapshot {
+	return transactionSnapshot{Transaction: t.txn}
+}
+
+type transactionSnapshot struct {
+	Transaction
+}
+
+func (transactionSnapshot) Discard() {}
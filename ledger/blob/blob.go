@@ -67,6 +67,14 @@ type Cleaner interface {
 type Blob struct {
 	Value []byte
 	JetID insolar.JetID
+	// Hash is set instead of the value when the blob is pruned. It's a hash of removed value, so IDs of pruned blobs
+	// and hashes of their drops can still be calculated.
+	Hash []byte
+}
+
+// Pruned checks if the value of the blob is removed by pruning.
+func (b Blob) Pruned() bool {
+	return b.Hash != nil
 }
 
 // Clone returns copy of argument blob.
@@ -77,6 +85,11 @@ func Clone(in Blob) (out Blob) {
 		copy(v, in.Value)
 		out.Value = v
 	}
+	if in.Hash != nil {
+		h := make([]byte, len(in.Hash))
+		copy(h, in.Hash)
+		out.Hash = h
+	}
 	return
 }
 
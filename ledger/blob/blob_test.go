@@ -37,6 +37,7 @@ func TestClone(t *testing.T) {
 	cases := []struct {
 		name  string
 		value []byte
+		hash  []byte
 	}{
 		{
 			name:  "rand value",
@@ -50,6 +51,10 @@ func TestClone(t *testing.T) {
 			name:  "empty value",
 			value: []byte{},
 		},
+		{
+			name: "pruned value",
+			hash: slice(),
+		},
 	}
 
 	for _, c := range cases {
@@ -57,6 +62,7 @@ func TestClone(t *testing.T) {
 			blob := Blob{
 				JetID: jetID,
 				Value: c.value,
+				Hash:  c.hash,
 			}
 
 			clonedBlob := Clone(blob)
@@ -0,0 +1,124 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package blob
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+// Codec is an algorithm values of blobs are compressed with. Codec is stored with every value, so values compressed
+// with different codecs and values stored without compression can be read by the same storage.
+type Codec uint8
+
+const (
+	// CodecNone means that value is stored as is.
+	CodecNone Codec = iota
+	// CodecSnappy means that value is compressed with snappy.
+	CodecSnappy
+	// CodecZstd means that value is compressed with zstd.
+	CodecZstd
+)
+
+// ParseCodec returns a codec for its name. Empty name means no compression.
+func ParseCodec(name string) (Codec, error) {
+	switch strings.ToLower(name) {
+	case "", "none":
+		return CodecNone, nil
+	case "snappy":
+		return CodecSnappy, nil
+	case "zstd":
+		return CodecZstd, nil
+	}
+	return CodecNone, fmt.Errorf("unknown blob compression %q", name)
+}
+
+// String implements fmt.Stringer.
+func (c Codec) String() string {
+	switch c {
+	case CodecNone:
+		return "none"
+	case CodecSnappy:
+		return "snappy"
+	case CodecZstd:
+		return "zstd"
+	}
+	return fmt.Sprintf("Codec(%d)", uint8(c))
+}
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+)
+
+// initZstd creates an encoder and a decoder, that are shared by all storages. Both are safe for concurrent use
+// of EncodeAll and DecodeAll.
+func initZstd() {
+	zstdOnce.Do(func() {
+		var err error
+		zstdEncoder, err = zstd.NewWriter(nil)
+		if err != nil {
+			panic(err)
+		}
+		zstdDecoder, err = zstd.NewReader(nil)
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
+// compress compresses the value. If compression doesn't make the value smaller, the value is returned as is
+// with CodecNone, so such values aren't decompressed on every read.
+func (c Codec) compress(value []byte) ([]byte, Codec) {
+	var data []byte
+	switch c {
+	case CodecSnappy:
+		data = snappy.Encode(nil, value)
+	case CodecZstd:
+		initZstd()
+		data = zstdEncoder.EncodeAll(value, nil)
+	default:
+		return value, CodecNone
+	}
+	if len(data) >= len(value) {
+		return value, CodecNone
+	}
+	return data, c
+}
+
+// decompress returns the value from data compressed with the codec. Returned slice doesn't share memory with data
+// unless the codec is CodecNone.
+func (c Codec) decompress(data []byte) ([]byte, error) {
+	switch c {
+	case CodecNone:
+		return data, nil
+	case CodecSnappy:
+		value, err := snappy.Decode(nil, data)
+		return value, errors.Wrap(err, "failed to decompress snappy value")
+	case CodecZstd:
+		initZstd()
+		value, err := zstdDecoder.DecodeAll(data, nil)
+		return value, errors.Wrap(err, "failed to decompress zstd value")
+	}
+	return nil, fmt.Errorf("unknown blob codec %v", c)
+}
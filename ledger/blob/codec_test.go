@@ -0,0 +1,62 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package blob
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCodec(t *testing.T) {
+	for name, expected := range map[string]Codec{
+		"":       CodecNone,
+		"none":   CodecNone,
+		"snappy": CodecSnappy,
+		"ZSTD":   CodecZstd,
+	} {
+		c, err := ParseCodec(name)
+		require.NoError(t, err)
+		assert.Equal(t, expected, c)
+	}
+
+	_, err := ParseCodec("lz4")
+	assert.Error(t, err)
+}
+
+func TestCodec_Compress(t *testing.T) {
+	compressible := bytes.Repeat([]byte("wallet"), 100)
+	for _, c := range []Codec{CodecNone, CodecSnappy, CodecZstd} {
+		t.Run(c.String(), func(t *testing.T) {
+			data, used := c.compress(compressible)
+			assert.Equal(t, c, used)
+			value, err := used.decompress(data)
+			require.NoError(t, err)
+			assert.Equal(t, compressible, value)
+
+			incompressible := slice()
+			data, used = c.compress(incompressible)
+			assert.Equal(t, CodecNone, used, "value is stored as is if compression doesn't help")
+			assert.Equal(t, incompressible, data)
+		})
+	}
+
+	_, err := Codec(100).decompress([]byte{1})
+	assert.Error(t, err)
+}
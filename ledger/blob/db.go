@@ -25,21 +25,50 @@ import (
 	"go.opencensus.io/stats"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/internal/ledger/store"
 )
 
+const (
+	// updateAttempts is a number of attempts to write blob if transaction conflicts with a concurrent one.
+	updateAttempts = 3
+	// deleteBatch is a number of blobs deleted in one transaction.
+	deleteBatch = 1000
+)
+
 // DB implements persistent blob-storage.
+//
+// Values are deduplicated. IDs of blobs are calculated from their values, so a value is stored once by the hash part
+// of ID and blobs of all pulses with the value refer to it. Values are compressed with the codec of the storage.
+// Blobs stored before deduplication keep their values inline and are read as is.
 type DB struct {
-	db store.DB
+	db    store.DB
+	codec Codec
+	// jetIndex is set for hot data, blobs of a jet are looked up with it instead of scanning all blobs of a pulse.
+	jetIndex *store.JetIndexDB
 }
 
-// NewDB creates a new storage, that holds persistent data.
+// NewDB creates a new storage, that holds persistent data. Values are stored without compression.
 func NewDB(db store.DB) *DB {
+	return NewCompressedDB(db, CodecNone)
+}
+
+// NewCompressedDB creates a new storage, that holds persistent data. New values are compressed with provided codec.
+func NewCompressedDB(db store.DB, codec Codec) *DB {
 	return &DB{
-		db: db,
+		db:    db,
+		codec: codec,
 	}
 }
 
+// NewCompressedDBWithJetIndex creates a new storage, that indexes blobs by jet in the same db. It's used for hot data
+// of light nodes, that is queried by jet. New values are compressed with provided codec.
+func NewCompressedDBWithJetIndex(db store.DB, codec Codec) *DB {
+	s := NewCompressedDB(db, codec)
+	s.jetIndex = store.NewJetIndexDB(db)
+	return s
+}
+
 type dbKey struct {
 	id insolar.ID
 }
@@ -52,9 +81,42 @@ func (k *dbKey) ID() []byte {
 	return k.id[:]
 }
 
+type valueKey struct {
+	hash []byte
+}
+
+func (k *valueKey) Scope() store.Scope {
+	return store.ScopeBlobValue
+}
+
+func (k *valueKey) ID() []byte {
+	return k.hash
+}
+
+// dbBlob is a stored form of blob.
+type dbBlob struct {
+	// Value is set for blobs stored before deduplication.
+	Value []byte
+	JetID insolar.JetID
+	Hash  []byte
+	// Shared is set if the value is stored by the hash of blob ID.
+	Shared bool
+}
+
+// dbValue is a stored value shared by blobs.
+type dbValue struct {
+	Codec Codec
+	Data  []byte
+	// Refs is a number of blobs referring to the value.
+	Refs uint32
+}
+
 // ForID returns Blob for provided id.
 func (s *DB) ForID(ctx context.Context, id insolar.ID) (Blob, error) {
-	b, err := s.db.Get(&dbKey{id: id})
+	snap := s.db.Snapshot()
+	defer snap.Discard()
+
+	buf, err := snap.Get(&dbKey{id: id})
 	if err != nil {
 		if err == store.ErrNotFound {
 			err = ErrNotFound
@@ -62,37 +124,350 @@ func (s *DB) ForID(ctx context.Context, id insolar.ID) (Blob, error) {
 		return Blob{}, err
 	}
 
-	return decode(b)
+	stored, err := decode(buf)
+	if err != nil {
+		return Blob{}, err
+	}
+	if stored.Hash != nil {
+		return Blob{}, ErrPruned
+	}
+	return s.blob(snap, id, stored)
 }
 
 // Set saves new Blob-value in storage.
 func (s *DB) Set(ctx context.Context, id insolar.ID, blob Blob) error {
-	// Blob override is ok.
 	k := &dbKey{id: id}
 
-	_, getErr := s.db.Get(k)
-	if getErr != nil && getErr != store.ErrNotFound {
-		return errors.Wrapf(getErr, "got db error on key %v get", k)
-	} else if getErr == nil {
-		return ErrOverride
-	}
+	var size, saved int
+	var deduplicated bool
+	err := s.update(func(txn store.Transaction) error {
+		_, err := txn.Get(k)
+		if err == nil {
+			return ErrOverride
+		}
+		if err != store.ErrNotFound {
+			return errors.Wrapf(err, "got db error on key %v get", k)
+		}
 
-	b := mustEncode(blob)
+		stored := dbBlob{JetID: blob.JetID, Hash: blob.Hash}
+		size, saved, deduplicated = 0, 0, false
+		if !blob.Pruned() {
+			stored.Shared = true
+			size, deduplicated, err = s.addRef(txn, id.Hash(), blob.Value)
+			if err != nil {
+				return err
+			}
+			saved = len(blob.Value) - size
+		}
 
-	err := s.db.Set(k, b)
+		b := mustEncode(stored)
+		size += len(b)
+		return txn.Set(k, b)
+	})
 	if err != nil {
 		return err
 	}
+	if s.jetIndex != nil {
+		s.jetIndex.Add(id, blob.JetID)
+	}
 
 	stats.Record(ctx,
-		statBlobInStorageSize.M(int64(len(b))),
+		statBlobInStorageSize.M(int64(size)),
 		statBlobInStorageCount.M(1),
+		statBlobInStorageSavedSize.M(int64(saved)),
 	)
+	if deduplicated {
+		stats.Record(ctx, statBlobInStorageDeduplicatedCount.M(1))
+	}
 	return nil
 }
 
+// ForPulse returns []Blob for a provided jetID and a pulse number.
+func (s *DB) ForPulse(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) []Blob {
+	snap := s.db.Snapshot()
+	defer snap.Discard()
+
+	var ids []insolar.ID
+	var blobs []dbBlob
+	if s.jetIndex != nil {
+		ids, blobs = s.indexed(ctx, snap, jetID, pn)
+	}
+	it := snap.NewIterator(store.ScopeBlob, pn.Bytes())
+	for s.jetIndex == nil && it.Next() {
+		buf, err := it.Value()
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to read blob"))
+			continue
+		}
+		b, err := decode(buf)
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to decode blob"))
+			continue
+		}
+		if b.JetID != jetID {
+			continue
+		}
+		var id insolar.ID
+		copy(id[:], it.ID())
+		ids = append(ids, id)
+		blobs = append(blobs, b)
+	}
+	// Only one iterator can be open, values are read after it's closed.
+	it.Close()
+
+	var res []Blob
+	for i, stored := range blobs {
+		if stored.Hash != nil {
+			res = append(res, Blob{JetID: stored.JetID, Hash: stored.Hash})
+			continue
+		}
+		b, err := s.blob(snap, ids[i], stored)
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to read blob value"))
+			continue
+		}
+		res = append(res, b)
+	}
+
+	return res
+}
+
+// indexed returns blobs of a jet and a pulse found by jet index.
+func (s *DB) indexed(
+	ctx context.Context, snap store.Snapshot, jetID insolar.JetID, pn insolar.PulseNumber,
+) ([]insolar.ID, []dbBlob) {
+	var ids []insolar.ID
+	var blobs []dbBlob
+	for id := range s.jetIndex.For(jetID) {
+		if id.Pulse() != pn {
+			continue
+		}
+		buf, err := snap.Get(&dbKey{id: id})
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to read blob"))
+			continue
+		}
+		b, err := decode(buf)
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to decode blob"))
+			continue
+		}
+		ids = append(ids, id)
+		blobs = append(blobs, b)
+	}
+	return ids, blobs
+}
+
+// Prune removes the value of the blob for provided id. The hash of the value is kept instead, so the blob is still
+// returned by ForPulse and hashes of drops can be verified. Pruning of pruned blob does nothing.
+func (s *DB) Prune(ctx context.Context, id insolar.ID) error {
+	k := &dbKey{id: id}
+	return s.update(func(txn store.Transaction) error {
+		buf, err := txn.Get(k)
+		if err == store.ErrNotFound {
+			return ErrNotFound
+		}
+		if err != nil {
+			return errors.Wrapf(err, "got db error on key %v get", k)
+		}
+		b, err := decode(buf)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode blob")
+		}
+		if b.Hash != nil {
+			return nil
+		}
+		if b.Shared {
+			err = s.releaseRef(txn, id.Hash())
+			if err != nil {
+				return err
+			}
+		}
+		// ID of the blob is a hash of its value calculated for the pulse.
+		return txn.Set(k, mustEncode(dbBlob{JetID: b.JetID, Hash: id.Hash()}))
+	})
+}
+
+// Share moves the inline value of the blob stored before deduplication to shared values, so it's stored once with
+// values of other blobs. Shared and pruned blobs are left as is.
+func (s *DB) Share(ctx context.Context, id insolar.ID) error {
+	k := &dbKey{id: id}
+	return s.update(func(txn store.Transaction) error {
+		buf, err := txn.Get(k)
+		if err == store.ErrNotFound {
+			return ErrNotFound
+		}
+		if err != nil {
+			return errors.Wrapf(err, "got db error on key %v get", k)
+		}
+		b, err := decode(buf)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode blob")
+		}
+		if b.Shared || b.Hash != nil {
+			return nil
+		}
+		_, _, err = s.addRef(txn, id.Hash(), b.Value)
+		if err != nil {
+			return err
+		}
+		return txn.Set(k, mustEncode(dbBlob{JetID: b.JetID, Shared: true}))
+	})
+}
+
+// DeleteForPN removes blobs for a provided pulse. Shared values are removed when no blobs refer to them.
+func (s *DB) DeleteForPN(ctx context.Context, pulse insolar.PulseNumber) {
+	var ids []insolar.ID
+	jets := map[insolar.ID]insolar.JetID{}
+	it := s.db.NewIterator(store.ScopeBlob, pulse.Bytes())
+	for it.Next() {
+		var id insolar.ID
+		copy(id[:], it.ID())
+		ids = append(ids, id)
+		if s.jetIndex == nil {
+			continue
+		}
+		buf, err := it.Value()
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to read blob"))
+			continue
+		}
+		b, err := decode(buf)
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to decode blob"))
+			continue
+		}
+		jets[id] = b.JetID
+	}
+	it.Close()
+
+	for from := 0; from < len(ids); from += deleteBatch {
+		to := from + deleteBatch
+		if to > len(ids) {
+			to = len(ids)
+		}
+		err := s.update(func(txn store.Transaction) error {
+			for _, id := range ids[from:to] {
+				if err := s.delete(txn, id); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrapf(err, "failed to delete blobs for pulse %v", pulse))
+			return
+		}
+		for _, id := range ids[from:to] {
+			if jetID, ok := jets[id]; ok {
+				s.jetIndex.Delete(id, jetID)
+			}
+		}
+	}
+}
+
+func (s *DB) delete(txn store.Transaction, id insolar.ID) error {
+	k := &dbKey{id: id}
+	buf, err := txn.Get(k)
+	if err == store.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "got db error on key %v get", k)
+	}
+	b, err := decode(buf)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode blob")
+	}
+	if b.Shared && b.Hash == nil {
+		err = s.releaseRef(txn, id.Hash())
+		if err != nil {
+			return err
+		}
+	}
+	return txn.Delete(k)
+}
+
+// blob returns blob with the value read from r.
+func (s *DB) blob(r store.Reader, id insolar.ID, stored dbBlob) (Blob, error) {
+	if !stored.Shared {
+		return Blob{JetID: stored.JetID, Value: stored.Value}, nil
+	}
+
+	k := &valueKey{hash: id.Hash()}
+	buf, err := r.Get(k)
+	if err != nil {
+		return Blob{}, errors.Wrapf(err, "failed to get value of blob %v", id.DebugString())
+	}
+	v, err := decodeValue(buf)
+	if err != nil {
+		return Blob{}, errors.Wrap(err, "failed to decode blob value")
+	}
+	value, err := v.Codec.decompress(v.Data)
+	if err != nil {
+		return Blob{}, err
+	}
+	return Blob{JetID: stored.JetID, Value: value}, nil
+}
+
+// addRef stores the value by hash or adds a reference to the stored one. It returns a number of written bytes.
+func (s *DB) addRef(txn store.Transaction, hash []byte, value []byte) (int, bool, error) {
+	k := &valueKey{hash: hash}
+	buf, err := txn.Get(k)
+	if err != nil && err != store.ErrNotFound {
+		return 0, false, errors.Wrapf(err, "got db error on key %v get", k)
+	}
+
+	if err == nil {
+		v, err := decodeValue(buf)
+		if err != nil {
+			return 0, false, errors.Wrap(err, "failed to decode blob value")
+		}
+		v.Refs++
+		return 0, true, txn.Set(k, mustEncodeValue(v))
+	}
+
+	data, c := s.codec.compress(value)
+	b := mustEncodeValue(dbValue{Codec: c, Data: data, Refs: 1})
+	return len(b), false, txn.Set(k, b)
+}
+
+// releaseRef removes a reference to the value. The value is removed with the last reference.
+func (s *DB) releaseRef(txn store.Transaction, hash []byte) error {
+	k := &valueKey{hash: hash}
+	buf, err := txn.Get(k)
+	if err == store.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "got db error on key %v get", k)
+	}
+	v, err := decodeValue(buf)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode blob value")
+	}
+	if v.Refs <= 1 {
+		return txn.Delete(k)
+	}
+	v.Refs--
+	return txn.Set(k, mustEncodeValue(v))
+}
+
+// update executes fn in a transaction. Conflicting transaction is retried, because shared values can be updated
+// concurrently by blobs of different pulses.
+func (s *DB) update(fn func(txn store.Transaction) error) error {
+	var err error
+	for i := 0; i < updateAttempts; i++ {
+		err = s.db.Update(fn)
+		if err != store.ErrConflict {
+			return err
+		}
+	}
+	return err
+}
+
 // mustEncode serializes blob struct.
-func mustEncode(blob Blob) []byte {
+func mustEncode(blob dbBlob) []byte {
 	var buf bytes.Buffer
 	enc := codec.NewEncoder(&buf, &codec.CborHandle{})
 	err := enc.Encode(blob)
@@ -103,9 +478,28 @@ func mustEncode(blob Blob) []byte {
 }
 
 // decode deserializes bytes to blob struct.
-func decode(buf []byte) (Blob, error) {
+func decode(buf []byte) (dbBlob, error) {
 	dec := codec.NewDecoder(bytes.NewReader(buf), &codec.CborHandle{})
-	var blob Blob
+	var blob dbBlob
 	err := dec.Decode(&blob)
 	return blob, err
 }
+
+// mustEncodeValue serializes value struct.
+func mustEncodeValue(v dbValue) []byte {
+	var buf bytes.Buffer
+	enc := codec.NewEncoder(&buf, &codec.CborHandle{})
+	err := enc.Encode(v)
+	if err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// decodeValue deserializes bytes to value struct.
+func decodeValue(buf []byte) (dbValue, error) {
+	dec := codec.NewDecoder(bytes.NewReader(buf), &codec.CborHandle{})
+	var v dbValue
+	err := dec.Decode(&v)
+	return v, err
+}
@@ -25,4 +25,6 @@ var (
 	ErrNotFound = errors.New("blob not found")
 	// ErrOverride is returned when trying to update existing record with the same id.
 	ErrOverride = errors.New("blob override is forbidden")
+	// ErrPruned is returned when value of the blob is removed by pruning.
+	ErrPruned = errors.New("blob is pruned")
 )
@@ -26,22 +26,45 @@ import (
 	"github.com/insolar/insolar/internal/ledger/store"
 )
 
-// StorageMemory is an in-blobsStor struct for blob-storage.
+// StorageMemory is an in-blobsStor struct for blob-storage. Like DB, it stores values once for blobs of all pulses and
+// compresses them with the codec of the storage.
 type StorageMemory struct {
 	jetIndexModifier store.JetIndexModifier
 	jetIndexAccessor store.JetIndexAccessor
+	codec            Codec
 
 	lock      sync.RWMutex
-	blobsStor map[insolar.ID]Blob
+	blobsStor map[insolar.ID]memoryBlob
+	values    map[string]*memoryValue
 }
 
-// NewStorageMemory creates a new instance of Storage.
+type memoryBlob struct {
+	jetID insolar.JetID
+	hash  []byte
+	// shared is set if the blob has a value stored by the hash of its ID.
+	shared bool
+}
+
+type memoryValue struct {
+	codec Codec
+	data  []byte
+	refs  int
+}
+
+// NewStorageMemory creates a new instance of Storage. Values are stored without compression.
 func NewStorageMemory() *StorageMemory {
+	return NewCompressedStorageMemory(CodecNone)
+}
+
+// NewCompressedStorageMemory creates a new instance of Storage. Values are compressed with provided codec.
+func NewCompressedStorageMemory(codec Codec) *StorageMemory {
 	ji := store.NewJetIndex()
 	return &StorageMemory{
-		blobsStor:        map[insolar.ID]Blob{},
+		blobsStor:        map[insolar.ID]memoryBlob{},
+		values:           map[string]*memoryValue{},
 		jetIndexModifier: ji,
 		jetIndexAccessor: ji,
+		codec:            codec,
 	}
 }
 
@@ -55,10 +78,7 @@ func (s *StorageMemory) ForID(ctx context.Context, id insolar.ID) (blob Blob, er
 		err = ErrNotFound
 		return
 	}
-
-	blob = Clone(b)
-
-	return
+	return s.blob(id, b)
 }
 
 // Set saves new Blob-value in storage.
@@ -73,14 +93,30 @@ func (s *StorageMemory) Set(ctx context.Context, id insolar.ID, blob Blob) error
 
 	b := Clone(blob)
 
-	s.blobsStor[id] = b
+	s.blobsStor[id] = memoryBlob{jetID: b.JetID, hash: b.Hash, shared: b.Value != nil}
 	s.jetIndexModifier.Add(id, b.JetID)
+	if b.Value == nil {
+		return nil
+	}
+
+	key := string(id.Hash())
+	if v, ok := s.values[key]; ok {
+		v.refs++
+		stats.Record(ctx,
+			statBlobInMemoryCount.M(1),
+			statBlobInMemorySavedSize.M(int64(len(b.Value))),
+			statBlobInMemoryDeduplicatedCount.M(1),
+		)
+		return nil
+	}
 
-	blobSize := int64(len(b.Value))
+	data, c := s.codec.compress(b.Value)
+	s.values[key] = &memoryValue{codec: c, data: data, refs: 1}
 
 	stats.Record(ctx,
-		statBlobInMemorySize.M(blobSize),
+		statBlobInMemorySize.M(int64(len(data))),
 		statBlobInMemoryCount.M(1),
+		statBlobInMemorySavedSize.M(int64(len(b.Value)-len(data))),
 	)
 
 	return nil
@@ -94,10 +130,14 @@ func (s *StorageMemory) ForPulse(ctx context.Context, jetID insolar.JetID, pn in
 	ids := s.jetIndexAccessor.For(jetID)
 	var res []Blob
 	for id := range ids {
-		if id.Pulse() == pn {
-			b := s.blobsStor[id]
-			res = append(res, b)
+		if id.Pulse() != pn {
+			continue
+		}
+		blob, err := s.blob(id, s.blobsStor[id])
+		if err != nil {
+			continue
 		}
+		res = append(res, blob)
 	}
 
 	return res
@@ -113,11 +153,42 @@ func (s *StorageMemory) DeleteForPN(ctx context.Context, pulse insolar.PulseNumb
 			continue
 		}
 
-		s.jetIndexModifier.Delete(id, blob.JetID)
+		s.jetIndexModifier.Delete(id, blob.jetID)
 		delete(s.blobsStor, id)
+		if blob.shared {
+			key := string(id.Hash())
+			if v, ok := s.values[key]; ok {
+				v.refs--
+				if v.refs <= 0 {
+					delete(s.values, key)
+				}
+			}
+		}
 
 		stats.Record(ctx,
 			statBlobInMemoryRemovedCount.M(1),
 		)
 	}
 }
+
+// blob returns blob with decompressed value. The blob doesn't share memory with the storage.
+func (s *StorageMemory) blob(id insolar.ID, b memoryBlob) (Blob, error) {
+	res := Clone(Blob{JetID: b.jetID, Hash: b.hash})
+	if !b.shared {
+		return res, nil
+	}
+
+	v, ok := s.values[string(id.Hash())]
+	if !ok {
+		return Blob{}, ErrNotFound
+	}
+	value, err := v.codec.decompress(v.data)
+	if err != nil {
+		return Blob{}, err
+	}
+	if v.codec == CodecNone {
+		value = Clone(Blob{Value: value}).Value
+	}
+	res.Value = value
+	return res, nil
+}
@@ -17,6 +17,7 @@
 package blob
 
 import (
+	"bytes"
 	"math/rand"
 	"testing"
 
@@ -114,6 +115,129 @@ func TestBlobStorages(t *testing.T) {
 	})
 }
 
+func TestBlobStorages_ForPulse(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	memStorage := NewStorageMemory()
+	dbStorage := NewDB(store.NewMemoryMockDB())
+
+	jetID := gen.JetID()
+	pn := gen.PulseNumber()
+	for i := 0; i < 10; i++ {
+		b := Blob{Value: slice(), JetID: jetID}
+		if i%3 == 0 {
+			b.JetID = gen.JetID()
+		}
+		id := gen.ID()
+		id = *insolar.NewID(pn+insolar.PulseNumber(i%2), id.Hash())
+
+		require.NoError(t, memStorage.Set(ctx, id, b))
+		require.NoError(t, dbStorage.Set(ctx, id, b))
+	}
+
+	memBlobs := memStorage.ForPulse(ctx, jetID, pn)
+	dbBlobs := dbStorage.ForPulse(ctx, jetID, pn)
+	assert.Len(t, dbBlobs, 3)
+	assert.ElementsMatch(t, memBlobs, dbBlobs)
+}
+
+func TestBlobStorages_JetIndex(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	memStorage := NewStorageMemory()
+	dbStorage := NewCompressedDBWithJetIndex(store.NewMemoryMockDB(), CodecNone)
+
+	jetID := gen.JetID()
+	pn := gen.PulseNumber()
+	for i := 0; i < 10; i++ {
+		b := Blob{Value: slice(), JetID: jetID}
+		if i%3 == 0 {
+			b.JetID = gen.JetID()
+		}
+		id := gen.ID()
+		id = *insolar.NewID(pn+insolar.PulseNumber(i%2), id.Hash())
+
+		require.NoError(t, memStorage.Set(ctx, id, b))
+		require.NoError(t, dbStorage.Set(ctx, id, b))
+	}
+
+	dbBlobs := dbStorage.ForPulse(ctx, jetID, pn)
+	assert.Len(t, dbBlobs, 3)
+	assert.ElementsMatch(t, memStorage.ForPulse(ctx, jetID, pn), dbBlobs)
+
+	memStorage.DeleteForPN(ctx, pn)
+	dbStorage.DeleteForPN(ctx, pn)
+
+	assert.Empty(t, dbStorage.ForPulse(ctx, jetID, pn))
+	assert.ElementsMatch(t, memStorage.ForPulse(ctx, jetID, pn+1), dbStorage.ForPulse(ctx, jetID, pn+1))
+}
+
+func TestBlobStorages_DeleteForPN(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	memStorage := NewStorageMemory()
+	dbStorage := NewDB(store.NewMemoryMockDB())
+
+	jetID := gen.JetID()
+	pn := gen.PulseNumber()
+	var ids []insolar.ID
+	for i := 0; i < 10; i++ {
+		b := Blob{Value: slice(), JetID: jetID}
+		id := gen.ID()
+		id = *insolar.NewID(pn+insolar.PulseNumber(i%2), id.Hash())
+
+		require.NoError(t, memStorage.Set(ctx, id, b))
+		require.NoError(t, dbStorage.Set(ctx, id, b))
+		ids = append(ids, id)
+	}
+
+	memStorage.DeleteForPN(ctx, pn)
+	dbStorage.DeleteForPN(ctx, pn)
+
+	assert.Empty(t, dbStorage.ForPulse(ctx, jetID, pn))
+	assert.ElementsMatch(t, memStorage.ForPulse(ctx, jetID, pn+1), dbStorage.ForPulse(ctx, jetID, pn+1))
+	for _, id := range ids {
+		_, memErr := memStorage.ForID(ctx, id)
+		_, dbErr := dbStorage.ForID(ctx, id)
+		assert.Equal(t, memErr, dbErr)
+	}
+}
+
+func TestBlobDB_Prune(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	storage := NewDB(store.NewMemoryMockDB())
+
+	jetID := gen.JetID()
+	pn := gen.PulseNumber()
+	pruned := gen.ID()
+	pruned = *insolar.NewID(pn, pruned.Hash())
+	kept := gen.ID()
+	kept = *insolar.NewID(pn, kept.Hash())
+	require.NoError(t, storage.Set(ctx, pruned, Blob{Value: slice(), JetID: jetID}))
+	require.NoError(t, storage.Set(ctx, kept, Blob{Value: slice(), JetID: jetID}))
+
+	require.NoError(t, storage.Prune(ctx, pruned))
+	require.NoError(t, storage.Prune(ctx, pruned), "pruning of pruned blob does nothing")
+	assert.Equal(t, ErrNotFound, storage.Prune(ctx, gen.ID()))
+
+	_, err := storage.ForID(ctx, pruned)
+	assert.Equal(t, ErrPruned, err)
+	_, err = storage.ForID(ctx, kept)
+	assert.NoError(t, err)
+
+	blobs := storage.ForPulse(ctx, jetID, pn)
+	require.Len(t, blobs, 2)
+	assert.Contains(t, blobs, Blob{JetID: jetID, Hash: pruned.Hash()}, "pruned blob keeps its hash")
+}
+
 // sizedSlice generates random byte slice fixed size.
 func sizedSlice(size int32) (blob []byte) {
 	blob = make([]byte, size)
@@ -126,3 +250,84 @@ func slice() []byte {
 	size := rand.Int31n(1024)
 	return sizedSlice(size)
 }
+
+func TestBlobStorages_Deduplication(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	for _, c := range []Codec{CodecNone, CodecSnappy, CodecZstd} {
+		c := c
+		t.Run(c.String(), func(t *testing.T) {
+			t.Parallel()
+
+			db := store.NewMemoryMockDB()
+			memStorage := NewCompressedStorageMemory(c)
+			dbStorage := NewCompressedDB(db, c)
+
+			jetID := gen.JetID()
+			pn := gen.PulseNumber()
+			value := bytes.Repeat([]byte("balance"), 100)
+			hash := gen.ID()
+			first := *insolar.NewID(pn, hash.Hash())
+			second := *insolar.NewID(pn+1, hash.Hash())
+			for _, id := range []insolar.ID{first, second} {
+				require.NoError(t, memStorage.Set(ctx, id, Blob{Value: value, JetID: jetID}))
+				require.NoError(t, dbStorage.Set(ctx, id, Blob{Value: value, JetID: jetID}))
+			}
+
+			stored, err := decodeValue(mustGet(t, db, &valueKey{hash: hash.Hash()}))
+			require.NoError(t, err)
+			assert.Equal(t, uint32(2), stored.Refs)
+			assert.Equal(t, c, stored.Codec)
+			if c != CodecNone {
+				assert.True(t, len(stored.Data) < len(value))
+			}
+
+			memStorage.DeleteForPN(ctx, pn)
+			dbStorage.DeleteForPN(ctx, pn)
+			for _, s := range []Storage{memStorage, dbStorage} {
+				b, err := s.ForID(ctx, second)
+				require.NoError(t, err)
+				assert.Equal(t, value, b.Value)
+			}
+
+			require.NoError(t, dbStorage.Prune(ctx, second))
+			_, err = db.Get(&valueKey{hash: hash.Hash()})
+			assert.Equal(t, store.ErrNotFound, err, "value is removed with the last blob")
+		})
+	}
+}
+
+func TestBlobDB_ReadsUncompressed(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	storage := NewCompressedDB(db, CodecZstd)
+
+	jetID := gen.JetID()
+	pn := gen.PulseNumber()
+	legacy := gen.ID()
+	legacy = *insolar.NewID(pn, legacy.Hash())
+	value := slice()
+
+	// Blobs were stored inline before deduplication.
+	err := db.Set(&dbKey{id: legacy}, MustEncode(&Blob{Value: value, JetID: jetID}))
+	require.NoError(t, err)
+
+	b, err := storage.ForID(ctx, legacy)
+	require.NoError(t, err)
+	assert.Equal(t, Blob{Value: value, JetID: jetID}, b)
+	assert.Equal(t, []Blob{b}, storage.ForPulse(ctx, jetID, pn))
+
+	storage.DeleteForPN(ctx, pn)
+	_, err = storage.ForID(ctx, legacy)
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func mustGet(t *testing.T, db store.DB, key store.Key) []byte {
+	buf, err := db.Get(key)
+	require.NoError(t, err)
+	return buf
+}
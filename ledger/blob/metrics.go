@@ -32,6 +32,16 @@ var (
 		"How many blob-records saved in blobsStor",
 		stats.UnitDimensionless,
 	)
+	statBlobInMemorySavedSize = stats.Int64(
+		"blobstorage/inmemory/saved",
+		"Size of the blob-records saved by compression and deduplication in blobsStor",
+		stats.UnitBytes,
+	)
+	statBlobInMemoryDeduplicatedCount = stats.Int64(
+		"blobstorage/inmemory/deduplicated/count",
+		"How many blob-records in blobsStor share the value with a stored one",
+		stats.UnitDimensionless,
+	)
 	statBlobInMemoryRemovedCount = stats.Int64(
 		"blobstorage/inmemory/removed/count",
 		"How many blob-records removed from blobsStor",
@@ -47,6 +57,16 @@ var (
 		"How many blob-records persisted in blob storage",
 		stats.UnitDimensionless,
 	)
+	statBlobInStorageSavedSize = stats.Int64(
+		"blobstorage/persistent/saved",
+		"Size of the blob-records saved by compression and deduplication in blob storage",
+		stats.UnitBytes,
+	)
+	statBlobInStorageDeduplicatedCount = stats.Int64(
+		"blobstorage/persistent/deduplicated/count",
+		"How many blob-records in blob storage share the value with a stored one",
+		stats.UnitDimensionless,
+	)
 )
 
 func init() {
@@ -63,6 +83,18 @@ func init() {
 			Measure:     statBlobInMemoryCount,
 			Aggregation: view.Count(),
 		},
+		&view.View{
+			Name:        statBlobInMemorySavedSize.Name(),
+			Description: statBlobInMemorySavedSize.Description(),
+			Measure:     statBlobInMemorySavedSize,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statBlobInMemoryDeduplicatedCount.Name(),
+			Description: statBlobInMemoryDeduplicatedCount.Description(),
+			Measure:     statBlobInMemoryDeduplicatedCount,
+			Aggregation: view.Count(),
+		},
 		&view.View{
 			Name:        statBlobInMemoryRemovedCount.Name(),
 			Description: statBlobInMemoryRemovedCount.Description(),
@@ -81,6 +113,18 @@ func init() {
 			Measure:     statBlobInStorageCount,
 			Aggregation: view.Count(),
 		},
+		&view.View{
+			Name:        statBlobInStorageSavedSize.Name(),
+			Description: statBlobInStorageSavedSize.Description(),
+			Measure:     statBlobInStorageSavedSize,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statBlobInStorageDeduplicatedCount.Name(),
+			Description: statBlobInStorageDeduplicatedCount.Description(),
+			Measure:     statBlobInStorageDeduplicatedCount,
+			Aggregation: view.Count(),
+		},
 	)
 	if err != nil {
 		panic(err)
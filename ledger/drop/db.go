@@ -46,11 +46,27 @@ func (dk *dropDbKey) ID() []byte {
 	return bytes.Join([][]byte{dk.jetPrefix, dk.pn.Bytes()}, nil)
 }
 
+type dropPulseKey struct {
+	pn    insolar.PulseNumber
+	jetID insolar.JetID
+}
+
+func (k *dropPulseKey) Scope() store.Scope {
+	return store.ScopeJetDropPulse
+}
+
+func (k *dropPulseKey) ID() []byte {
+	return append(k.pn.Bytes(), k.jetID[:]...)
+}
+
 // ForPulse returns a Drop for a provided pulse, that is stored in a db.
 func (ds *DB) ForPulse(ctx context.Context, jetID insolar.JetID, pulse insolar.PulseNumber) (Drop, error) {
 	k := dropDbKey{jetID.Prefix(), pulse}
 
 	buf, err := ds.db.Get(&k)
+	if err == store.ErrNotFound {
+		return Drop{}, ErrNotFound
+	}
 	if err != nil {
 		return Drop{}, err
 	}
@@ -71,5 +87,68 @@ func (ds *DB) Set(ctx context.Context, drop Drop) error {
 	}
 
 	encoded := MustEncode(&drop)
-	return ds.db.Set(&k, encoded)
+	err = ds.db.Set(&k, encoded)
+	if err != nil {
+		return err
+	}
+	return ds.db.Set(&dropPulseKey{pn: drop.Pulse, jetID: drop.JetID}, []byte{})
+}
+
+// Reindex adds a stored drop to the index of drops by pulse. It's used to index drops stored before the index
+// was introduced.
+func (ds *DB) Reindex(ctx context.Context, drop Drop) error {
+	return ds.db.Set(&dropPulseKey{pn: drop.Pulse, jetID: drop.JetID}, []byte{})
+}
+
+// Rewrite replaces a stored drop. It's used by migrations of stored drops.
+func (ds *DB) Rewrite(ctx context.Context, drop Drop) error {
+	return ds.db.Set(&dropDbKey{drop.JetID.Prefix(), drop.Pulse}, MustEncode(&drop))
+}
+
+// All returns drops of all jets for provided pulse ordered by jet.
+func (ds *DB) All(ctx context.Context, pulse insolar.PulseNumber) ([]Drop, error) {
+	prefix := pulse.Bytes()
+	it := ds.db.NewIterator(store.ScopeJetDropPulse, prefix)
+	var jets []insolar.JetID
+	for it.Next() {
+		var jetID insolar.JetID
+		copy(jetID[:], it.ID()[len(prefix):])
+		jets = append(jets, jetID)
+	}
+	it.Close()
+
+	drops := make([]Drop, 0, len(jets))
+	for _, jetID := range jets {
+		drop, err := ds.ForPulse(ctx, jetID, pulse)
+		if err != nil {
+			return nil, err
+		}
+		drops = append(drops, drop)
+	}
+	return drops, nil
+}
+
+// After returns up to limit drops, that follow the drop of provided jet and pulse in storage order.
+// Drops of the same jet are ordered by pulse.
+func (ds *DB) After(ctx context.Context, jetID insolar.JetID, pulse insolar.PulseNumber, limit int) ([]Drop, error) {
+	k := dropDbKey{jetID.Prefix(), pulse}
+	// Appending zero byte gives the first ID after the ID of the key.
+	from := append(k.ID(), 0)
+
+	it := ds.db.NewRangeIterator(store.ScopeJetDrop, from, nil)
+	defer it.Close()
+
+	var drops []Drop
+	for len(drops) < limit && it.Next() {
+		buf, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		drop, err := Decode(buf)
+		if err != nil {
+			return nil, err
+		}
+		drops = append(drops, *drop)
+	}
+	return drops, nil
 }
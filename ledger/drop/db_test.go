@@ -57,6 +57,10 @@ func TestDropStorageDB_Set(t *testing.T) {
 
 	dbMock := store.NewDBMock(t)
 	dbMock.SetFunc = func(p store.Key, p1 []byte) (r error) {
+		if p.Scope() == store.ScopeJetDropPulse {
+			require.Empty(t, p1)
+			return nil
+		}
 		_, ok := encodedDrops[string(p1)]
 		require.Equal(t, true, ok)
 		return nil
@@ -139,3 +143,53 @@ func TestDropStorageDB_ForPulse_ProblemsWithDecoding(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+func TestDropStorageDB_After(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	ds := NewDB(store.NewMemoryMockDB())
+
+	jetID := *insolar.NewJetID(2, []byte{0x40})
+	var drops []Drop
+	for i := 0; i < 5; i++ {
+		d := Drop{JetID: jetID, Pulse: insolar.FirstPulseNumber + insolar.PulseNumber(i)}
+		err := ds.Set(ctx, d)
+		require.NoError(t, err)
+		drops = append(drops, d)
+	}
+
+	page, err := ds.After(ctx, insolar.ZeroJetID, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, drops[:2], page)
+
+	page, err = ds.After(ctx, jetID, drops[1].Pulse, 10)
+	require.NoError(t, err)
+	require.Equal(t, drops[2:], page)
+
+	page, err = ds.After(ctx, jetID, drops[4].Pulse, 10)
+	require.NoError(t, err)
+	require.Empty(t, page)
+}
+
+func TestDropStorageDB_All(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	ds := NewDB(store.NewMemoryMockDB())
+
+	pn := gen.PulseNumber()
+	var drops []Drop
+	for i := 0; i < 3; i++ {
+		d := Drop{JetID: *insolar.NewJetID(2, []byte{byte(i) << 6}), Pulse: pn}
+		err := ds.Set(ctx, d)
+		require.NoError(t, err)
+		drops = append(drops, d)
+	}
+	err := ds.Set(ctx, Drop{JetID: drops[0].JetID, Pulse: pn + 1})
+	require.NoError(t, err)
+
+	all, err := ds.All(ctx, pn)
+	require.NoError(t, err)
+	require.Equal(t, drops, all)
+
+	all, err = ds.All(ctx, pn-1)
+	require.NoError(t, err)
+	require.Empty(t, all)
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/ugorji/go/codec"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/blob"
 )
 
 //go:generate minimock -i github.com/insolar/insolar/ledger/drop.Modifier -o ./ -s _mock.go
@@ -55,7 +57,7 @@ type Drop struct {
 	// PrevHash is a hash of all record hashes belongs to previous pulse.
 	PrevHash []byte
 
-	// Hash is a hash of all record hashes belongs to one pulse and previous drop hash.
+	// Hash is a hash of previous drop hash and Merkle root of IDs of records and blobs belongs to one pulse.
 	Hash []byte
 
 	// Size represents data about physical size of the current jet.Drop.
@@ -66,6 +68,82 @@ type Drop struct {
 
 	// Split indicates that current jet was split.
 	Split bool
+
+	// Load is a load of the jet averaged over recent pulses.
+	Load Load
+}
+
+// Load describes how much data a jet gets. Drops keep it averaged over recent pulses, so the history of load moves
+// to the next executor of the jet together with the drop.
+type Load struct {
+	// Size is a size of records and blobs in bytes.
+	Size uint64
+	// Records is a number of records.
+	Records uint64
+	// Requests is a number of registered requests.
+	Requests uint64
+}
+
+// Add returns sum of the loads.
+func (l Load) Add(other Load) Load {
+	return Load{
+		Size:     l.Size + other.Size,
+		Records:  l.Records + other.Records,
+		Requests: l.Requests + other.Requests,
+	}
+}
+
+// Half returns a half of the load. It's used as the estimate of a load of a jet created by split.
+func (l Load) Half() Load {
+	return Load{
+		Size:     l.Size / 2,
+		Records:  l.Records / 2,
+		Requests: l.Requests / 2,
+	}
+}
+
+// Average returns moving average of the load and the load of the next pulse. Window is a number of pulses the load
+// is averaged over.
+func (l Load) Average(next Load, window uint64) Load {
+	if window <= 1 {
+		return next
+	}
+	avg := func(prev, cur uint64) uint64 {
+		return (prev*(window-1) + cur) / window
+	}
+	return Load{
+		Size:     avg(l.Size, next.Size),
+		Records:  avg(l.Records, next.Records),
+		Requests: avg(l.Requests, next.Requests),
+	}
+}
+
+// Hash calculates hash of a drop from the hash of the previous drop and the Merkle root of IDs of records and blobs
+// of the drop. IDs are calculated for provided pulse and sorted, so the hash doesn't depend on the order of records
+// and blobs.
+func Hash(
+	pcs insolar.PlatformCryptographyScheme,
+	prevHash []byte,
+	pn insolar.PulseNumber,
+	records []record.Material,
+	blobs []blob.Blob,
+) []byte {
+	return hashWithRoot(pcs, prevHash, merkleRoot(pcs, leaves(pcs, pn, records, blobs)))
+}
+
+func hashWithRoot(pcs insolar.PlatformCryptographyScheme, prevHash, root []byte) []byte {
+	hasher := pcs.IntegrityHasher()
+	_, _ = hasher.Write(prevHash)
+	_, _ = hasher.Write(root)
+	return hasher.Sum(nil)
+}
+
+// MergedHash calculates a hash, that a drop of a jet created by merge of two sibling jets continues.
+func MergedHash(pcs insolar.PlatformCryptographyScheme, leftHash, rightHash []byte) []byte {
+	hasher := pcs.IntegrityHasher()
+	_, _ = hasher.Write(leftHash)
+	_, _ = hasher.Write(rightHash)
+	return hasher.Sum(nil)
 }
 
 // MustEncode serializes jet drop.
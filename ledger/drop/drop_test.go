@@ -0,0 +1,78 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package drop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func TestHash(t *testing.T) {
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	pn := gen.PulseNumber()
+
+	newRecord := func() record.Material {
+		obj := gen.Reference()
+		return record.Material{
+			Virtual: &record.Virtual{
+				Union: &record.Virtual_Request{Request: &record.Request{Object: &obj}},
+			},
+		}
+	}
+	records := []record.Material{newRecord(), newRecord(), newRecord()}
+	blobs := []blob.Blob{{Value: []byte{1}}, {Value: []byte{2}}}
+	prevHash := []byte{1, 2, 3}
+
+	hash := Hash(pcs, prevHash, pn, records, blobs)
+	assert.NotEmpty(t, hash)
+
+	reordered := Hash(
+		pcs, prevHash, pn,
+		[]record.Material{records[2], records[0], records[1]},
+		[]blob.Blob{blobs[1], blobs[0]},
+	)
+	assert.Equal(t, hash, reordered, "hash doesn't depend on order")
+
+	blobID := object.CalculateIDForBlob(pcs, pn, blobs[0].Value)
+	pruned := blob.Blob{Hash: blobID.Hash()}
+	assert.Equal(t, hash, Hash(pcs, prevHash, pn, records, []blob.Blob{pruned, blobs[1]}), "pruned blob keeps hash")
+
+	assert.NotEqual(t, hash, Hash(pcs, []byte{3, 2, 1}, pn, records, blobs))
+	assert.NotEqual(t, hash, Hash(pcs, prevHash, pn+1, records, blobs))
+	assert.NotEqual(t, hash, Hash(pcs, prevHash, pn, records[:2], blobs))
+	assert.NotEqual(t, hash, Hash(pcs, prevHash, pn, records, blobs[:1]))
+}
+
+func TestLoad_Average(t *testing.T) {
+	prev := Load{Size: 100, Records: 10, Requests: 4}
+	next := Load{Size: 200, Records: 30, Requests: 0}
+
+	assert.Equal(t, next, prev.Average(next, 0))
+	assert.Equal(t, next, prev.Average(next, 1))
+	assert.Equal(t, Load{Size: 150, Records: 20, Requests: 2}, prev.Average(next, 2))
+	assert.Equal(t, Load{Size: 125, Records: 15, Requests: 3}, prev.Average(next, 4))
+
+	assert.Equal(t, Load{Size: 300, Records: 40, Requests: 4}, prev.Add(next))
+	assert.Equal(t, Load{Size: 50, Records: 5, Requests: 2}, prev.Half())
+}
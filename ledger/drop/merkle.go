@@ -0,0 +1,163 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package drop
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// Prefixes of hashed data of leaves and nodes of Merkle tree. They make a leaf indistinguishable from a node.
+const (
+	leafPrefix = 0
+	nodePrefix = 1
+)
+
+// ProofStep is a hash of a sibling on the path from a leaf of drop's Merkle tree to its root.
+type ProofStep struct {
+	Hash []byte
+	// Left is set if the sibling is the left node.
+	Left bool
+}
+
+// Proof is a proof of inclusion of a record or a blob to a drop. It's a path from the leaf of record ID to the
+// Merkle root of the drop.
+type Proof []ProofStep
+
+// Prove builds a proof of inclusion of provided ID to a drop of provided pulse with provided records and blobs.
+// ErrNotFound is returned if ID doesn't belong to the drop.
+func Prove(
+	pcs insolar.PlatformCryptographyScheme,
+	pn insolar.PulseNumber,
+	records []record.Material,
+	blobs []blob.Blob,
+	id insolar.ID,
+) (Proof, error) {
+	level := leaves(pcs, pn, records, blobs)
+	leaf := leafHash(pcs, id)
+	pos := -1
+	for i, hash := range level {
+		if bytes.Equal(hash, leaf) {
+			pos = i
+			break
+		}
+	}
+	if pos < 0 {
+		return nil, ErrNotFound
+	}
+
+	proof := Proof{}
+	for len(level) > 1 {
+		sibling := pos ^ 1
+		if sibling < len(level) {
+			proof = append(proof, ProofStep{Hash: level[sibling], Left: sibling < pos})
+		}
+		level = nextLevel(pcs, level)
+		pos /= 2
+	}
+	return proof, nil
+}
+
+// Root calculates Merkle root from provided ID and the proof.
+func (p Proof) Root(pcs insolar.PlatformCryptographyScheme, id insolar.ID) []byte {
+	hash := leafHash(pcs, id)
+	for _, step := range p {
+		if step.Left {
+			hash = nodeHash(pcs, step.Hash, hash)
+		} else {
+			hash = nodeHash(pcs, hash, step.Hash)
+		}
+	}
+	return hash
+}
+
+// VerifyProof checks that the record or the blob with provided ID is included to the drop.
+func VerifyProof(pcs insolar.PlatformCryptographyScheme, d Drop, id insolar.ID, proof Proof) bool {
+	if id.Pulse() != d.Pulse {
+		return false
+	}
+	return bytes.Equal(d.Hash, hashWithRoot(pcs, d.PrevHash, proof.Root(pcs, id)))
+}
+
+// leaves returns sorted hashes of IDs of records and blobs calculated for provided pulse.
+func leaves(
+	pcs insolar.PlatformCryptographyScheme,
+	pn insolar.PulseNumber,
+	records []record.Material,
+	blobs []blob.Blob,
+) [][]byte {
+	res := make([][]byte, 0, len(records)+len(blobs))
+	for _, rec := range records {
+		hash := record.HashVirtual(pcs.ReferenceHasher(), *rec.Virtual)
+		res = append(res, leafHash(pcs, *insolar.NewID(pn, hash)))
+	}
+	for _, b := range blobs {
+		id := object.CalculateIDForBlob(pcs, pn, b.Value)
+		if b.Pruned() {
+			id = insolar.NewID(pn, b.Hash)
+		}
+		res = append(res, leafHash(pcs, *id))
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return bytes.Compare(res[i], res[j]) < 0
+	})
+	return res
+}
+
+// merkleRoot calculates the root of Merkle tree with provided leaves. The last node of a level without a pair is moved
+// to the next level as is. Root of the empty tree is a hash of empty data.
+func merkleRoot(pcs insolar.PlatformCryptographyScheme, level [][]byte) []byte {
+	if len(level) == 0 {
+		return pcs.IntegrityHasher().Sum(nil)
+	}
+	for len(level) > 1 {
+		level = nextLevel(pcs, level)
+	}
+	return level[0]
+}
+
+func nextLevel(pcs insolar.PlatformCryptographyScheme, level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+			break
+		}
+		next = append(next, nodeHash(pcs, level[i], level[i+1]))
+	}
+	return next
+}
+
+func leafHash(pcs insolar.PlatformCryptographyScheme, id insolar.ID) []byte {
+	hasher := pcs.IntegrityHasher()
+	_, _ = hasher.Write([]byte{leafPrefix})
+	_, _ = hasher.Write(id.Bytes())
+	return hasher.Sum(nil)
+}
+
+func nodeHash(pcs insolar.PlatformCryptographyScheme, left, right []byte) []byte {
+	hasher := pcs.IntegrityHasher()
+	_, _ = hasher.Write([]byte{nodePrefix})
+	_, _ = hasher.Write(left)
+	_, _ = hasher.Write(right)
+	return hasher.Sum(nil)
+}
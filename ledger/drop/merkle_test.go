@@ -0,0 +1,81 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package drop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func TestProve(t *testing.T) {
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	pn := gen.PulseNumber()
+
+	newRecord := func() record.Material {
+		obj := gen.Reference()
+		return record.Material{
+			Virtual: &record.Virtual{
+				Union: &record.Virtual_Request{Request: &record.Request{Object: &obj}},
+			},
+		}
+	}
+	recordID := func(rec record.Material) insolar.ID {
+		return *insolar.NewID(pn, record.HashVirtual(pcs.ReferenceHasher(), *rec.Virtual))
+	}
+
+	// Odd numbers of leaves make trees with unpaired nodes.
+	for _, size := range []int{1, 2, 5, 8} {
+		records := make([]record.Material, 0, size)
+		for i := 0; i < size; i++ {
+			records = append(records, newRecord())
+		}
+		blobs := []blob.Blob{{Value: []byte{1}}}
+		d := Drop{Pulse: pn, PrevHash: []byte{1, 2, 3}}
+		d.Hash = Hash(pcs, d.PrevHash, pn, records, blobs)
+
+		for _, rec := range records {
+			proof, err := Prove(pcs, pn, records, blobs, recordID(rec))
+			require.NoError(t, err)
+			assert.True(t, VerifyProof(pcs, d, recordID(rec), proof), "record is included")
+		}
+		blobID := *object.CalculateIDForBlob(pcs, pn, blobs[0].Value)
+		proof, err := Prove(pcs, pn, records, blobs, blobID)
+		require.NoError(t, err)
+		assert.True(t, VerifyProof(pcs, d, blobID, proof), "blob is included")
+
+		// Proof of one record doesn't prove another one.
+		proof, err = Prove(pcs, pn, records, blobs, recordID(records[0]))
+		require.NoError(t, err)
+		assert.False(t, VerifyProof(pcs, d, recordID(newRecord()), proof))
+
+		forged := d
+		forged.PrevHash = []byte{3, 2, 1}
+		assert.False(t, VerifyProof(pcs, forged, recordID(records[0]), proof), "drop hash doesn't match")
+	}
+
+	_, err := Prove(pcs, pn, []record.Material{newRecord()}, nil, recordID(newRecord()))
+	assert.Equal(t, ErrNotFound, err)
+}
@@ -0,0 +1,255 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/migration"
+)
+
+// Version is a version of backup format. It's increased on every incompatible change of the format.
+const Version = 1
+
+const manifestSuffix = ".json"
+
+// Manifest describes a backup.
+type Manifest struct {
+	Version int
+	// From and To are the range of pulses stored after the previous backup. From is zero for the full backup,
+	// To is the latest pulse of the storage snapshot the backup was made from.
+	From insolar.PulseNumber
+	To   insolar.PulseNumber
+	// Since is a storage version the backup continues. It's zero for the full backup.
+	Since uint64
+	// Until is a storage version the next backup should continue.
+	Until uint64
+	// File is a name of the file with entries in the same directory.
+	File string
+	// Checksum is hex encoded SHA-256 of the file.
+	Checksum string
+}
+
+// Backuper makes incremental backups of the storage to the directory.
+type Backuper struct {
+	db  *store.BadgerDB
+	dir string
+
+	lock sync.Mutex
+}
+
+// NewBackuper creates new Backuper.
+func NewBackuper(db *store.BadgerDB, dir string) *Backuper {
+	return &Backuper{db: db, dir: dir}
+}
+
+// Make makes a backup of data stored since the previous backup. The storage should have pulse until stored, so the
+// backup covers data up to it. Backup is made online, writes to the storage aren't blocked. Data is backed up from a
+// snapshot, so To of the manifest is the latest pulse of the snapshot, that can be greater than until.
+func (b *Backuper) Make(ctx context.Context, until insolar.PulseNumber) (*Manifest, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if err := os.MkdirAll(b.dir, 0755); err != nil {
+		return nil, errors.Wrap(err, "failed to create backup directory")
+	}
+	manifests, err := readManifests(b.dir)
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{Version: Version}
+	if len(manifests) > 0 {
+		prev := manifests[len(manifests)-1]
+		if prev.To >= until {
+			return nil, errors.Errorf("pulse %v is already backed up", until)
+		}
+		m.From = prev.To + 1
+		m.Since = prev.Until
+	}
+
+	// File is named by the range of pulses, that is known only when the snapshot is taken.
+	tmpFile := filepath.Join(b.dir, fmt.Sprintf("backup-%d.tmp", m.From))
+	f, err := os.Create(tmpFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create backup file")
+	}
+	defer os.Remove(tmpFile)
+	hash := sha256.New()
+	m.Until, err = b.db.Backup(io.MultiWriter(f, hash), m.Since, func(snap store.Snapshot) error {
+		latest, err := pulse.NewDB(b.db).LatestIn(ctx, snap)
+		if err != nil {
+			return errors.Wrap(err, "failed to fetch latest pulse")
+		}
+		if latest.PulseNumber < until {
+			return errors.Errorf("pulse %v isn't stored yet, latest pulse is %v", until, latest.PulseNumber)
+		}
+		m.To = latest.PulseNumber
+		return nil
+	})
+	closeErr := f.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write backup")
+	}
+	if closeErr != nil {
+		return nil, errors.Wrap(closeErr, "failed to close backup file")
+	}
+	m.File = fmt.Sprintf("backup-%d-%d.bak", m.From, m.To)
+	if err := os.Rename(tmpFile, filepath.Join(b.dir, m.File)); err != nil {
+		return nil, errors.Wrap(err, "failed to rename backup file")
+	}
+	m.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	// Manifest is written last, so the backup without manifest is ignored.
+	buf, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode manifest")
+	}
+	manifestFile := strings.TrimSuffix(m.File, filepath.Ext(m.File)) + manifestSuffix
+	if err := ioutil.WriteFile(filepath.Join(b.dir, manifestFile), buf, 0644); err != nil {
+		return nil, errors.Wrap(err, "failed to write manifest")
+	}
+
+	inslogger.FromContext(ctx).Infof("[ Backup ] pulses %v-%v are backed up to %v", m.From, m.To, m.File)
+	return m, nil
+}
+
+// Restore loads backups from the directory to the empty storage. Backups are loaded in order while they contain
+// pulses up to provided one, zero pulse means all backups. The latest restored pulse is returned.
+func Restore(
+	ctx context.Context, db *store.BadgerDB, dir string, until insolar.PulseNumber,
+) (insolar.PulseNumber, error) {
+	manifests, err := readManifests(dir)
+	if err != nil {
+		return 0, err
+	}
+	if err := verifyChain(manifests); err != nil {
+		return 0, err
+	}
+
+	restored := 0
+	for _, m := range manifests {
+		if until != 0 && m.To > until {
+			break
+		}
+		if err := load(db, dir, m); err != nil {
+			return 0, errors.Wrapf(err, "failed to restore backup %v", m.File)
+		}
+		restored++
+	}
+	if restored == 0 {
+		return 0, errors.Errorf("no backups up to pulse %v found", until)
+	}
+	// Data of older versions is migrated on start of the node, newer data can't be read.
+	if err := migration.Default.Check(db); err != nil {
+		return 0, errors.Wrap(err, "unsupported backup")
+	}
+
+	latest, err := pulse.NewDB(db).Latest(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to fetch latest restored pulse")
+	}
+	return latest.PulseNumber, nil
+}
+
+func load(db *store.BadgerDB, dir string, m Manifest) error {
+	if m.Version != Version {
+		return errors.Errorf("unsupported backup version %v", m.Version)
+	}
+
+	name := filepath.Join(dir, m.File)
+	f, err := os.Open(name)
+	if err != nil {
+		return errors.Wrap(err, "failed to open backup file")
+	}
+	hash := sha256.New()
+	_, err = io.Copy(hash, f)
+	_ = f.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to read backup file")
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != m.Checksum {
+		return errors.New("checksum mismatch")
+	}
+
+	// File is checked before load, so broken backup doesn't leave partially restored storage.
+	f, err = os.Open(name)
+	if err != nil {
+		return errors.Wrap(err, "failed to open backup file")
+	}
+	defer f.Close()
+	return db.Load(f)
+}
+
+// verifyChain checks that backups start from the full one and each of them continues the previous.
+func verifyChain(manifests []Manifest) error {
+	var prev *Manifest
+	for i, m := range manifests {
+		if prev == nil && (m.From != 0 || m.Since != 0) {
+			return errors.Errorf("backup %v isn't full, previous backups are missing", m.File)
+		}
+		if prev != nil && (m.From != prev.To+1 || m.Since != prev.Until) {
+			return errors.Errorf("backup %v doesn't continue backup %v", m.File, prev.File)
+		}
+		prev = &manifests[i]
+	}
+	return nil
+}
+
+// readManifests reads manifests of backups from the directory sorted by pulses.
+func readManifests(dir string) ([]Manifest, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read backup directory")
+	}
+
+	var manifests []Manifest
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != manifestSuffix {
+			continue
+		}
+		buf, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read manifest")
+		}
+		var m Manifest
+		if err := json.Unmarshal(buf, &m); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode manifest %v", file.Name())
+		}
+		manifests = append(manifests, m)
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].From < manifests[j].From
+	})
+	return manifests, nil
+}
@@ -0,0 +1,197 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/migration"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+func TestBackup(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	tmpdir, err := ioutil.TempDir("", "backup-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	openDB := func(name string) *store.BadgerDB {
+		db, err := store.NewBadgerDB(filepath.Join(tmpdir, name))
+		require.NoError(t, err)
+		return db
+	}
+	db := openDB("data")
+	defer db.Stop(ctx)
+	backupDir := filepath.Join(tmpdir, "backup")
+	backuper := NewBackuper(db, backupDir)
+
+	// Stores a pulse with a record of it.
+	write := func(pn insolar.PulseNumber) insolar.ID {
+		require.NoError(t, pulse.NewDB(db).Append(ctx, insolar.Pulse{PulseNumber: pn}))
+		id := gen.ID()
+		obj := gen.Reference()
+		rec := record.Material{
+			Virtual: &record.Virtual{Union: &record.Virtual_Request{Request: &record.Request{Object: &obj}}},
+		}
+		require.NoError(t, object.NewRecordDB(db).Set(ctx, id, rec))
+		return id
+	}
+
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	second := first + 10
+	firstRec := write(first)
+
+	_, err = backuper.Make(ctx, second)
+	assert.Error(t, err, "pulse isn't stored yet")
+
+	full, err := backuper.Make(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, insolar.PulseNumber(0), full.From)
+	assert.Equal(t, first, full.To)
+
+	_, err = backuper.Make(ctx, first)
+	assert.Error(t, err, "pulse is already backed up")
+
+	secondRec := write(second)
+	incremental, err := backuper.Make(ctx, second)
+	require.NoError(t, err)
+	assert.Equal(t, first+1, incremental.From)
+	assert.Equal(t, second, incremental.To)
+	assert.Equal(t, full.Until, incremental.Since)
+
+	t.Run("restores all backups", func(t *testing.T) {
+		restored := openDB("all")
+		defer restored.Stop(ctx)
+
+		latest, err := Restore(ctx, restored, backupDir, 0)
+		require.NoError(t, err)
+		assert.Equal(t, second, latest)
+		records := object.NewRecordDB(restored)
+		_, err = records.ForID(ctx, firstRec)
+		assert.NoError(t, err)
+		_, err = records.ForID(ctx, secondRec)
+		assert.NoError(t, err)
+	})
+
+	t.Run("restores up to pulse", func(t *testing.T) {
+		restored := openDB("first")
+		defer restored.Stop(ctx)
+
+		latest, err := Restore(ctx, restored, backupDir, first)
+		require.NoError(t, err)
+		assert.Equal(t, first, latest)
+		records := object.NewRecordDB(restored)
+		_, err = records.ForID(ctx, firstRec)
+		assert.NoError(t, err)
+		_, err = records.ForID(ctx, secondRec)
+		assert.Equal(t, object.ErrNotFound, err)
+	})
+
+	t.Run("fails on broken chain", func(t *testing.T) {
+		restored := openDB("broken")
+		defer restored.Stop(ctx)
+
+		brokenDir := filepath.Join(tmpdir, "broken-backup")
+		require.NoError(t, os.MkdirAll(brokenDir, 0755))
+		manifest := strings.TrimSuffix(incremental.File, filepath.Ext(incremental.File)) + manifestSuffix
+		for _, name := range []string{incremental.File, manifest} {
+			buf, err := ioutil.ReadFile(filepath.Join(backupDir, name))
+			require.NoError(t, err)
+			require.NoError(t, ioutil.WriteFile(filepath.Join(brokenDir, name), buf, 0644))
+		}
+		_, err := Restore(ctx, restored, brokenDir, 0)
+		assert.Error(t, err, "full backup is missing")
+	})
+
+	t.Run("fails on newer data version", func(t *testing.T) {
+		newer := openDB("newer")
+		defer newer.Stop(ctx)
+		err := newer.Update(func(txn store.Transaction) error {
+			return store.SetSchemaVersion(txn, migration.Default.Latest()+1)
+		})
+		require.NoError(t, err)
+		require.NoError(t, pulse.NewDB(newer).Append(ctx, insolar.Pulse{PulseNumber: first}))
+		newerDir := filepath.Join(tmpdir, "newer-backup")
+		_, err = NewBackuper(newer, newerDir).Make(ctx, first)
+		require.NoError(t, err)
+
+		restored := openDB("newer-restored")
+		defer restored.Stop(ctx)
+		_, err = Restore(ctx, restored, newerDir, 0)
+		assert.Equal(t, migration.ErrNewerVersion, errors.Cause(err))
+	})
+
+	t.Run("fails on checksum mismatch", func(t *testing.T) {
+		restored := openDB("corrupted")
+		defer restored.Stop(ctx)
+
+		f, err := os.OpenFile(filepath.Join(backupDir, full.File), os.O_APPEND|os.O_WRONLY, 0644)
+		require.NoError(t, err)
+		_, err = f.Write([]byte{1})
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		_, err = Restore(ctx, restored, backupDir, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestBackup_RecordsSnapshotRange(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	tmpdir, err := ioutil.TempDir("", "backup-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	db, err := store.NewBadgerDB(filepath.Join(tmpdir, "data"))
+	require.NoError(t, err)
+	defer db.Stop(ctx)
+
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	second := first + 10
+	require.NoError(t, pulse.NewDB(db).Append(ctx, insolar.Pulse{PulseNumber: first}))
+	require.NoError(t, pulse.NewDB(db).Append(ctx, insolar.Pulse{PulseNumber: second}))
+
+	backupDir := filepath.Join(tmpdir, "backup")
+	m, err := NewBackuper(db, backupDir).Make(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, second, m.To, "backup contains all pulses of the snapshot")
+
+	files, err := ioutil.ReadDir(backupDir)
+	require.NoError(t, err)
+	require.Len(t, files, 2, "only backup and manifest are left")
+
+	restored, err := store.NewBadgerDB(filepath.Join(tmpdir, "restored"))
+	require.NoError(t, err)
+	defer restored.Stop(ctx)
+	latest, err := Restore(ctx, restored, backupDir, 0)
+	require.NoError(t, err)
+	assert.Equal(t, m.To, latest)
+}
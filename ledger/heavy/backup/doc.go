@@ -0,0 +1,22 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package backup makes online incremental backups of heavy node storage and restores storage from them.
+//
+// Every backup is a stream of badger entries changed since the previous backup, and a manifest with the range
+// of pulses, badger versions and a checksum of the stream. Backups of a directory form a chain: the first one is
+// full and every next one continues the previous. Restore loads the chain into an empty storage up to a given pulse.
+package backup
@@ -0,0 +1,29 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package exporter provides finalized data of heavy node to external systems.
+//
+// Data is exported by pulses. Each exported pulse contains drops of all jets with their records, blobs and changes
+// of indexes. Pulse is exported only when the next pulse is stored, ExportLag passed since the pulse and drops of all
+// jets of the pulse are replicated from light nodes, so consumers see settled data only. Every batch has a cursor,
+// that should be passed as a start pulse of the next request to resume export.
+//
+// Drop hashes commit to Merkle roots of IDs of their records, so a single record can be proven to belong to a drop
+// without the rest of the drop. Proof contains the record, the path to the Merkle root and the pulse signed by
+// pulsars, and is checked by VerifyProof against a drop hash received from a trusted source, because drop hashes
+// aren't signed. So a verifier still has to trust the source of drop hashes, e.g. a set of independent heavy nodes,
+// while the record itself and its pulse are checked without trusting the API node.
+package exporter
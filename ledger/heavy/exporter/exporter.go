@@ -0,0 +1,320 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// Version is a version of export format. It's increased on every incompatible change of the format.
+const Version = 1
+
+// Result is a batch of exported pulses.
+type Result struct {
+	Version int
+	Pulses  []Pulse
+	// NextFrom is a pulse to start the next export from.
+	NextFrom insolar.PulseNumber
+}
+
+// Pulse is data of a finalized pulse.
+type Pulse struct {
+	PulseNumber insolar.PulseNumber
+	// Timestamp is a time of pulse start in nanoseconds since Unix epoch.
+	Timestamp int64
+	Drops     []Drop
+}
+
+// Drop is a drop of a jet with data stored in the jet during the pulse.
+type Drop struct {
+	JetID    string
+	Hash     []byte
+	PrevHash []byte
+	Records  []Record
+	Blobs    []Blob
+	Indexes  []Index
+}
+
+// Record is a record stored in a jet.
+type Record struct {
+	ID string
+	// Type is a type of the record, e.g. "request" or "activate".
+	Type string
+	// Payload is a protobuf encoded record.Virtual.
+	Payload []byte
+}
+
+// Blob is a memory of objects or a code stored in a jet.
+type Blob struct {
+	ID    string
+	Value []byte
+	// Pruned is set if the value is removed by pruning.
+	Pruned bool `json:",omitempty"`
+}
+
+// Index is a state of an object lifeline changed during the pulse.
+type Index struct {
+	ObjectID            string
+	Parent              string
+	LatestState         string `json:",omitempty"`
+	LatestStateApproved string `json:",omitempty"`
+	ChildPointer        string `json:",omitempty"`
+	LatestRequest       string `json:",omitempty"`
+	LatestUpdate        insolar.PulseNumber
+	Deactivated         bool
+}
+
+// Exporter provides data of finalized pulses.
+type Exporter interface {
+	// Export returns up to size finalized pulses starting from provided one. Zero pulse means the genesis pulse.
+	Export(ctx context.Context, from insolar.PulseNumber, size int) (*Result, error)
+	// Proof returns a proof of inclusion of the record with provided ID to its drop.
+	Proof(ctx context.Context, id insolar.ID) (*Proof, error)
+}
+
+// ExporterDefault is a base impl of Exporter, that reads data from heavy storage.
+type ExporterDefault struct {
+	db     store.DB
+	pulses pulse.Accessor
+	calc   pulse.Calculator
+	pcs    insolar.PlatformCryptographyScheme
+	lag    time.Duration
+	now    func() time.Time
+}
+
+// NewExporter creates new instance of ExporterDefault.
+func NewExporter(
+	db store.DB,
+	pulses pulse.Accessor,
+	calc pulse.Calculator,
+	pcs insolar.PlatformCryptographyScheme,
+	conf configuration.Exporter,
+) *ExporterDefault {
+	return &ExporterDefault{
+		db:     db,
+		pulses: pulses,
+		calc:   calc,
+		pcs:    pcs,
+		lag:    time.Duration(conf.ExportLag) * time.Second,
+		now:    time.Now,
+	}
+}
+
+// Export returns up to size finalized pulses starting from provided one. If provided pulse isn't stored or isn't
+// finalized yet, empty result is returned with the same pulse as a cursor. Pulse is finalized when the next pulse is
+// started, ExportLag passed and drops of all its jets are stored, so export stops on the first pulse, that waits for
+// replication.
+func (e *ExporterDefault) Export(ctx context.Context, from insolar.PulseNumber, size int) (*Result, error) {
+	if size <= 0 {
+		return nil, errors.New("size should be positive")
+	}
+	if from == 0 {
+		from = insolar.GenesisPulse.PulseNumber
+	}
+
+	res := &Result{Version: Version, Pulses: []Pulse{}, NextFrom: from}
+	current, err := e.pulses.ForPulseNumber(ctx, from)
+	if err == pulse.ErrNotFound {
+		return res, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch pulse")
+	}
+
+	for len(res.Pulses) < size && e.settled(current) {
+		// Pulse is finalized only when the next one is started.
+		next, err := e.calc.Forwards(ctx, current.PulseNumber, 1)
+		if err == pulse.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch next pulse")
+		}
+
+		exported, err := e.exportPulse(ctx, current)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to export pulse %v", current.PulseNumber)
+		}
+		if exported == nil {
+			break
+		}
+		res.Pulses = append(res.Pulses, *exported)
+		res.NextFrom = next.PulseNumber
+		current = next
+	}
+
+	return res, nil
+}
+
+// settled checks if ExportLag passed since the start of the pulse.
+func (e *ExporterDefault) settled(p insolar.Pulse) bool {
+	return !time.Unix(0, p.PulseTimestamp).Add(e.lag).After(e.now())
+}
+
+// allJets checks that jets of drops cover all the jets of a pulse. Jets of a pulse are leaves of the jet tree, so
+// every jet has either its own drop or drops of all its descendants.
+func allJets(drops []drop.Drop) bool {
+	jets := make(map[insolar.JetID]struct{}, len(drops))
+	var maxDepth uint8
+	for _, d := range drops {
+		jets[d.JetID] = struct{}{}
+		if d.JetID.Depth() > maxDepth {
+			maxDepth = d.JetID.Depth()
+		}
+	}
+
+	var covered func(id insolar.JetID) bool
+	covered = func(id insolar.JetID) bool {
+		if _, ok := jets[id]; ok {
+			return true
+		}
+		if id.Depth() >= maxDepth {
+			return false
+		}
+		left, right := jet.Children(id)
+		return covered(left) && covered(right)
+	}
+	return covered(insolar.ZeroJetID)
+}
+
+// exportPulse returns data of the pulse or nil if drops of some jets aren't stored yet. Genesis pulse has no drops.
+func (e *ExporterDefault) exportPulse(ctx context.Context, p insolar.Pulse) (*Pulse, error) {
+	drops, err := drop.NewDB(e.db).All(ctx, p.PulseNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch drops")
+	}
+	if p.PulseNumber != insolar.GenesisPulse.PulseNumber && !allJets(drops) {
+		return nil, nil
+	}
+
+	records := object.NewRecordDB(e.db)
+	blobs := blob.NewDB(e.db)
+	indexes := object.NewIndexDB(e.db)
+
+	res := &Pulse{PulseNumber: p.PulseNumber, Timestamp: p.PulseTimestamp, Drops: []Drop{}}
+	for _, d := range drops {
+		jetID := insolar.ID(d.JetID)
+		exported := Drop{
+			JetID:    jetID.String(),
+			Hash:     d.Hash,
+			PrevHash: d.PrevHash,
+			Records:  []Record{},
+			Blobs:    []Blob{},
+			Indexes:  []Index{},
+		}
+
+		for _, rec := range records.ForPulse(ctx, d.JetID, p.PulseNumber) {
+			payload, err := rec.Virtual.Marshal()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to encode record")
+			}
+			id := insolar.NewID(p.PulseNumber, record.HashVirtual(e.pcs.ReferenceHasher(), *rec.Virtual))
+			exported.Records = append(exported.Records, Record{
+				ID:      id.String(),
+				Type:    recordType(rec.Virtual),
+				Payload: payload,
+			})
+		}
+		for _, b := range blobs.ForPulse(ctx, d.JetID, p.PulseNumber) {
+			id := object.CalculateIDForBlob(e.pcs, p.PulseNumber, b.Value)
+			if b.Pruned() {
+				id = insolar.NewID(p.PulseNumber, b.Hash)
+			}
+			exported.Blobs = append(exported.Blobs, Blob{
+				ID:     id.String(),
+				Value:  b.Value,
+				Pruned: b.Pruned(),
+			})
+		}
+		for _, bucket := range indexes.ForPNAndJet(ctx, p.PulseNumber, d.JetID) {
+			exported.Indexes = append(exported.Indexes, exportIndex(bucket))
+		}
+
+		// Storage order isn't stable for records and blobs of the same pulse, so they are sorted by ID.
+		sort.Slice(exported.Records, func(i, j int) bool {
+			return exported.Records[i].ID < exported.Records[j].ID
+		})
+		sort.Slice(exported.Blobs, func(i, j int) bool {
+			return exported.Blobs[i].ID < exported.Blobs[j].ID
+		})
+
+		res.Drops = append(res.Drops, exported)
+	}
+	return res, nil
+}
+
+func exportIndex(bucket object.IndexBucket) Index {
+	idString := func(id *insolar.ID) string {
+		if id == nil {
+			return ""
+		}
+		return id.String()
+	}
+	lifeline := bucket.Lifeline
+	return Index{
+		ObjectID:            bucket.ObjID.String(),
+		Parent:              lifeline.Parent.String(),
+		LatestState:         idString(lifeline.LatestState),
+		LatestStateApproved: idString(lifeline.LatestStateApproved),
+		ChildPointer:        idString(lifeline.ChildPointer),
+		LatestRequest:       idString(lifeline.LatestRequest),
+		LatestUpdate:        lifeline.LatestUpdate,
+		Deactivated:         lifeline.StateID == record.StateDeactivation,
+	}
+}
+
+// recordType returns a stable name of the record type.
+func recordType(rec *record.Virtual) string {
+	switch rec.Union.(type) {
+	case *record.Virtual_Genesis:
+		return "genesis"
+	case *record.Virtual_Child:
+		return "child"
+	case *record.Virtual_Jet:
+		return "jet"
+	case *record.Virtual_Request:
+		return "request"
+	case *record.Virtual_Result:
+		return "result"
+	case *record.Virtual_Type:
+		return "type"
+	case *record.Virtual_Code:
+		return "code"
+	case *record.Virtual_Activate:
+		return "activate"
+	case *record.Virtual_Amend:
+		return "amend"
+	case *record.Virtual_Deactivate:
+		return "deactivate"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,175 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func TestExporter_Export(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	db := store.NewMemoryMockDB()
+	pulses := pulse.NewDB(db)
+
+	start := time.Now()
+	var pns []insolar.PulseNumber
+	for i := 0; i < 4; i++ {
+		pn := insolar.FirstPulseNumber + insolar.PulseNumber(i*10)
+		err := pulses.Append(ctx, insolar.Pulse{
+			PulseNumber:    pn,
+			PulseTimestamp: start.Add(time.Duration(i) * 10 * time.Second).UnixNano(),
+		})
+		require.NoError(t, err)
+		pns = append(pns, pn)
+	}
+
+	// Data of the second pulse.
+	pn := pns[1]
+	jetID := *insolar.NewJetID(1, []byte{0x80})
+	obj := gen.Reference()
+	rec := record.Material{
+		Virtual: &record.Virtual{
+			Union: &record.Virtual_Request{Request: &record.Request{Object: &obj}},
+		},
+		JetID: jetID,
+	}
+	recID := insolar.NewID(pn, record.HashVirtual(pcs.ReferenceHasher(), *rec.Virtual))
+	require.NoError(t, object.NewRecordDB(db).Set(ctx, *recID, rec))
+	value := []byte{1, 2, 3}
+	blobID := object.CalculateIDForBlob(pcs, pn, value)
+	require.NoError(t, blob.NewDB(db).Set(ctx, *blobID, blob.Blob{Value: value, JetID: jetID}))
+	objID := gen.ID()
+	require.NoError(t, object.NewIndexDB(db).SetBucket(ctx, pn, object.IndexBucket{
+		ObjID:    objID,
+		Lifeline: object.Lifeline{JetID: jetID, LatestState: recID, LatestUpdate: pn},
+	}))
+	drops := drop.NewDB(db)
+	require.NoError(t, drops.Set(ctx, drop.Drop{Pulse: pn, JetID: jetID, Hash: []byte{1}, PrevHash: []byte{2}}))
+	// Drops of all jets are stored for the second and the last pulses, the third one waits for the left jet.
+	leftJetID, _ := jet.Children(insolar.ZeroJetID)
+	require.NoError(t, drops.Set(ctx, drop.Drop{Pulse: pn, JetID: leftJetID}))
+	require.NoError(t, drops.Set(ctx, drop.Drop{Pulse: pns[2], JetID: jetID}))
+	require.NoError(t, drops.Set(ctx, drop.Drop{Pulse: pns[3], JetID: insolar.ZeroJetID}))
+
+	exporter := NewExporter(db, pulses, pulses, pcs, configuration.Exporter{ExportLag: 5})
+	exporter.now = func() time.Time {
+		// The third pulse is not settled yet.
+		return start.Add(21 * time.Second)
+	}
+
+	t.Run("exports settled pulses", func(t *testing.T) {
+		res, err := exporter.Export(ctx, pns[0], 10)
+		require.NoError(t, err)
+		assert.Equal(t, Version, res.Version)
+		assert.Equal(t, pns[2], res.NextFrom)
+		require.Len(t, res.Pulses, 2)
+		assert.Equal(t, pns[0], res.Pulses[0].PulseNumber)
+		assert.Empty(t, res.Pulses[0].Drops)
+
+		exported := res.Pulses[1]
+		assert.Equal(t, pns[1], exported.PulseNumber)
+		require.Len(t, exported.Drops, 2)
+		exportedJet := insolar.ID(jetID)
+		d := exported.Drops[0]
+		if d.JetID != exportedJet.String() {
+			d = exported.Drops[1]
+		}
+		assert.Equal(t, exportedJet.String(), d.JetID)
+		assert.Equal(t, []byte{1}, d.Hash)
+		assert.Equal(t, []byte{2}, d.PrevHash)
+
+		payload, err := rec.Virtual.Marshal()
+		require.NoError(t, err)
+		assert.Equal(t, []Record{{ID: recID.String(), Type: "request", Payload: payload}}, d.Records)
+		assert.Equal(t, []Blob{{ID: blobID.String(), Value: value}}, d.Blobs)
+		assert.Equal(t, []Index{{
+			ObjectID:     objID.String(),
+			Parent:       insolar.Reference{}.String(),
+			LatestState:  recID.String(),
+			LatestUpdate: pn,
+		}}, d.Indexes)
+	})
+
+	t.Run("resumes from cursor", func(t *testing.T) {
+		res, err := exporter.Export(ctx, pns[0], 1)
+		require.NoError(t, err)
+		require.Len(t, res.Pulses, 1)
+		assert.Equal(t, pns[1], res.NextFrom)
+
+		res, err = exporter.Export(ctx, res.NextFrom, 1)
+		require.NoError(t, err)
+		require.Len(t, res.Pulses, 1)
+		assert.Equal(t, pns[1], res.Pulses[0].PulseNumber)
+		assert.Equal(t, pns[2], res.NextFrom)
+
+		res, err = exporter.Export(ctx, res.NextFrom, 1)
+		require.NoError(t, err)
+		assert.Empty(t, res.Pulses, "pulse isn't settled")
+		assert.Equal(t, pns[2], res.NextFrom)
+	})
+
+	t.Run("waits for drops of all jets", func(t *testing.T) {
+		exporter.now = func() time.Time {
+			return start.Add(time.Hour)
+		}
+		res, err := exporter.Export(ctx, pns[1], 10)
+		require.NoError(t, err)
+		require.Len(t, res.Pulses, 1)
+		assert.Equal(t, pns[2], res.NextFrom, "pulse isn't skipped")
+
+		require.NoError(t, drops.Set(ctx, drop.Drop{Pulse: pns[2], JetID: leftJetID}))
+		res, err = exporter.Export(ctx, res.NextFrom, 10)
+		require.NoError(t, err)
+		require.Len(t, res.Pulses, 1)
+		assert.Equal(t, pns[2], res.Pulses[0].PulseNumber)
+	})
+
+	t.Run("latest pulse isn't finalized", func(t *testing.T) {
+		res, err := exporter.Export(ctx, pns[2], 10)
+		require.NoError(t, err)
+		require.Len(t, res.Pulses, 1)
+		assert.Equal(t, pns[3], res.NextFrom)
+	})
+
+	t.Run("unknown pulse", func(t *testing.T) {
+		res, err := exporter.Export(ctx, pns[3]+1, 10)
+		require.NoError(t, err)
+		assert.Empty(t, res.Pulses)
+		assert.Equal(t, pns[3]+1, res.NextFrom)
+
+		_, err = exporter.Export(ctx, pns[0], 0)
+		assert.Error(t, err)
+	})
+}
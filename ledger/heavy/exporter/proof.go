@@ -0,0 +1,184 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/pulsar"
+)
+
+// Proof is a proof of inclusion of a record to a drop.
+type Proof struct {
+	Version int
+	Record  Record
+	// JetID, DropHash and PrevHash are the data of the drop the record belongs to.
+	JetID    string
+	DropHash []byte
+	PrevHash []byte
+	// Path is a path from the record to the Merkle root of the drop.
+	Path drop.Proof
+	// Pulse is the pulse of the record with signs of pulsars.
+	Pulse insolar.Pulse
+}
+
+// Proof returns a proof of inclusion of the record with provided ID to its drop.
+func (e *ExporterDefault) Proof(ctx context.Context, id insolar.ID) (*Proof, error) {
+	records := object.NewRecordDB(e.db)
+	rec, err := records.ForID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch record")
+	}
+
+	pn := id.Pulse()
+	d, err := drop.NewDB(e.db).ForPulse(ctx, rec.JetID, pn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch drop of the record")
+	}
+	// Drops are stored by jet prefix, so the drop of a jet with the same prefix and another depth can be found.
+	if d.JetID != rec.JetID {
+		return nil, errors.New("failed to fetch drop of the record: drop not found")
+	}
+
+	path, err := drop.Prove(
+		e.pcs,
+		pn,
+		records.ForPulse(ctx, d.JetID, pn),
+		blob.NewDB(e.db).ForPulse(ctx, d.JetID, pn),
+		id,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build proof")
+	}
+
+	p, err := e.pulses.ForPulseNumber(ctx, pn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch pulse")
+	}
+
+	payload, err := rec.Virtual.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode record")
+	}
+	jetID := insolar.ID(d.JetID)
+	return &Proof{
+		Version:  Version,
+		Record:   Record{ID: id.String(), Type: recordType(rec.Virtual), Payload: payload},
+		JetID:    jetID.String(),
+		DropHash: d.Hash,
+		PrevHash: d.PrevHash,
+		Path:     path,
+		Pulse:    p,
+	}, nil
+}
+
+// VerifyProof checks that the record of the proof is included to the drop with the trusted hash and that the pulse of
+// the record is signed by the majority of trusted pulsars. Trusted pulsars are provided by public keys in PEM format.
+//
+// Drop hashes aren't signed by pulsars or by a quorum of nodes, so the proof doesn't remove trust in ledger nodes
+// completely: it's only as trustworthy as the drop hash. The hash should be received from a source trusted by the
+// verifier, e.g. compared between exports of several independent heavy nodes, and never taken from the proof itself.
+func VerifyProof(
+	pcs insolar.PlatformCryptographyScheme,
+	keyProcessor insolar.KeyProcessor,
+	proof Proof,
+	dropHash []byte,
+	pulsarKeys []string,
+) error {
+	if !bytes.Equal(proof.DropHash, dropHash) {
+		return errors.New("drop hash doesn't match trusted hash")
+	}
+
+	var virtual record.Virtual
+	if err := virtual.Unmarshal(proof.Record.Payload); err != nil {
+		return errors.Wrap(err, "failed to decode record")
+	}
+	id := insolar.NewID(proof.Pulse.PulseNumber, record.HashVirtual(pcs.ReferenceHasher(), virtual))
+	if id.String() != proof.Record.ID {
+		return errors.New("record doesn't match its ID")
+	}
+
+	d := drop.Drop{Pulse: proof.Pulse.PulseNumber, Hash: proof.DropHash, PrevHash: proof.PrevHash}
+	if !drop.VerifyProof(pcs, d, *id, proof.Path) {
+		return errors.New("record isn't included to the drop")
+	}
+
+	return verifyPulse(pcs, keyProcessor, proof.Pulse, pulsarKeys)
+}
+
+// verifyPulse checks that the pulse is signed by the majority of provided pulsars. Signs are stored by public keys of
+// pulsars made them. Duplicated keys are counted once, so a single pulsar can't make the majority.
+func verifyPulse(
+	pcs insolar.PlatformCryptographyScheme,
+	keyProcessor insolar.KeyProcessor,
+	p insolar.Pulse,
+	pulsarKeys []string,
+) error {
+	if len(pulsarKeys) == 0 {
+		return errors.New("no trusted pulsars provided")
+	}
+
+	signed := 0
+	trusted := map[string]struct{}{}
+	for _, pemKey := range pulsarKeys {
+		key, err := keyProcessor.ImportPublicKeyPEM([]byte(pemKey))
+		if err != nil {
+			return errors.Wrap(err, "failed to import pulsar key")
+		}
+		// The same key can be encoded to PEM differently, so keys are compared in binary form.
+		binaryKey, err := keyProcessor.ExportPublicKeyBinary(key)
+		if err != nil {
+			return errors.Wrap(err, "failed to export pulsar key")
+		}
+		if _, ok := trusted[string(binaryKey)]; ok {
+			continue
+		}
+		trusted[string(binaryKey)] = struct{}{}
+
+		sign, ok := p.Signs[pemKey]
+		if !ok {
+			continue
+		}
+		if sign.PulseNumber != p.PulseNumber || !bytes.Equal(sign.Entropy[:], p.Entropy[:]) {
+			return errors.Errorf("sign of pulsar doesn't match pulse %v", p.PulseNumber)
+		}
+		payload := pulsar.PulseSenderConfirmationPayload{PulseSenderConfirmation: sign}
+		hash, err := payload.Hash(pcs.IntegrityHasher())
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate hash of pulse sign")
+		}
+		if !pcs.Verifier(key).Verify(insolar.SignatureFromBytes(sign.Signature), hash) {
+			return errors.Errorf("invalid sign of pulse %v", p.PulseNumber)
+		}
+		signed++
+	}
+
+	if signed <= len(trusted)/2 {
+		return errors.Errorf(
+			"pulse %v is signed by %d of %d trusted pulsars", p.PulseNumber, signed, len(trusted),
+		)
+	}
+	return nil
+}
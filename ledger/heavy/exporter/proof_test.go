@@ -0,0 +1,167 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/pulsar"
+)
+
+func TestExporter_Proof(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	kp := platformpolicy.NewKeyProcessor()
+	db := store.NewMemoryMockDB()
+	pulses := pulse.NewDB(db)
+
+	// Pulse signed by two of three pulsars.
+	var pulsarKeys []string
+	p := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 10, Signs: map[string]insolar.PulseSenderConfirmation{}}
+	p.Entropy[0] = 1
+	for i := 0; i < 3; i++ {
+		privateKey, err := kp.GeneratePrivateKey()
+		require.NoError(t, err)
+		pemKey, err := kp.ExportPublicKeyPEM(kp.ExtractPublicKey(privateKey))
+		require.NoError(t, err)
+		pulsarKeys = append(pulsarKeys, string(pemKey))
+		if i == 2 {
+			continue
+		}
+
+		payload := pulsar.PulseSenderConfirmationPayload{PulseSenderConfirmation: insolar.PulseSenderConfirmation{
+			PulseNumber:     p.PulseNumber,
+			ChosenPublicKey: pulsarKeys[0],
+			Entropy:         p.Entropy,
+		}}
+		hash, err := payload.Hash(pcs.IntegrityHasher())
+		require.NoError(t, err)
+		sign, err := pcs.Signer(privateKey).Sign(hash)
+		require.NoError(t, err)
+		payload.Signature = sign.Bytes()
+		p.Signs[string(pemKey)] = payload.PulseSenderConfirmation
+	}
+	require.NoError(t, pulses.Append(ctx, p))
+
+	pn := p.PulseNumber
+	jetID := *insolar.NewJetID(1, []byte{0x80})
+	var records []record.Material
+	for i := 0; i < 3; i++ {
+		obj := gen.Reference()
+		rec := record.Material{
+			Virtual: &record.Virtual{
+				Union: &record.Virtual_Request{Request: &record.Request{Object: &obj}},
+			},
+			JetID: jetID,
+		}
+		id := insolar.NewID(pn, record.HashVirtual(pcs.ReferenceHasher(), *rec.Virtual))
+		require.NoError(t, object.NewRecordDB(db).Set(ctx, *id, rec))
+		records = append(records, rec)
+	}
+	blobs := []blob.Blob{{Value: []byte{1, 2, 3}, JetID: jetID}}
+	blobID := object.CalculateIDForBlob(pcs, pn, blobs[0].Value)
+	require.NoError(t, blob.NewDB(db).Set(ctx, *blobID, blobs[0]))
+	prevHash := []byte{1, 2, 3}
+	require.NoError(t, drop.NewDB(db).Set(ctx, drop.Drop{
+		Pulse:    pn,
+		JetID:    jetID,
+		Hash:     drop.Hash(pcs, prevHash, pn, records, blobs),
+		PrevHash: prevHash,
+	}))
+
+	dropHash := drop.Hash(pcs, prevHash, pn, records, blobs)
+	exporter := NewExporter(db, pulses, pulses, pcs, configuration.Exporter{})
+	recID := insolar.NewID(pn, record.HashVirtual(pcs.ReferenceHasher(), *records[1].Virtual))
+
+	t.Run("valid proof", func(t *testing.T) {
+		proof, err := exporter.Proof(ctx, *recID)
+		require.NoError(t, err)
+		assert.Equal(t, recID.String(), proof.Record.ID)
+		assert.Equal(t, "request", proof.Record.Type)
+		assert.Equal(t, prevHash, proof.PrevHash)
+		assert.NoError(t, VerifyProof(pcs, kp, *proof, dropHash, pulsarKeys))
+	})
+
+	t.Run("forged record", func(t *testing.T) {
+		proof, err := exporter.Proof(ctx, *recID)
+		require.NoError(t, err)
+		payload, err := records[0].Virtual.Marshal()
+		require.NoError(t, err)
+		proof.Record.Payload = payload
+		assert.Error(t, VerifyProof(pcs, kp, *proof, dropHash, pulsarKeys))
+
+		// Record matches the ID, but isn't included to the drop.
+		obj := gen.Reference()
+		virtual := record.Virtual{Union: &record.Virtual_Request{Request: &record.Request{Object: &obj}}}
+		proof.Record.Payload, err = virtual.Marshal()
+		require.NoError(t, err)
+		proof.Record.ID = insolar.NewID(pn, record.HashVirtual(pcs.ReferenceHasher(), virtual)).String()
+		assert.Error(t, VerifyProof(pcs, kp, *proof, dropHash, pulsarKeys))
+	})
+
+	t.Run("forged drop", func(t *testing.T) {
+		proof, err := exporter.Proof(ctx, *recID)
+		require.NoError(t, err)
+		proof.PrevHash = []byte{3, 2, 1}
+		assert.Error(t, VerifyProof(pcs, kp, *proof, dropHash, pulsarKeys))
+
+		// Forged drop is consistent with the forged record, but its hash isn't trusted.
+		obj := gen.Reference()
+		virtual := record.Virtual{Union: &record.Virtual_Request{Request: &record.Request{Object: &obj}}}
+		proof.Record.Payload, err = virtual.Marshal()
+		require.NoError(t, err)
+		proof.Record.ID = insolar.NewID(pn, record.HashVirtual(pcs.ReferenceHasher(), virtual)).String()
+		proof.Path = drop.Proof{}
+		proof.DropHash = drop.Hash(pcs, proof.PrevHash, pn, []record.Material{{Virtual: &virtual}}, nil)
+		assert.NoError(t, VerifyProof(pcs, kp, *proof, proof.DropHash, pulsarKeys), "proof is consistent")
+		assert.Error(t, VerifyProof(pcs, kp, *proof, dropHash, pulsarKeys))
+	})
+
+	t.Run("not enough pulsar signs", func(t *testing.T) {
+		proof, err := exporter.Proof(ctx, *recID)
+		require.NoError(t, err)
+		delete(proof.Pulse.Signs, pulsarKeys[1])
+		assert.Error(t, VerifyProof(pcs, kp, *proof, dropHash, pulsarKeys))
+
+		duplicated := append([]string{pulsarKeys[0], pulsarKeys[0]}, pulsarKeys...)
+		assert.Error(t, VerifyProof(pcs, kp, *proof, dropHash, duplicated), "duplicated keys are counted once")
+
+		proof, err = exporter.Proof(ctx, *recID)
+		require.NoError(t, err)
+		proof.Pulse.Entropy[0] = 2
+		assert.Error(t, VerifyProof(pcs, kp, *proof, dropHash, pulsarKeys), "entropy doesn't match signs")
+	})
+
+	t.Run("unknown record", func(t *testing.T) {
+		_, err := exporter.Proof(ctx, gen.ID())
+		assert.Error(t, err)
+	})
+}
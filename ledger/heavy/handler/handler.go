@@ -26,10 +26,11 @@ import (
 	"github.com/insolar/insolar/insolar/bus"
 	"github.com/insolar/insolar/insolar/jet"
 	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/ledger/blob"
-	"github.com/insolar/insolar/ledger/drop"
 	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 
@@ -46,12 +47,13 @@ type Handler struct {
 	JetCoordinator        jet.Coordinator
 	PCS                   insolar.PlatformCryptographyScheme
 	BlobAccessor          blob.Accessor
-	BlobModifier          blob.Modifier
 	RecordAccessor        object.RecordAccessor
-	RecordModifier        object.RecordModifier
 	IndexLifelineAccessor object.LifelineAccessor
-	IndexBucketModifier   object.IndexBucketModifier
-	DropModifier          drop.Modifier
+	ChildIndexAccessor    object.ChildIndexAccessor
+	PulseCalculator       pulse.Calculator
+	DB                    store.DB
+	// BlobCodec is a codec values of stored blobs are compressed with.
+	BlobCodec blob.Codec
 
 	jetID insolar.JetID
 }
@@ -110,6 +112,7 @@ func (h *Handler) handle(ctx context.Context, msg *watermillMsg.Message) (insola
 
 func (h *Handler) Init(ctx context.Context) error {
 	h.Bus.MustRegister(insolar.TypeHeavyPayload, h.handleHeavyPayload)
+	h.Bus.MustRegister(insolar.TypeGetHeavyHistory, h.handleGetHeavyHistory)
 
 	h.Bus.MustRegister(insolar.TypeGetCode, h.handleGetCode)
 	h.Bus.MustRegister(insolar.TypeGetDelegate, h.handleGetDelegate)
@@ -189,6 +192,9 @@ func (h *Handler) handleGetObject(
 
 	if state.GetMemory() != nil && state.GetMemory().NotEmpty() {
 		b, err := h.BlobAccessor.ForID(ctx, *state.GetMemory())
+		if err == blob.ErrPruned {
+			return &reply.Error{ErrType: reply.ErrStatePruned}, nil
+		}
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to fetch blob")
 		}
@@ -227,6 +233,10 @@ func (h *Handler) handleGetChildren(
 		return nil, errors.Wrap(err, fmt.Sprintf("failed to fetch index for %v", msg.Parent.Record()))
 	}
 
+	if msg.Prototype != nil {
+		return h.childrenForPrototype(ctx, msg)
+	}
+
 	var (
 		refs         []insolar.Reference
 		currentChild *insolar.ID
@@ -293,6 +303,27 @@ func (h *Handler) handleGetChildren(
 	return &reply.Children{Refs: refs, NextFrom: nil}, nil
 }
 
+// childrenForPrototype returns children of the prototype from the index, so children of other prototypes are not
+// iterated over.
+func (h *Handler) childrenForPrototype(ctx context.Context, msg *message.GetChildren) (insolar.Reply, error) {
+	found, next, err := h.ChildIndexAccessor.ForPrototype(
+		ctx, *msg.Parent.Record(), *msg.Prototype, msg.FromChild, msg.Amount,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve children")
+	}
+
+	var refs []insolar.Reference
+	for _, ref := range found {
+		// Skip records later than specified pulse.
+		if msg.FromPulse != nil && ref.Record().Pulse() > *msg.FromPulse {
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	return &reply.Children{Refs: refs, NextFrom: next}, nil
+}
+
 func (h *Handler) handleGetRequest(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
 	msg := parcel.Message().(*message.GetRequest)
 
@@ -353,14 +384,19 @@ func (h *Handler) getCode(ctx context.Context, id *insolar.ID) (record.Code, err
 func (h *Handler) handleHeavyPayload(ctx context.Context, genericMsg insolar.Parcel) (insolar.Reply, error) {
 	msg := genericMsg.Message().(*message.HeavyPayload)
 
-	storeRecords(ctx, h.RecordModifier, h.PCS, msg.PulseNum, msg.Records)
-	if err := storeIndexBuckets(ctx, h.IndexBucketModifier, msg.IndexBuckets, msg.PulseNum); err != nil {
-		return &reply.HeavyError{Message: err.Error(), JetID: msg.JetID, PulseNum: msg.PulseNum}, nil
+	payload, err := decodePayload(h.PCS, msg)
+	if err == nil {
+		err = storePayload(ctx, h.DB, h.PCS, h.PulseCalculator, h.BlobCodec, payload)
 	}
-	if err := storeDrop(ctx, h.DropModifier, msg.Drop); err != nil {
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrapf(
+			err, "heavyserver: payload of jet %v for pulse %v is rejected", msg.JetID.DebugString(), msg.PulseNum,
+		))
+		stats.Record(ctx,
+			statRejectedHeavyPayloadCount.M(1),
+		)
 		return &reply.HeavyError{Message: err.Error(), JetID: msg.JetID, PulseNum: msg.PulseNum}, nil
 	}
-	storeBlobs(ctx, h.BlobModifier, h.PCS, msg.PulseNum, msg.Blobs)
 
 	stats.Record(ctx,
 		statReceivedHeavyPayloadCount.M(1),
@@ -0,0 +1,320 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/node"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/light/replication"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/utils/backoff"
+)
+
+// historyPageSize is how many drops are sent in a single reply to GetHeavyHistory.
+const historyPageSize = 10
+
+func (h *Handler) handleGetHeavyHistory(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetHeavyHistory)
+
+	drops := drop.NewDB(h.DB)
+	page, err := drops.After(ctx, msg.JetID, msg.PulseNum, historyPageSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch drops")
+	}
+
+	gatherer := replication.NewDataGatherer(drops, blob.NewDB(h.DB), object.NewRecordDB(h.DB), object.NewIndexDB(h.DB))
+	rep := &reply.HeavyHistory{Done: len(page) < historyPageSize}
+	for _, d := range page {
+		payload, err := gatherer.ForPulseAndJet(ctx, d.Pulse, d.JetID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to gather data of jet %v for pulse %v", d.JetID.DebugString(), d.Pulse)
+		}
+		rep.Payloads = append(rep.Payloads, *payload)
+	}
+	return rep, nil
+}
+
+// HistorySyncer is an interface for a component, that bootstraps a heavy node with history of other heavy nodes.
+type HistorySyncer interface {
+	// NotifyAboutPulse notifies a component about a pulse
+	NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber)
+}
+
+const (
+	// historyRetryMin and historyRetryMax bound a delay between attempts to fetch history.
+	historyRetryMin = time.Second
+	historyRetryMax = time.Minute
+)
+
+// HistorySyncerDefault is a base impl of HistorySyncer. It fetches history from another active heavy node,
+// if the node has no history of its own, i.e. it is a new heavy node. Progress of fetching is stored, so it's
+// continued after a restart, and failed attempts are retried until the history is fetched.
+type HistorySyncerDefault struct {
+	once sync.Once
+
+	lock   sync.Mutex
+	state  historyState
+	latest insolar.PulseNumber
+
+	retryMin, retryMax time.Duration
+
+	db             store.DB
+	msgBus         insolar.MessageBus
+	jetCoordinator jet.Coordinator
+	nodes          node.Accessor
+	pcs            insolar.PlatformCryptographyScheme
+	pulses         pulse.Calculator
+	codec          blob.Codec
+}
+
+// NewHistorySyncer creates new instance of HistorySyncerDefault. The node is considered new if it has no drops
+// except genesis ones, when the syncer is created for the first time. Fetched blobs are stored compressed with
+// provided codec.
+func NewHistorySyncer(
+	db store.DB,
+	msgBus insolar.MessageBus,
+	jetCoordinator jet.Coordinator,
+	nodes node.Accessor,
+	pcs insolar.PlatformCryptographyScheme,
+	pulses pulse.Calculator,
+	codec blob.Codec,
+) (*HistorySyncerDefault, error) {
+	state, err := loadHistoryState(db)
+	if err == store.ErrNotFound {
+		fresh, err := hasNoHistory(context.Background(), db)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check history")
+		}
+		state = historyState{Done: !fresh, Cursor: message.GetHeavyHistory{JetID: insolar.ZeroJetID}}
+		// State is stored before new drops are received, so the node is still considered new after a restart.
+		err = saveHistoryState(db, state)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to store history state")
+		}
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to load history state")
+	}
+
+	return &HistorySyncerDefault{
+		state:          state,
+		retryMin:       historyRetryMin,
+		retryMax:       historyRetryMax,
+		db:             db,
+		msgBus:         msgBus,
+		jetCoordinator: jetCoordinator,
+		nodes:          nodes,
+		pcs:            pcs,
+		pulses:         pulses,
+		codec:          codec,
+	}, nil
+}
+
+// NotifyAboutPulse starts fetching of history on the first pulse if the node is a new one.
+func (s *HistorySyncerDefault) NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber) {
+	s.lock.Lock()
+	s.latest = pn
+	done := s.state.Done
+	s.lock.Unlock()
+
+	if done {
+		return
+	}
+	s.once.Do(func() {
+		go s.run(ctx)
+	})
+}
+
+// run fetches history until it's fetched. Active heavy nodes are taken for the latest known pulse.
+func (s *HistorySyncerDefault) run(ctx context.Context) {
+	bo := backoff.Backoff{Factor: 2, Jitter: true, Min: s.retryMin, Max: s.retryMax}
+	for {
+		s.lock.Lock()
+		pn := s.latest
+		s.lock.Unlock()
+
+		err := s.sync(ctx, pn)
+		if err == nil {
+			return
+		}
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "[HistorySyncer] failed to fetch history, will retry"))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(bo.Duration()):
+		}
+	}
+}
+
+func (s *HistorySyncerDefault) sync(ctx context.Context, pn insolar.PulseNumber) error {
+	logger := inslogger.FromContext(ctx)
+
+	heavies, err := s.nodes.InRole(pn, insolar.StaticRoleHeavyMaterial)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch heavy nodes")
+	}
+
+	me := s.jetCoordinator.Me()
+	err = nil
+	for _, heavy := range heavies {
+		if heavy.ID.Equal(me) {
+			continue
+		}
+		err = s.syncFrom(ctx, heavy.ID)
+		if err != nil {
+			logger.Error(errors.Wrapf(err, "[HistorySyncer] failed to fetch history from heavy %v", heavy.ID))
+			continue
+		}
+		logger.Infof("[HistorySyncer] history is fetched from heavy %v", heavy.ID)
+		break
+	}
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.state.Done = true
+	return saveHistoryState(s.db, s.state)
+}
+
+// syncFrom fetches history from source starting after the last fetched drop. The last fetched drop is stored after
+// every page of history.
+func (s *HistorySyncerDefault) syncFrom(ctx context.Context, source insolar.Reference) error {
+	s.lock.Lock()
+	cursor := s.state.Cursor
+	s.lock.Unlock()
+	for {
+		msg := cursor
+		rep, err := s.msgBus.Send(ctx, &msg, &insolar.MessageSendOptions{Receiver: &source})
+		if err != nil {
+			return err
+		}
+		history, ok := rep.(*reply.HeavyHistory)
+		if !ok {
+			return fmt.Errorf("unexpected reply type %T", rep)
+		}
+
+		for i := range history.Payloads {
+			p := &history.Payloads[i]
+			cursor = message.GetHeavyHistory{JetID: p.JetID, PulseNum: p.PulseNum}
+			// Genesis data is created by every heavy node by itself.
+			if p.PulseNum <= insolar.GenesisPulse.PulseNumber {
+				continue
+			}
+
+			payload, err := decodePayload(s.pcs, p)
+			if err != nil {
+				return err
+			}
+			err = storePayload(ctx, s.db, s.pcs, s.pulses, s.codec, payload)
+			if err != nil {
+				return err
+			}
+		}
+
+		s.lock.Lock()
+		s.state.Cursor = cursor
+		err = saveHistoryState(s.db, s.state)
+		s.lock.Unlock()
+		if err != nil {
+			return errors.Wrap(err, "failed to store history state")
+		}
+		if history.Done {
+			return nil
+		}
+	}
+}
+
+// hasNoHistory checks that db has no drops except genesis ones.
+func hasNoHistory(ctx context.Context, db store.DB) (bool, error) {
+	drops := drop.NewDB(db)
+	cursor := drop.Drop{JetID: insolar.ZeroJetID}
+	for {
+		page, err := drops.After(ctx, cursor.JetID, cursor.Pulse, historyPageSize)
+		if err != nil {
+			return false, err
+		}
+		for _, d := range page {
+			if d.Pulse > insolar.GenesisPulse.PulseNumber {
+				return false, nil
+			}
+			cursor = d
+		}
+		if len(page) < historyPageSize {
+			return true, nil
+		}
+	}
+}
+
+// historyState is a progress of fetching of history.
+type historyState struct {
+	// Done is true if history is fetched or the node doesn't need it.
+	Done bool
+	// Cursor points to the last fetched drop.
+	Cursor message.GetHeavyHistory
+}
+
+type historyStateKey struct{}
+
+func (historyStateKey) Scope() store.Scope {
+	return store.ScopeSyncState
+}
+
+func (historyStateKey) ID() []byte {
+	return []byte("history")
+}
+
+func loadHistoryState(db store.DB) (historyState, error) {
+	buf, err := db.Get(historyStateKey{})
+	if err != nil {
+		return historyState{}, err
+	}
+	var state historyState
+	if len(buf) != 1+insolar.PulseNumberSize+len(state.Cursor.JetID) {
+		return historyState{}, errors.Errorf("malformed history state %x", buf)
+	}
+	state.Done = buf[0] == 1
+	state.Cursor.PulseNum = insolar.NewPulseNumber(buf[1 : 1+insolar.PulseNumberSize])
+	copy(state.Cursor.JetID[:], buf[1+insolar.PulseNumberSize:])
+	return state, nil
+}
+
+func saveHistoryState(db store.DB, state historyState) error {
+	buf := []byte{0}
+	if state.Done {
+		buf[0] = 1
+	}
+	buf = append(buf, state.Cursor.PulseNum.Bytes()...)
+	buf = append(buf, state.Cursor.JetID[:]...)
+	return db.Set(historyStateKey{}, buf)
+}
@@ -0,0 +1,196 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/node"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+)
+
+// historySource is a heavy with the chain of two drops for one jet and more drops, than fit in a page of history.
+type historySource struct {
+	*Handler
+	drops   []drop.Drop
+	records []insolar.ID
+}
+
+func newHistorySource(
+	t *testing.T,
+	pcs insolar.PlatformCryptographyScheme,
+	pulses pulse.Calculator,
+	pn insolar.PulseNumber,
+) *historySource {
+	ctx := inslogger.TestContext(t)
+	source := &historySource{Handler: New()}
+	source.PCS = pcs
+	source.DB = store.NewMemoryMockDB()
+	source.PulseCalculator = pulses
+
+	addPayload := func(b *payloadBuilder, prevHash []byte) drop.Drop {
+		b.addRecord()
+		b.addBlob(append(b.jetID.Prefix(), b.pn.Bytes()...))
+		d := b.drop(prevHash)
+		rep, err := source.handleHeavyPayload(ctx, testParcel{msg: b.build(d)})
+		require.NoError(t, err)
+		require.Equal(t, &reply.OK{}, rep)
+		source.drops = append(source.drops, d)
+		for _, rec := range b.records {
+			source.records = append(source.records, *insolar.NewID(b.pn, record.HashVirtual(pcs.ReferenceHasher(), *rec.Virtual)))
+		}
+		return d
+	}
+	jetID := *insolar.NewJetID(8, []byte{0xff})
+	first := addPayload(&payloadBuilder{pcs: pcs, jetID: jetID, pn: pn}, nil)
+	addPayload(&payloadBuilder{pcs: pcs, jetID: jetID, pn: pn + 10}, first.Hash)
+	for i := 0; i < historyPageSize; i++ {
+		addPayload(&payloadBuilder{pcs: pcs, jetID: *insolar.NewJetID(8, []byte{byte(i)}), pn: pn}, nil)
+	}
+	return source
+}
+
+func (s *historySource) requireFetched(t *testing.T, db store.DB) {
+	ctx := inslogger.TestContext(t)
+	for _, d := range s.drops {
+		stored, err := drop.NewDB(db).ForPulse(ctx, d.JetID, d.Pulse)
+		require.NoError(t, err)
+		require.Equal(t, d, stored)
+	}
+	for _, id := range s.records {
+		_, err := object.NewRecordDB(db).ForID(ctx, id)
+		require.NoError(t, err)
+	}
+}
+
+func TestHistorySyncer(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber + 100)
+
+	pulses := pulse.NewCalculatorMock(t)
+	pulses.BackwardsFunc = func(ctx context.Context, p insolar.PulseNumber, steps int) (insolar.Pulse, error) {
+		return insolar.Pulse{PulseNumber: p - 10}, nil
+	}
+	source := newHistorySource(t, pcs, pulses, pn)
+
+	me, sourceRef := gen.Reference(), gen.Reference()
+	coordinator := jet.NewCoordinatorMock(t)
+	coordinator.MeMock.Return(me)
+	nodes := node.NewAccessorMock(t)
+	nodes.InRoleMock.Expect(pn+20, insolar.StaticRoleHeavyMaterial).Return([]insolar.Node{{ID: me}, {ID: sourceRef}}, nil)
+
+	t.Run("fetches history of a new node", func(t *testing.T) {
+		bus := testutils.NewMessageBusMock(t)
+		bus.SendFunc = func(ctx context.Context, msg insolar.Message, o *insolar.MessageSendOptions) (insolar.Reply, error) {
+			require.Equal(t, sourceRef, *o.Receiver)
+			return source.handleGetHeavyHistory(ctx, testParcel{msg: msg})
+		}
+
+		targetDB := store.NewMemoryMockDB()
+		syncer, err := NewHistorySyncer(targetDB, bus, coordinator, nodes, pcs, pulses, blob.CodecZstd)
+		require.NoError(t, err)
+		require.False(t, syncer.state.Done)
+
+		require.NoError(t, syncer.sync(ctx, pn+20))
+
+		require.Equal(t, uint64(2), bus.SendCounter)
+		source.requireFetched(t, targetDB)
+
+		// The node isn't new anymore.
+		syncer, err = NewHistorySyncer(targetDB, bus, coordinator, nodes, pcs, pulses, blob.CodecZstd)
+		require.NoError(t, err)
+		require.True(t, syncer.state.Done)
+	})
+
+	t.Run("continues fetching after a restart", func(t *testing.T) {
+		var cursors []message.GetHeavyHistory
+		bus := testutils.NewMessageBusMock(t)
+		bus.SendFunc = func(ctx context.Context, msg insolar.Message, o *insolar.MessageSendOptions) (insolar.Reply, error) {
+			cursors = append(cursors, *msg.(*message.GetHeavyHistory))
+			if len(cursors) == 2 {
+				return nil, errors.New("source is unavailable")
+			}
+			return source.handleGetHeavyHistory(ctx, testParcel{msg: msg})
+		}
+
+		targetDB := store.NewMemoryMockDB()
+		syncer, err := NewHistorySyncer(targetDB, bus, coordinator, nodes, pcs, pulses, blob.CodecZstd)
+		require.NoError(t, err)
+		require.Error(t, syncer.sync(ctx, pn+20))
+
+		// The node has drops of the first page, but it's still new.
+		syncer, err = NewHistorySyncer(targetDB, bus, coordinator, nodes, pcs, pulses, blob.CodecZstd)
+		require.NoError(t, err)
+		require.False(t, syncer.state.Done)
+
+		require.NoError(t, syncer.sync(ctx, pn+20))
+		require.Len(t, cursors, 3)
+		require.Equal(t, cursors[1], cursors[2], "fetching is continued from the stored cursor")
+		source.requireFetched(t, targetDB)
+	})
+
+	t.Run("retries failed attempts", func(t *testing.T) {
+		bus := testutils.NewMessageBusMock(t)
+		bus.SendFunc = func(ctx context.Context, msg insolar.Message, o *insolar.MessageSendOptions) (insolar.Reply, error) {
+			if bus.SendCounter == 0 {
+				return nil, errors.New("source is unavailable")
+			}
+			return source.handleGetHeavyHistory(ctx, testParcel{msg: msg})
+		}
+
+		targetDB := store.NewMemoryMockDB()
+		syncer, err := NewHistorySyncer(targetDB, bus, coordinator, nodes, pcs, pulses, blob.CodecZstd)
+		require.NoError(t, err)
+		syncer.retryMin, syncer.retryMax = time.Millisecond, time.Millisecond
+		syncer.latest = pn + 20
+
+		syncer.run(ctx)
+
+		require.Equal(t, uint64(3), bus.SendCounter)
+		require.True(t, syncer.state.Done)
+		source.requireFetched(t, targetDB)
+	})
+}
+
+func TestHandler_HandleGetHeavyHistory_Empty(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	h := New()
+	h.DB = store.NewMemoryMockDB()
+
+	rep, err := h.handleGetHeavyHistory(ctx, testParcel{msg: &message.GetHeavyHistory{JetID: insolar.ZeroJetID}})
+	require.NoError(t, err)
+	require.Equal(t, &reply.HeavyHistory{Done: true}, rep)
+}
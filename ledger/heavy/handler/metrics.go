@@ -27,6 +27,11 @@ var (
 		"How many heavy-payload messages were received from a light-node",
 		stats.UnitDimensionless,
 	)
+	statRejectedHeavyPayloadCount = stats.Int64(
+		"heavysyncer/heavypayload/rejected",
+		"How many heavy-payload messages from a light-node were rejected",
+		stats.UnitDimensionless,
+	)
 )
 
 func init() {
@@ -37,6 +42,12 @@ func init() {
 			Measure:     statReceivedHeavyPayloadCount,
 			Aggregation: view.Count(),
 		},
+		&view.View{
+			Name:        statRejectedHeavyPayloadCount.Name(),
+			Description: statRejectedHeavyPayloadCount.Description(),
+			Measure:     statRejectedHeavyPayloadCount,
+			Aggregation: view.Count(),
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -17,107 +17,350 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 
+	"github.com/pkg/errors"
+
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/ledger/blob"
 	"github.com/insolar/insolar/ledger/drop"
 	"github.com/insolar/insolar/ledger/object"
-	"github.com/pkg/errors"
 )
 
-func storeIndexBuckets(
-	ctx context.Context,
-	indexes object.IndexBucketModifier,
-	rawBuckets [][]byte,
-	pn insolar.PulseNumber,
-) error {
-	for _, rwb := range rawBuckets {
-		buck := object.IndexBucket{}
-		err := buck.Unmarshal(rwb)
+const (
+	// storeAttempts is how many times a transaction is retried if it conflicts with a concurrent one.
+	storeAttempts = 3
+	// storeBatchCount and storeBatchSize limit a transaction of records and blobs storing, so that big payloads don't
+	// exceed transaction limits of the store.
+	storeBatchCount = 1000
+	storeBatchSize  = 4 * 1024 * 1024
+)
+
+// ErrPrevDropNotFound is returned when drop continues a chain, but previous drop of the chain is not stored yet.
+// Payload should be sent again after the payload of previous drop.
+var ErrPrevDropNotFound = errors.New("previous drop is not stored yet")
+
+// heavyPayload is a decoded HeavyPayload.
+type heavyPayload struct {
+	drop    drop.Drop
+	records []record.Material
+	blobs   []blob.Blob
+	buckets []object.IndexBucket
+}
+
+// decodePayload decodes payload and verifies that records and blobs of payload match hash of its drop.
+func decodePayload(pcs insolar.PlatformCryptographyScheme, msg *message.HeavyPayload) (*heavyPayload, error) {
+	d, err := drop.Decode(msg.Drop)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode drop")
+	}
+	if d.JetID != msg.JetID || d.Pulse != msg.PulseNum {
+		return nil, errors.Errorf(
+			"drop of jet %v for pulse %v doesn't match payload", d.JetID.DebugString(), d.Pulse,
+		)
+	}
+
+	payload := &heavyPayload{drop: *d}
+	for i, raw := range msg.Records {
+		rec := record.Material{}
+		err := rec.Unmarshal(raw)
 		if err != nil {
-			inslogger.FromContext(ctx).Error(err)
-			continue
+			return nil, errors.Wrapf(err, "failed to decode record %d", i)
 		}
-
-		err = indexes.SetBucket(ctx, pn, buck)
+		if rec.Virtual == nil {
+			return nil, errors.Errorf("record %d is empty", i)
+		}
+		if rec.JetID != msg.JetID {
+			return nil, errors.Errorf("record %d belongs to another jet %v", i, rec.JetID.DebugString())
+		}
+		payload.records = append(payload.records, rec)
+	}
+	for i, raw := range msg.Blobs {
+		b, err := blob.Decode(raw)
 		if err != nil {
-			return errors.Wrapf(err, "heavyserver: index storing failed")
+			return nil, errors.Wrapf(err, "failed to decode blob %d", i)
+		}
+		if b.JetID != msg.JetID {
+			return nil, errors.Errorf("blob %d belongs to another jet %v", i, b.JetID.DebugString())
 		}
+		payload.blobs = append(payload.blobs, *b)
+	}
+	for i, raw := range msg.IndexBuckets {
+		bucket := object.IndexBucket{}
+		err := bucket.Unmarshal(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode index bucket %d", i)
+		}
+		payload.buckets = append(payload.buckets, bucket)
 	}
 
-	return nil
+	hash := drop.Hash(pcs, d.PrevHash, d.Pulse, payload.records, payload.blobs)
+	if !bytes.Equal(hash, d.Hash) {
+		return nil, errors.New("records and blobs don't match hash of drop")
+	}
+
+	return payload, nil
 }
 
-func storeDrop(
+// storePayload saves data of payload. Records and blobs are saved first in batches of transactions. They are
+// addressed by hash and aren't reachable from indexes of objects until the payload is stored, so data of a failed
+// payload is harmless and is overwritten when the payload is sent again. Index buckets, indexes of children and the
+// drop are saved in one transaction after that, so indexes are readable only if the drop is stored.
+// Payload with a drop that is already stored is ignored.
+func storePayload(
 	ctx context.Context,
-	drops drop.Modifier,
-	rawDrop []byte,
+	db store.DB,
+	pcs insolar.PlatformCryptographyScheme,
+	pulses pulse.Calculator,
+	codec blob.Codec,
+	payload *heavyPayload,
 ) error {
-	d, err := drop.Decode(rawDrop)
+	pn := payload.drop.Pulse
+	drops := drop.NewDB(db)
+
+	stored, err := checkStoredDrop(ctx, drops, payload.drop)
+	if err != nil || stored {
+		return err
+	}
+
+	err = verifyDropChain(ctx, drops, pulses, pcs, payload.drop)
+	if err != nil {
+		return err
+	}
+
+	batch := &storeBatch{db: db}
+	for _, rec := range payload.records {
+		rec := rec
+		id := insolar.NewID(pn, record.HashVirtual(pcs.ReferenceHasher(), *rec.Virtual))
+		err := batch.add(ctx, rec.Size(), func(db store.DB) error {
+			err := object.NewRecordDB(db).Set(ctx, *id, rec)
+			// Records are addressed by hash, so the same record is ok.
+			if err != nil && err != object.ErrOverride {
+				return errors.Wrap(err, "heavyserver: record storing failed")
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, b := range payload.blobs {
+		b := b
+		err := batch.add(ctx, len(b.Value), func(db store.DB) error {
+			err := blob.NewCompressedDB(db, codec).Set(ctx, *object.CalculateIDForBlob(pcs, pn, b.Value), b)
+			// Blobs are addressed by hash, so the same blob is ok.
+			if err != nil && err != blob.ErrOverride {
+				return errors.Wrap(err, "heavyserver: blob storing failed")
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	// Children are indexed by records of the payload.
+	err = batch.flush(ctx)
 	if err != nil {
-		inslogger.FromContext(ctx).Error(err)
 		return err
 	}
-	err = drops.Set(ctx, *d)
+
+	return update(db, func(db store.DB) error {
+		drops := drop.NewDB(db)
+		stored, err := checkStoredDrop(ctx, drops, payload.drop)
+		if err != nil || stored {
+			return err
+		}
+		for _, bucket := range payload.buckets {
+			err := object.NewIndexDB(db).SetBucket(ctx, pn, bucket)
+			if err != nil {
+				return errors.Wrap(err, "heavyserver: index storing failed")
+			}
+			err = indexChildren(ctx, object.NewRecordDB(db), object.NewChildIndexDB(db), pn, bucket.ObjID, bucket.Lifeline)
+			if err != nil {
+				return errors.Wrap(err, "heavyserver: children indexing failed")
+			}
+		}
+		err = drops.Set(ctx, payload.drop)
+		if err != nil {
+			return errors.Wrap(err, "heavyserver: drop storing failed")
+		}
+		return nil
+	})
+}
+
+// checkStoredDrop returns true if the drop is already stored and an error if another drop is stored for its jet and pulse.
+func checkStoredDrop(ctx context.Context, drops drop.Accessor, d drop.Drop) (bool, error) {
+	stored, err := drops.ForPulse(ctx, d.JetID, d.Pulse)
+	if err == drop.ErrNotFound {
+		return false, nil
+	}
 	if err != nil {
-		return errors.Wrapf(err, "heavyserver: drop storing failed")
+		return false, errors.Wrap(err, "failed to get stored drop")
+	}
+	if stored.JetID != d.JetID || !bytes.Equal(stored.Hash, d.Hash) {
+		return false, errors.New("another drop is already stored for jet and pulse")
 	}
+	inslogger.FromContext(ctx).Infof(
+		"heavyserver: payload of jet %v for pulse %v is already stored", d.JetID.DebugString(), d.Pulse,
+	)
+	return true, nil
+}
+
+// update executes fn in a transaction, the transaction is retried if it conflicts with a concurrent one.
+func update(db store.DB, fn func(db store.DB) error) error {
+	var err error
+	for i := 0; i < storeAttempts; i++ {
+		err = db.Update(func(txn store.Transaction) error {
+			return fn(store.NewTransactionDB(txn))
+		})
+		if err != store.ErrConflict {
+			return err
+		}
+	}
+	return err
+}
 
+// storeBatch groups writes into transactions limited by storeBatchCount and storeBatchSize.
+type storeBatch struct {
+	db   store.DB
+	ops  []func(db store.DB) error
+	size int
+}
+
+func (b *storeBatch) add(ctx context.Context, size int, op func(db store.DB) error) error {
+	if len(b.ops) >= storeBatchCount || (len(b.ops) > 0 && b.size+size > storeBatchSize) {
+		err := b.flush(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	b.ops = append(b.ops, op)
+	b.size += size
 	return nil
 }
 
-func storeBlobs(
+func (b *storeBatch) flush(ctx context.Context) error {
+	if len(b.ops) == 0 {
+		return nil
+	}
+	ops := b.ops
+	b.ops, b.size = nil, 0
+	return update(b.db, func(db store.DB) error {
+		for _, op := range ops {
+			if err := op(db); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// indexChildren adds children registered in the pulse to the index of children by prototype. Children are linked from
+// the latest one and are registered in the jet of the parent, so children of the pulse are at the head of the list.
+// Children registered without prototype are indexed only by position.
+func indexChildren(
 	ctx context.Context,
-	blobs blob.Modifier,
-	pcs insolar.PlatformCryptographyScheme,
+	records object.RecordAccessor,
+	children object.ChildIndexModifier,
 	pn insolar.PulseNumber,
-	rawBlobs [][]byte,
-) {
-	inslog := inslogger.FromContext(ctx)
-
-	for _, rwb := range rawBlobs {
-		b, err := blob.Decode(rwb)
+	parent insolar.ID,
+	lifeline object.Lifeline,
+) error {
+	var number uint32
+	for next := lifeline.ChildPointer; next != nil && next.Pulse() == pn; number++ {
+		id := *next
+		rec, err := records.ForID(ctx, id)
+		if err == object.ErrNotFound {
+			inslogger.FromContext(ctx).Warnf(
+				"heavyserver: child %s of %s is not found, it's not indexed", id.DebugString(), parent.DebugString(),
+			)
+			return nil
+		}
 		if err != nil {
-			inslog.Error(err, "heavyserver: deserialize blob failed")
-			continue
+			return errors.Wrapf(err, "failed to fetch child %s", id.DebugString())
 		}
-
-		blobID := object.CalculateIDForBlob(pcs, pn, b.Value)
-		err = blobs.Set(ctx, *blobID, *b)
+		child, ok := record.Unwrap(rec.Virtual).(*record.Child)
+		if !ok {
+			return errors.Errorf("child pointer %s doesn't point to a child record", id.DebugString())
+		}
+		err = children.Set(ctx, parent, id, number, child.Prototype, child.Ref)
 		if err != nil {
-			inslog.Error(err, "heavyserver: blob storing failed")
-			continue
+			return err
 		}
+		next = &child.PrevChild
 	}
+	return nil
 }
 
-func storeRecords(
+// verifyDropChain checks that drop continues the chain of drops of its jet. Previous drop is the drop of the jet or
+// of its parent, if the jet was split. If the jet was created by merge, it continues both drops of its children.
+// Drop without previous hash starts the chain, ErrPrevDropNotFound is returned if previous drop isn't stored.
+func verifyDropChain(
 	ctx context.Context,
-	records object.RecordModifier,
+	drops drop.Accessor,
+	pulses pulse.Calculator,
 	pcs insolar.PlatformCryptographyScheme,
-	pn insolar.PulseNumber,
-	rawRecords [][]byte,
-) {
-	inslog := inslogger.FromContext(ctx)
+	d drop.Drop,
+) error {
+	prevPulse, err := pulses.Backwards(ctx, d.Pulse, 1)
+	if err == pulse.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate previous pulse")
+	}
 
-	for _, rawRec := range rawRecords {
-		rec := record.Material{}
-		err := rec.Unmarshal(rawRec)
-		if err != nil {
-			inslog.Error(err, "heavyserver: deserialize record failed")
-			continue
+	// Drops are stored by jet prefix, so the drop of a jet with the same prefix and another depth can be found.
+	forJet := func(jetID insolar.JetID) (drop.Drop, error) {
+		found, err := drops.ForPulse(ctx, jetID, prevPulse.PulseNumber)
+		if err == nil && found.JetID != jetID {
+			return drop.Drop{}, drop.ErrNotFound
 		}
+		return found, err
+	}
 
-		virtRec := *rec.Virtual
-		hash := record.HashVirtual(pcs.ReferenceHasher(), virtRec)
-		id := insolar.NewID(pn, hash)
-		err = records.Set(ctx, *id, rec)
-		if err != nil {
-			inslog.Error(err, "heavyserver: store record failed")
-			continue
+	var prevHash []byte
+	prev, err := forJet(d.JetID)
+	if err == drop.ErrNotFound {
+		prev, err = forJet(jet.Parent(d.JetID))
+	}
+	if err == nil {
+		prevHash = prev.Hash
+	}
+	if err == drop.ErrNotFound {
+		leftID, rightID := jet.Children(d.JetID)
+		left, leftErr := forJet(leftID)
+		right, rightErr := forJet(rightID)
+		switch {
+		case leftErr == nil && rightErr == nil:
+			prevHash, err = drop.MergedHash(pcs, left.Hash, right.Hash), nil
+		case leftErr != nil && leftErr != drop.ErrNotFound:
+			err = leftErr
+		case rightErr != nil && rightErr != drop.ErrNotFound:
+			err = rightErr
+		}
+	}
+	if err == drop.ErrNotFound {
+		if len(d.PrevHash) == 0 {
+			return nil
 		}
+		return errors.Wrapf(ErrPrevDropNotFound, "drop of jet %v for pulse %v", d.JetID.DebugString(), d.Pulse)
 	}
+	if err != nil {
+		return errors.Wrap(err, "failed to get previous drop")
+	}
+
+	if !bytes.Equal(prevHash, d.PrevHash) {
+		return errors.Errorf(
+			"drop of jet %v for pulse %v doesn't continue drop chain", d.JetID.DebugString(), d.Pulse,
+		)
+	}
+	return nil
 }
@@ -0,0 +1,348 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+type payloadBuilder struct {
+	pcs     insolar.PlatformCryptographyScheme
+	jetID   insolar.JetID
+	pn      insolar.PulseNumber
+	records []record.Material
+	blobs   []blob.Blob
+	buckets []object.IndexBucket
+}
+
+func (b *payloadBuilder) addRecord() {
+	obj := gen.Reference()
+	b.records = append(b.records, record.Material{
+		Virtual: &record.Virtual{
+			Union: &record.Virtual_Request{Request: &record.Request{Object: &obj}},
+		},
+		JetID: b.jetID,
+	})
+}
+
+func (b *payloadBuilder) addBlob(value []byte) {
+	b.blobs = append(b.blobs, blob.Blob{Value: value, JetID: b.jetID})
+}
+
+// addChildren registers children of provided prototypes from the earliest to the latest and returns their refs.
+func (b *payloadBuilder) addChildren(parent insolar.ID, prototypes ...*insolar.Reference) []insolar.Reference {
+	var (
+		refs []insolar.Reference
+		prev insolar.ID
+	)
+	for _, proto := range prototypes {
+		ref := gen.Reference()
+		virtual := record.Wrap(record.Child{PrevChild: prev, Ref: ref, Prototype: proto})
+		b.records = append(b.records, record.Material{Virtual: &virtual, JetID: b.jetID})
+		prev = *insolar.NewID(b.pn, record.HashVirtual(b.pcs.ReferenceHasher(), virtual))
+		refs = append(refs, ref)
+	}
+	b.buckets = append(b.buckets, object.IndexBucket{
+		ObjID:    parent,
+		Lifeline: object.Lifeline{ChildPointer: &prev, JetID: b.jetID},
+	})
+	return refs
+}
+
+func (b *payloadBuilder) drop(prevHash []byte) drop.Drop {
+	return drop.Drop{
+		Pulse:    b.pn,
+		JetID:    b.jetID,
+		PrevHash: prevHash,
+		Hash:     drop.Hash(b.pcs, prevHash, b.pn, b.records, b.blobs),
+	}
+}
+
+func (b *payloadBuilder) build(d drop.Drop) *message.HeavyPayload {
+	msg := &message.HeavyPayload{
+		JetID:    b.jetID,
+		PulseNum: b.pn,
+		Drop:     drop.MustEncode(&d),
+	}
+	for _, rec := range b.records {
+		raw, err := rec.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		msg.Records = append(msg.Records, raw)
+	}
+	for _, bl := range b.blobs {
+		bl := bl
+		msg.Blobs = append(msg.Blobs, blob.MustEncode(&bl))
+	}
+	for _, bucket := range b.buckets {
+		raw, err := bucket.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		msg.IndexBuckets = append(msg.IndexBuckets, raw)
+	}
+	return msg
+}
+
+type testParcel struct {
+	insolar.Parcel
+	msg insolar.Message
+}
+
+func (p testParcel) Message() insolar.Message {
+	return p.msg
+}
+
+// dropFailingDB fails to save drops in transactions.
+type dropFailingDB struct {
+	store.DB
+}
+
+func (db dropFailingDB) Update(fn func(txn store.Transaction) error) error {
+	return db.DB.Update(func(txn store.Transaction) error {
+		return fn(dropFailingTxn{Transaction: txn})
+	})
+}
+
+type dropFailingTxn struct {
+	store.Transaction
+}
+
+func (txn dropFailingTxn) Set(key store.Key, value []byte) error {
+	if key.Scope() == store.ScopeJetDrop {
+		return errors.New("disk failure")
+	}
+	return txn.Transaction.Set(key, value)
+}
+
+func TestHandler_HandleHeavyPayload(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	jetID := gen.JetID()
+	pn := gen.PulseNumber()
+
+	newHandler := func(t *testing.T, db store.DB) *Handler {
+		pulses := pulse.NewCalculatorMock(t)
+		pulses.BackwardsFunc = func(ctx context.Context, p insolar.PulseNumber, steps int) (insolar.Pulse, error) {
+			return insolar.Pulse{PulseNumber: p - 10}, nil
+		}
+		h := New()
+		h.PCS = pcs
+		h.DB = db
+		h.PulseCalculator = pulses
+		return h
+	}
+	newBuilder := func() *payloadBuilder {
+		b := &payloadBuilder{pcs: pcs, jetID: jetID, pn: pn}
+		b.addRecord()
+		b.addRecord()
+		b.addBlob([]byte{1, 2, 3})
+		return b
+	}
+	handle := func(h *Handler, msg *message.HeavyPayload) insolar.Reply {
+		rep, err := h.handleHeavyPayload(ctx, testParcel{msg: msg})
+		require.NoError(t, err)
+		return rep
+	}
+
+	t.Run("stores verified payload once", func(t *testing.T) {
+		db := store.NewMemoryMockDB()
+		h := newHandler(t, db)
+		b := newBuilder()
+		d := b.drop(nil)
+		msg := b.build(d)
+
+		require.Equal(t, &reply.OK{}, handle(h, msg))
+
+		stored, err := drop.NewDB(db).ForPulse(ctx, jetID, pn)
+		require.NoError(t, err)
+		assert.Equal(t, d, stored)
+		assert.Len(t, object.NewRecordDB(db).ForPulse(ctx, jetID, pn), 2)
+		assert.Len(t, blob.NewDB(db).ForPulse(ctx, jetID, pn), 1)
+
+		require.Equal(t, &reply.OK{}, handle(h, msg), "resending is ok")
+	})
+
+	t.Run("rejects payload that doesn't match drop hash", func(t *testing.T) {
+		db := store.NewMemoryMockDB()
+		h := newHandler(t, db)
+		b := newBuilder()
+		d := b.drop(nil)
+		b.addRecord()
+
+		rep := handle(h, b.build(d))
+		require.IsType(t, &reply.HeavyError{}, rep)
+
+		_, err := drop.NewDB(db).ForPulse(ctx, jetID, pn)
+		assert.Equal(t, drop.ErrNotFound, err)
+		assert.Empty(t, object.NewRecordDB(db).ForPulse(ctx, jetID, pn), "nothing is stored")
+	})
+
+	t.Run("rejects another drop for the same pulse", func(t *testing.T) {
+		db := store.NewMemoryMockDB()
+		h := newHandler(t, db)
+		b := newBuilder()
+		require.Equal(t, &reply.OK{}, handle(h, b.build(b.drop(nil))))
+
+		b.addBlob([]byte{4})
+		rep := handle(h, b.build(b.drop(nil)))
+		require.IsType(t, &reply.HeavyError{}, rep)
+		assert.Len(t, blob.NewDB(db).ForPulse(ctx, jetID, pn), 1)
+	})
+
+	t.Run("verifies drop chain", func(t *testing.T) {
+		db := store.NewMemoryMockDB()
+		h := newHandler(t, db)
+
+		prev := &payloadBuilder{pcs: pcs, jetID: jetID, pn: pn - 10}
+		prev.addRecord()
+		prevDrop := prev.drop(nil)
+		require.Equal(t, &reply.OK{}, handle(h, prev.build(prevDrop)))
+
+		b := newBuilder()
+		rep := handle(h, b.build(b.drop([]byte{1})))
+		require.IsType(t, &reply.HeavyError{}, rep)
+
+		require.Equal(t, &reply.OK{}, handle(h, b.build(b.drop(prevDrop.Hash))))
+	})
+
+	t.Run("rejects payload until previous drop is stored", func(t *testing.T) {
+		db := store.NewMemoryMockDB()
+		h := newHandler(t, db)
+
+		prev := &payloadBuilder{pcs: pcs, jetID: jetID, pn: pn - 10}
+		prev.addRecord()
+		prevDrop := prev.drop(nil)
+
+		b := newBuilder()
+		msg := b.build(b.drop(prevDrop.Hash))
+		rep := handle(h, msg)
+		require.IsType(t, &reply.HeavyError{}, rep)
+		assert.Contains(t, rep.(*reply.HeavyError).Message, ErrPrevDropNotFound.Error())
+		_, err := drop.NewDB(db).ForPulse(ctx, jetID, pn)
+		assert.Equal(t, drop.ErrNotFound, err)
+
+		require.Equal(t, &reply.OK{}, handle(h, prev.build(prevDrop)))
+		require.Equal(t, &reply.OK{}, handle(h, msg))
+	})
+
+	t.Run("stores payload bigger than a batch", func(t *testing.T) {
+		db := store.NewMemoryMockDB()
+		h := newHandler(t, db)
+		b := newBuilder()
+		for i := 0; i < storeBatchCount; i++ {
+			b.addRecord()
+		}
+
+		require.Equal(t, &reply.OK{}, handle(h, b.build(b.drop(nil))))
+		assert.Len(t, object.NewRecordDB(db).ForPulse(ctx, jetID, pn), storeBatchCount+2)
+	})
+
+	t.Run("continues chain of parent jet after split", func(t *testing.T) {
+		db := store.NewMemoryMockDB()
+		h := newHandler(t, db)
+
+		parent := &payloadBuilder{pcs: pcs, jetID: *insolar.NewJetID(0, nil), pn: pn - 10}
+		parentDrop := parent.drop(nil)
+		require.Equal(t, &reply.OK{}, handle(h, parent.build(parentDrop)))
+
+		b := &payloadBuilder{pcs: pcs, jetID: *insolar.NewJetID(1, []byte{0x80}), pn: pn}
+		b.addRecord()
+		require.Equal(t, &reply.OK{}, handle(h, b.build(b.drop(parentDrop.Hash))))
+	})
+
+	t.Run("continues chains of children jets after merge", func(t *testing.T) {
+		db := store.NewMemoryMockDB()
+		h := newHandler(t, db)
+
+		parentID := *insolar.NewJetID(1, []byte{0x80})
+		leftID, rightID := jet.Children(parentID)
+		left := &payloadBuilder{pcs: pcs, jetID: leftID, pn: pn - 10}
+		left.addRecord()
+		leftDrop := left.drop(nil)
+		require.Equal(t, &reply.OK{}, handle(h, left.build(leftDrop)))
+		right := &payloadBuilder{pcs: pcs, jetID: rightID, pn: pn - 10}
+		right.addRecord()
+		rightDrop := right.drop(nil)
+		require.Equal(t, &reply.OK{}, handle(h, right.build(rightDrop)))
+
+		b := &payloadBuilder{pcs: pcs, jetID: parentID, pn: pn}
+		b.addRecord()
+		rep := handle(h, b.build(b.drop(leftDrop.Hash)))
+		require.IsType(t, &reply.HeavyError{}, rep, "drop of left child has the same prefix but isn't previous")
+
+		require.Equal(t, &reply.OK{}, handle(h, b.build(b.drop(drop.MergedHash(pcs, leftDrop.Hash, rightDrop.Hash)))))
+	})
+
+	t.Run("doesn't store indexes if drop isn't stored", func(t *testing.T) {
+		db := store.NewMemoryMockDB()
+		h := newHandler(t, dropFailingDB{DB: db})
+		h.ChildIndexAccessor = object.NewChildIndexDB(db)
+
+		parent := gen.Reference()
+		proto := gen.Reference()
+		b := newBuilder()
+		b.addChildren(*parent.Record(), &proto)
+		require.IsType(t, &reply.HeavyError{}, handle(h, b.build(b.drop(nil))))
+
+		_, err := object.NewIndexDB(db).LastKnownForID(ctx, *parent.Record())
+		assert.Equal(t, object.ErrLifelineNotFound, err)
+		rep, err := h.childrenForPrototype(ctx, &message.GetChildren{Parent: parent, Prototype: &proto, Amount: 10})
+		require.NoError(t, err)
+		assert.Equal(t, &reply.Children{}, rep)
+	})
+
+	t.Run("indexes children by prototype", func(t *testing.T) {
+		db := store.NewMemoryMockDB()
+		h := newHandler(t, db)
+		h.ChildIndexAccessor = object.NewChildIndexDB(db)
+
+		parent := gen.Reference()
+		protoA, protoB := gen.Reference(), gen.Reference()
+		b := newBuilder()
+		refs := b.addChildren(*parent.Record(), &protoA, &protoB, &protoA, nil)
+		require.Equal(t, &reply.OK{}, handle(h, b.build(b.drop(nil))))
+
+		rep, err := h.childrenForPrototype(ctx, &message.GetChildren{Parent: parent, Prototype: &protoA, Amount: 10})
+		require.NoError(t, err)
+		assert.Equal(t, &reply.Children{Refs: []insolar.Reference{refs[2], refs[0]}}, rep)
+
+		rep, err = h.childrenForPrototype(ctx, &message.GetChildren{Parent: parent, Prototype: &protoB, Amount: 10})
+		require.NoError(t, err)
+		assert.Equal(t, &reply.Children{Refs: []insolar.Reference{refs[1]}}, rep)
+	})
+}
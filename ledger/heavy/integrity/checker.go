@@ -0,0 +1,370 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package integrity
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// Kinds of found problems.
+const (
+	// KindMissingPulse means there is a drop for a pulse, that is not stored.
+	KindMissingPulse = "missing_pulse"
+	// KindHashMismatch means a drop hash doesn't match its records and blobs.
+	KindHashMismatch = "hash_mismatch"
+	// KindMissingPrevDrop means a drop refers to a previous drop, that is not stored.
+	KindMissingPrevDrop = "missing_prev_drop"
+	// KindBrokenChain means a drop doesn't continue the hash of a previous drop.
+	KindBrokenChain = "broken_chain"
+	// KindOverlappingJets means drops of a jet and its ancestor are stored for the same pulse.
+	KindOverlappingJets = "overlapping_jets"
+	// KindSplitNotPerformed means a jet marked for split has its own drop after the split had to happen.
+	KindSplitNotPerformed = "split_not_performed"
+	// KindMissingRecord means a lifeline points to a record, that is not stored.
+	KindMissingRecord = "missing_record"
+)
+
+// dropsBatch is the number of drops read from storage at once.
+const dropsBatch = 1000
+
+// Problem describes a broken or missing ledger entry.
+type Problem struct {
+	Kind   string              `json:"kind"`
+	Pulse  insolar.PulseNumber `json:"pulse"`
+	JetID  string              `json:"jet_id,omitempty"`
+	Object string              `json:"object,omitempty"`
+	Record string              `json:"record,omitempty"`
+	Detail string              `json:"detail"`
+}
+
+// Report is a result of a check.
+type Report struct {
+	Pulses   int       `json:"pulses"`
+	Drops    int       `json:"drops"`
+	Indexes  int       `json:"indexes"`
+	Problems []Problem `json:"problems"`
+}
+
+// OK returns true if no problems are found.
+func (r *Report) OK() bool {
+	return len(r.Problems) == 0
+}
+
+type dropKey struct {
+	pulse insolar.PulseNumber
+	jetID insolar.JetID
+}
+
+// Checker verifies data of heavy node storage.
+type Checker struct {
+	pcs     insolar.PlatformCryptographyScheme
+	drops   *drop.DB
+	pulses  *pulse.DB
+	records *object.RecordDB
+	blobs   *blob.DB
+	indexes *object.IndexDB
+}
+
+// NewChecker creates a checker for provided storage.
+func NewChecker(db store.DB, pcs insolar.PlatformCryptographyScheme) *Checker {
+	return &Checker{
+		pcs:     pcs,
+		drops:   drop.NewDB(db),
+		pulses:  pulse.NewDB(db),
+		records: object.NewRecordDB(db),
+		blobs:   blob.NewDB(db),
+		indexes: object.NewIndexDB(db),
+	}
+}
+
+// Check walks all stored drops and indexes and returns found problems. Error is returned only if storage can't be
+// read.
+func (c *Checker) Check(ctx context.Context) (*Report, error) {
+	byKey, pulses, err := c.loadDrops(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Problems: []Problem{}}
+	for _, pn := range pulses {
+		if _, err := c.pulses.ForPulseNumber(ctx, pn); err != nil {
+			if err != pulse.ErrNotFound {
+				return nil, errors.Wrap(err, "failed to get pulse")
+			}
+			report.add(Problem{Kind: KindMissingPulse, Pulse: pn, Detail: "pulse of stored drops is not found"})
+		}
+		report.Pulses++
+	}
+
+	for key, d := range byKey {
+		report.Drops++
+		c.checkHash(ctx, report, d)
+		if err := c.checkChain(ctx, report, byKey, d); err != nil {
+			return nil, err
+		}
+		if err := c.checkSplit(ctx, report, byKey, d); err != nil {
+			return nil, err
+		}
+		checkOverlap(report, byKey, key)
+
+		for _, bucket := range c.indexes.ForPNAndJet(ctx, d.Pulse, d.JetID) {
+			report.Indexes++
+			if err := c.checkLifeline(ctx, report, d.Pulse, bucket); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sort.Slice(report.Problems, func(i, j int) bool {
+		a, b := report.Problems[i], report.Problems[j]
+		if a.Pulse != b.Pulse {
+			return a.Pulse < b.Pulse
+		}
+		if a.JetID != b.JetID {
+			return a.JetID < b.JetID
+		}
+		return a.Kind < b.Kind
+	})
+	return report, nil
+}
+
+func (r *Report) add(p Problem) {
+	r.Problems = append(r.Problems, p)
+}
+
+// loadDrops reads all stored drops and returns them by jet and pulse with sorted pulses of the drops.
+func (c *Checker) loadDrops(ctx context.Context) (map[dropKey]drop.Drop, []insolar.PulseNumber, error) {
+	byKey := map[dropKey]drop.Drop{}
+	seen := map[insolar.PulseNumber]struct{}{}
+	var pulses []insolar.PulseNumber
+
+	jetID, pn := insolar.ZeroJetID, insolar.PulseNumber(0)
+	for {
+		batch, err := c.drops.After(ctx, jetID, pn, dropsBatch)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to read drops")
+		}
+		for _, d := range batch {
+			byKey[dropKey{pulse: d.Pulse, jetID: d.JetID}] = d
+			if _, ok := seen[d.Pulse]; !ok {
+				seen[d.Pulse] = struct{}{}
+				pulses = append(pulses, d.Pulse)
+			}
+		}
+		if len(batch) < dropsBatch {
+			break
+		}
+		last := batch[len(batch)-1]
+		jetID, pn = last.JetID, last.Pulse
+	}
+
+	sort.Slice(pulses, func(i, j int) bool { return pulses[i] < pulses[j] })
+	return byKey, pulses, nil
+}
+
+func (c *Checker) checkHash(ctx context.Context, report *Report, d drop.Drop) {
+	records := c.records.ForPulse(ctx, d.JetID, d.Pulse)
+	blobs := c.blobs.ForPulse(ctx, d.JetID, d.Pulse)
+	hash := drop.Hash(c.pcs, d.PrevHash, d.Pulse, records, blobs)
+	if !bytes.Equal(hash, d.Hash) {
+		report.add(Problem{
+			Kind:   KindHashMismatch,
+			Pulse:  d.Pulse,
+			JetID:  d.JetID.DebugString(),
+			Detail: fmt.Sprintf("hash of %d records and %d blobs doesn't match drop hash", len(records), len(blobs)),
+		})
+	}
+}
+
+// checkChain checks, that the drop continues the drop of its own jet, its parent jet or merged children jets for
+// the previous pulse.
+func (c *Checker) checkChain(
+	ctx context.Context, report *Report, byKey map[dropKey]drop.Drop, d drop.Drop,
+) error {
+	prevPulse, err := c.pulses.Backwards(ctx, d.Pulse, 1)
+	if err == pulse.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate previous pulse")
+	}
+	pn := prevPulse.PulseNumber
+
+	var prevHash []byte
+	leftID, rightID := jet.Children(d.JetID)
+	if prev, ok := byKey[dropKey{pulse: pn, jetID: d.JetID}]; ok {
+		prevHash = prev.Hash
+	} else if prev, ok := byKey[dropKey{pulse: pn, jetID: jet.Parent(d.JetID)}]; ok && d.JetID != insolar.ZeroJetID {
+		prevHash = prev.Hash
+	} else {
+		left, leftOK := byKey[dropKey{pulse: pn, jetID: leftID}]
+		right, rightOK := byKey[dropKey{pulse: pn, jetID: rightID}]
+		if !leftOK || !rightOK {
+			// The first drop of a chain doesn't refer to a previous drop.
+			if len(d.PrevHash) != 0 {
+				report.add(Problem{
+					Kind:   KindMissingPrevDrop,
+					Pulse:  d.Pulse,
+					JetID:  d.JetID.DebugString(),
+					Detail: fmt.Sprintf("previous drop for pulse %v is not found", pn),
+				})
+			}
+			return nil
+		}
+		prevHash = drop.MergedHash(c.pcs, left.Hash, right.Hash)
+	}
+
+	if !bytes.Equal(prevHash, d.PrevHash) {
+		report.add(Problem{
+			Kind:   KindBrokenChain,
+			Pulse:  d.Pulse,
+			JetID:  d.JetID.DebugString(),
+			Detail: fmt.Sprintf("drop doesn't continue drop chain of pulse %v", pn),
+		})
+	}
+	return nil
+}
+
+// checkSplit checks, that a jet marked for split is replaced by its children. Split is performed at the end of the
+// next pulse, so the jet must not have its own drop two pulses later.
+func (c *Checker) checkSplit(
+	ctx context.Context, report *Report, byKey map[dropKey]drop.Drop, d drop.Drop,
+) error {
+	if !d.Split {
+		return nil
+	}
+	splitPulse, err := c.pulses.Forwards(ctx, d.Pulse, 2)
+	if err == pulse.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate split pulse")
+	}
+	if _, ok := byKey[dropKey{pulse: splitPulse.PulseNumber, jetID: d.JetID}]; ok {
+		report.add(Problem{
+			Kind:   KindSplitNotPerformed,
+			Pulse:  splitPulse.PulseNumber,
+			JetID:  d.JetID.DebugString(),
+			Detail: fmt.Sprintf("jet is marked for split in pulse %v, but still has its own drop", d.Pulse),
+		})
+	}
+	return nil
+}
+
+// checkOverlap checks, that no ancestor of the jet has a drop for the same pulse.
+func checkOverlap(report *Report, byKey map[dropKey]drop.Drop, key dropKey) {
+	for id := key.jetID; id != insolar.ZeroJetID; {
+		id = jet.Parent(id)
+		if _, ok := byKey[dropKey{pulse: key.pulse, jetID: id}]; ok {
+			report.add(Problem{
+				Kind:   KindOverlappingJets,
+				Pulse:  key.pulse,
+				JetID:  key.jetID.DebugString(),
+				Detail: fmt.Sprintf("ancestor jet %v has a drop for the same pulse", id.DebugString()),
+			})
+		}
+	}
+}
+
+// checkLifeline checks, that the lifeline points to existing state records and children records.
+func (c *Checker) checkLifeline(
+	ctx context.Context, report *Report, pn insolar.PulseNumber, bucket object.IndexBucket,
+) error {
+	lifeline := bucket.Lifeline
+	objID := bucket.ObjID
+	missing := func(id insolar.ID, detail string) {
+		report.add(Problem{
+			Kind:   KindMissingRecord,
+			Pulse:  pn,
+			JetID:  lifeline.JetID.DebugString(),
+			Object: objID.String(),
+			Record: id.String(),
+			Detail: detail,
+		})
+	}
+	exists := func(id insolar.ID) (record.Material, bool, error) {
+		rec, err := c.records.ForID(ctx, id)
+		if err == object.ErrNotFound {
+			return rec, false, nil
+		}
+		if err != nil {
+			return rec, false, errors.Wrap(err, "failed to get record")
+		}
+		return rec, true, nil
+	}
+
+	states := []struct {
+		id     *insolar.ID
+		detail string
+	}{
+		{lifeline.LatestState, "latest state record is not found"},
+		{lifeline.LatestStateApproved, "latest approved state record is not found"},
+	}
+	for _, s := range states {
+		if s.id == nil {
+			continue
+		}
+		_, ok, err := exists(*s.id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			missing(*s.id, s.detail)
+		}
+	}
+
+	// Children records are linked from the latest to the first one.
+	visited := map[insolar.ID]struct{}{}
+	for next := lifeline.ChildPointer; next != nil && !next.IsEmpty(); {
+		id := *next
+		if _, ok := visited[id]; ok {
+			missing(id, "children records are looped")
+			break
+		}
+		visited[id] = struct{}{}
+
+		rec, ok, err := exists(id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			missing(id, "child record is not found")
+			break
+		}
+		child, ok := record.Unwrap(rec.Virtual).(*record.Child)
+		if !ok {
+			missing(id, "child pointer doesn't point to a child record")
+			break
+		}
+		next = &child.PrevChild
+	}
+	return nil
+}
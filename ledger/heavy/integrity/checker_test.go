@@ -0,0 +1,239 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package integrity
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+type ledger struct {
+	db     store.DB
+	pcs    insolar.PlatformCryptographyScheme
+	pulses []insolar.PulseNumber
+	objID  insolar.ID
+	state  insolar.ID
+	child  insolar.ID
+}
+
+// newLedger stores consistent data: the root jet is marked for split in the first pulse, its children are created
+// in the third pulse and merged back in the fourth pulse.
+func newLedger(ctx context.Context, t *testing.T) *ledger {
+	l := &ledger{
+		db:  store.NewMemoryMockDB(),
+		pcs: platformpolicy.NewPlatformCryptographyScheme(),
+	}
+	for i := 0; i < 4; i++ {
+		pn := insolar.FirstPulseNumber + insolar.PulseNumber(i*10)
+		require.NoError(t, pulse.NewDB(l.db).Append(ctx, insolar.Pulse{PulseNumber: pn}))
+		l.pulses = append(l.pulses, pn)
+	}
+
+	root := insolar.ZeroJetID
+	left, right := jet.Children(root)
+	pn := l.pulses[1]
+	l.objID = gen.ID()
+	l.state = l.setRecord(ctx, t, root, pn, &record.Virtual{
+		Union: &record.Virtual_Activate{Activate: &record.Activate{Request: gen.Reference()}},
+	})
+	l.child = l.setRecord(ctx, t, root, pn, &record.Virtual{
+		Union: &record.Virtual_Child{Child: &record.Child{Ref: gen.Reference()}},
+	})
+	value := []byte{1, 2, 3}
+	blobID := object.CalculateIDForBlob(l.pcs, pn, value)
+	require.NoError(t, blob.NewDB(l.db).Set(ctx, *blobID, blob.Blob{Value: value, JetID: root}))
+	require.NoError(t, object.NewIndexDB(l.db).SetBucket(ctx, pn, object.IndexBucket{
+		ObjID:    l.objID,
+		Lifeline: object.Lifeline{JetID: root, LatestState: &l.state, ChildPointer: &l.child},
+	}))
+
+	first := l.setDrop(ctx, t, drop.Drop{Pulse: l.pulses[0], JetID: root, Split: true})
+	second := l.setDrop(ctx, t, drop.Drop{Pulse: l.pulses[1], JetID: root, PrevHash: first.Hash})
+	leftDrop := l.setDrop(ctx, t, drop.Drop{Pulse: l.pulses[2], JetID: left, PrevHash: second.Hash})
+	rightDrop := l.setDrop(ctx, t, drop.Drop{Pulse: l.pulses[2], JetID: right, PrevHash: second.Hash})
+	l.setDrop(ctx, t, drop.Drop{
+		Pulse:    l.pulses[3],
+		JetID:    root,
+		PrevHash: drop.MergedHash(l.pcs, leftDrop.Hash, rightDrop.Hash),
+	})
+	return l
+}
+
+func (l *ledger) setRecord(
+	ctx context.Context, t *testing.T, jetID insolar.JetID, pn insolar.PulseNumber, virtual *record.Virtual,
+) insolar.ID {
+	id := insolar.NewID(pn, record.HashVirtual(l.pcs.ReferenceHasher(), *virtual))
+	require.NoError(t, object.NewRecordDB(l.db).Set(ctx, *id, record.Material{Virtual: virtual, JetID: jetID}))
+	return *id
+}
+
+// setDrop calculates the hash of the drop from stored data and stores the drop.
+func (l *ledger) setDrop(ctx context.Context, t *testing.T, d drop.Drop) drop.Drop {
+	records := object.NewRecordDB(l.db).ForPulse(ctx, d.JetID, d.Pulse)
+	blobs := blob.NewDB(l.db).ForPulse(ctx, d.JetID, d.Pulse)
+	d.Hash = drop.Hash(l.pcs, d.PrevHash, d.Pulse, records, blobs)
+	require.NoError(t, drop.NewDB(l.db).Set(ctx, d))
+	return d
+}
+
+// overrideDrop replaces a stored drop bypassing override protection of the storage.
+func (l *ledger) overrideDrop(ctx context.Context, t *testing.T, d drop.Drop) {
+	records := object.NewRecordDB(l.db).ForPulse(ctx, d.JetID, d.Pulse)
+	blobs := blob.NewDB(l.db).ForPulse(ctx, d.JetID, d.Pulse)
+	d.Hash = drop.Hash(l.pcs, d.PrevHash, d.Pulse, records, blobs)
+	require.NoError(t, l.db.Set(&testDropKey{jetID: d.JetID, pn: d.Pulse}, drop.MustEncode(&d)))
+}
+
+func kinds(report *Report) []string {
+	var res []string
+	for _, p := range report.Problems {
+		res = append(res, p.Kind)
+	}
+	return res
+}
+
+func TestChecker_Check(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	root := insolar.ZeroJetID
+
+	t.Run("consistent ledger", func(t *testing.T) {
+		l := newLedger(ctx, t)
+		report, err := NewChecker(l.db, l.pcs).Check(ctx)
+		require.NoError(t, err)
+		assert.True(t, report.OK(), "problems: %v", report.Problems)
+		assert.Equal(t, 4, report.Pulses)
+		assert.Equal(t, 5, report.Drops)
+		assert.Equal(t, 1, report.Indexes)
+	})
+
+	t.Run("changed record", func(t *testing.T) {
+		l := newLedger(ctx, t)
+		l.setRecord(ctx, t, root, l.pulses[1], &record.Virtual{
+			Union: &record.Virtual_Code{Code: &record.Code{Code: gen.ID()}},
+		})
+
+		report, err := NewChecker(l.db, l.pcs).Check(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{KindHashMismatch}, kinds(report))
+		assert.Equal(t, l.pulses[1], report.Problems[0].Pulse)
+		assert.Equal(t, root.DebugString(), report.Problems[0].JetID)
+	})
+
+	t.Run("broken chain", func(t *testing.T) {
+		l := newLedger(ctx, t)
+		_, right := jet.Children(root)
+		l.overrideDrop(ctx, t, drop.Drop{Pulse: l.pulses[2], JetID: right, PrevHash: []byte{1}})
+		l.setDrop(ctx, t, drop.Drop{Pulse: l.pulses[0], JetID: right})
+
+		report, err := NewChecker(l.db, l.pcs).Check(ctx)
+		require.NoError(t, err)
+		// Merge of the fourth pulse doesn't continue the changed right drop. The first drop of the right jet
+		// overlaps the root jet.
+		assert.Equal(t, []Problem{
+			{
+				Kind:   KindOverlappingJets,
+				Pulse:  l.pulses[0],
+				JetID:  right.DebugString(),
+				Detail: "ancestor jet " + root.DebugString() + " has a drop for the same pulse",
+			},
+			{
+				Kind:   KindBrokenChain,
+				Pulse:  l.pulses[2],
+				JetID:  right.DebugString(),
+				Detail: fmt.Sprintf("drop doesn't continue drop chain of pulse %v", l.pulses[1]),
+			},
+			{
+				Kind:   KindBrokenChain,
+				Pulse:  l.pulses[3],
+				JetID:  root.DebugString(),
+				Detail: fmt.Sprintf("drop doesn't continue drop chain of pulse %v", l.pulses[2]),
+			},
+		}, report.Problems)
+	})
+
+	t.Run("missing previous drop", func(t *testing.T) {
+		l := newLedger(ctx, t)
+		_, right := jet.Children(root)
+		err := l.db.Update(func(txn store.Transaction) error {
+			return txn.Delete(&testDropKey{jetID: right, pn: l.pulses[2]})
+		})
+		require.NoError(t, err)
+
+		report, err := NewChecker(l.db, l.pcs).Check(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{KindMissingPrevDrop}, kinds(report))
+		assert.Equal(t, l.pulses[3], report.Problems[0].Pulse)
+	})
+
+	t.Run("split is not performed", func(t *testing.T) {
+		l := newLedger(ctx, t)
+		// The root jet keeps its own drop in the fourth pulse.
+		second, err := drop.NewDB(l.db).ForPulse(ctx, root, l.pulses[1])
+		require.NoError(t, err)
+		second.Split = true
+		l.overrideDrop(ctx, t, second)
+
+		report, err := NewChecker(l.db, l.pcs).Check(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, kinds(report), KindSplitNotPerformed)
+	})
+
+	t.Run("lifeline points to missing records", func(t *testing.T) {
+		l := newLedger(ctx, t)
+		state, child := gen.ID(), gen.ID()
+		require.NoError(t, object.NewIndexDB(l.db).SetBucket(ctx, l.pulses[1], object.IndexBucket{
+			ObjID:    l.objID,
+			Lifeline: object.Lifeline{JetID: root, LatestStateApproved: &state, ChildPointer: &child},
+		}))
+
+		report, err := NewChecker(l.db, l.pcs).Check(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{KindMissingRecord, KindMissingRecord}, kinds(report))
+		records := []string{report.Problems[0].Record, report.Problems[1].Record}
+		assert.ElementsMatch(t, []string{state.String(), child.String()}, records)
+		assert.Equal(t, l.objID.String(), report.Problems[0].Object)
+	})
+}
+
+type testDropKey struct {
+	jetID insolar.JetID
+	pn    insolar.PulseNumber
+}
+
+func (k *testDropKey) Scope() store.Scope {
+	return store.ScopeJetDrop
+}
+
+func (k *testDropKey) ID() []byte {
+	return append(append([]byte{}, k.jetID.Prefix()...), k.pn.Bytes()...)
+}
@@ -0,0 +1,23 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package integrity verifies ledger data stored by heavy node.
+//
+// Checker walks drops of all jets pulse by pulse and recomputes their hashes from stored records and blobs. It checks,
+// that every drop continues the chain of its own jet, its parent jet or merged children jets, that jets of a pulse
+// don't overlap and requested splits are performed. Lifelines of indexes are checked to point to existing records.
+// Found problems are collected into a report, that can be serialized to JSON.
+package integrity
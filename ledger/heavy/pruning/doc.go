@@ -0,0 +1,22 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package pruning removes memory of superseded object states and deactivated objects on heavy node.
+//
+// Only blobs are pruned. Records are kept and pruned blobs keep hashes of their values, so hashes of drops can still
+// be verified. The latest state of an active object is never pruned. Reads of pruned states return
+// insolar.ErrStatePruned.
+package pruning
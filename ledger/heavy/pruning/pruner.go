@@ -0,0 +1,309 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pruning
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// Pruner is an interface for a component, that prunes memory of superseded states and deactivated objects.
+type Pruner interface {
+	// NotifyAboutPulse notifies a component about a pulse
+	NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber)
+}
+
+// PrunerDefault is a base impl of Pruner. It prunes states that are older than depth pulses. Pulses are pruned one by
+// one in the background, starting from the oldest stored pulse. The last pruned pulse is stored, so pruning continues
+// from it after a restart.
+type PrunerDefault struct {
+	db      store.DB
+	blobs   *blob.DB
+	records *object.RecordDB
+	indexes *object.IndexDB
+	pulses  pulse.Calculator
+	depth   int
+
+	once sync.Once
+	wake chan struct{}
+
+	lock   sync.Mutex
+	target insolar.PulseNumber
+
+	// pruned is the last pruned pulse. It's used by the background worker only.
+	pruned insolar.PulseNumber
+}
+
+// NewPruner creates new instance of PrunerDefault. Depth should be greater than light chain limit, because states of
+// light chain limit are still requested from heavy node by light nodes.
+func NewPruner(db store.DB, pulses pulse.Calculator, depth, lightChainLimit int) (*PrunerDefault, error) {
+	if depth <= lightChainLimit {
+		return nil, errors.Errorf(
+			"pruning depth %d should be greater than light chain limit %d", depth, lightChainLimit,
+		)
+	}
+	pruned, err := loadPruned(db)
+	if err != nil && err != store.ErrNotFound {
+		return nil, errors.Wrap(err, "failed to load last pruned pulse")
+	}
+
+	return &PrunerDefault{
+		db:      db,
+		pruned:  pruned,
+		blobs:   blob.NewDB(db),
+		records: object.NewRecordDB(db),
+		indexes: object.NewIndexDB(db),
+		pulses:  pulses,
+		depth:   depth,
+		wake:    make(chan struct{}, 1),
+	}, nil
+}
+
+// NotifyAboutPulse schedules pruning of the pulse, that is depth pulses before the provided one.
+func (p *PrunerDefault) NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber) {
+	target, err := p.pulses.Backwards(ctx, pn, p.depth)
+	if err == pulse.ErrNotFound {
+		return
+	}
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "[Pruner] failed to calculate pulse to prune"))
+		return
+	}
+
+	p.lock.Lock()
+	if target.PulseNumber > p.target {
+		p.target = target.PulseNumber
+	}
+	p.lock.Unlock()
+
+	p.once.Do(func() {
+		go p.run(ctx)
+	})
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (p *PrunerDefault) run(ctx context.Context) {
+	for range p.wake {
+		p.lock.Lock()
+		target := p.target
+		p.lock.Unlock()
+
+		p.pruneUntil(ctx, target)
+	}
+}
+
+func (p *PrunerDefault) pruneUntil(ctx context.Context, target insolar.PulseNumber) {
+	logger := inslogger.FromContext(ctx)
+
+	var pn insolar.PulseNumber
+	if p.pruned == 0 {
+		oldest, err := p.oldest(ctx, target)
+		if err != nil {
+			logger.Error(errors.Wrapf(err, "[Pruner] failed to find the oldest pulse before %v", target))
+			return
+		}
+		pn = oldest
+	} else {
+		if p.pruned >= target {
+			return
+		}
+		next, err := p.pulses.Forwards(ctx, p.pruned, 1)
+		if err != nil {
+			logger.Error(errors.Wrapf(err, "[Pruner] failed to calculate pulse after %v", p.pruned))
+			return
+		}
+		pn = next.PulseNumber
+	}
+
+	for pn <= target {
+		count, err := p.Prune(ctx, pn)
+		if err != nil {
+			logger.Error(errors.Wrapf(err, "[Pruner] failed to prune pulse %v", pn))
+			return
+		}
+		logger.Debugf("[Pruner] %v states are pruned for pulse %v", count, pn)
+		err = savePruned(p.db, pn)
+		if err != nil {
+			logger.Error(errors.Wrapf(err, "[Pruner] failed to store last pruned pulse %v", pn))
+			return
+		}
+		p.pruned = pn
+
+		if pn == target {
+			return
+		}
+		next, err := p.pulses.Forwards(ctx, pn, 1)
+		if err != nil {
+			logger.Error(errors.Wrapf(err, "[Pruner] failed to calculate pulse after %v", pn))
+			return
+		}
+		pn = next.PulseNumber
+	}
+}
+
+// oldest returns the oldest stored pulse before pn. It's used once, when nothing is pruned yet.
+func (p *PrunerDefault) oldest(ctx context.Context, pn insolar.PulseNumber) (insolar.PulseNumber, error) {
+	for {
+		prev, err := p.pulses.Backwards(ctx, pn, 1)
+		if err == pulse.ErrNotFound {
+			return pn, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		pn = prev.PulseNumber
+	}
+}
+
+// Prune prunes memory of states, that are superseded by states of the provided pulse or older. Objects changed in the
+// pulse are walked from their latest states to the first pruned one. Memory of the latest state is kept. A blob is
+// pruned only if it's stored in the same pulse as the state and no other record of the pulse references it.
+// It returns a number of pruned states.
+func (p *PrunerDefault) Prune(ctx context.Context, pn insolar.PulseNumber) (int, error) {
+	total := 0
+	for _, bucket := range p.indexes.ForPN(ctx, pn) {
+		latest, err := p.indexes.LastKnownForID(ctx, bucket.ObjID)
+		if err != nil {
+			return total, errors.Wrapf(err, "failed to fetch lifeline of %v", bucket.ObjID.DebugString())
+		}
+		count, err := p.pruneObject(ctx, pn, latest.Lifeline)
+		total += count
+		if err != nil {
+			return total, errors.Wrapf(err, "failed to prune states of %v", bucket.ObjID.DebugString())
+		}
+	}
+	return total, nil
+}
+
+func (p *PrunerDefault) pruneObject(ctx context.Context, pn insolar.PulseNumber, lifeline object.Lifeline) (int, error) {
+	if lifeline.LatestState == nil {
+		return 0, nil
+	}
+
+	count := 0
+	stateID := lifeline.LatestState
+	for latest := true; stateID != nil; latest = false {
+		rec, err := p.records.ForID(ctx, *stateID)
+		if err != nil {
+			return count, errors.Wrapf(err, "failed to fetch state %v", stateID.DebugString())
+		}
+		state, ok := record.Unwrap(rec.Virtual).(record.State)
+		if !ok {
+			return count, errors.Errorf("record %v is not a state", stateID.DebugString())
+		}
+
+		memory := state.GetMemory()
+		if latest || stateID.Pulse() > pn || memory == nil || memory.IsEmpty() {
+			stateID = state.PrevStateID()
+			continue
+		}
+
+		pruned, err := p.pruneMemory(ctx, *stateID, *memory)
+		if err == blob.ErrPruned {
+			// Older states are pruned by previous calls.
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		if pruned {
+			count++
+		}
+		stateID = state.PrevStateID()
+	}
+	return count, nil
+}
+
+func (p *PrunerDefault) pruneMemory(ctx context.Context, stateID, memory insolar.ID) (bool, error) {
+	// Blobs are addressed by content, so a blob of another pulse can be shared by unknown number of states.
+	if memory.Pulse() != stateID.Pulse() {
+		return false, nil
+	}
+
+	b, err := p.blobs.ForID(ctx, memory)
+	if err == blob.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if p.references(ctx, b.JetID, memory) > 1 {
+		return false, nil
+	}
+
+	err = p.blobs.Prune(ctx, memory)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to prune blob %v", memory.DebugString())
+	}
+	return true, nil
+}
+
+func (p *PrunerDefault) references(ctx context.Context, jetID insolar.JetID, memory insolar.ID) int {
+	count := 0
+	for _, rec := range p.records.ForPulse(ctx, jetID, memory.Pulse()) {
+		switch r := record.Unwrap(rec.Virtual).(type) {
+		case record.State:
+			if m := r.GetMemory(); m != nil && *m == memory {
+				count++
+			}
+		case *record.Code:
+			if r.Code == memory {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+type prunedKey struct{}
+
+func (prunedKey) Scope() store.Scope {
+	return store.ScopeSyncState
+}
+
+func (prunedKey) ID() []byte {
+	return []byte("pruned")
+}
+
+func loadPruned(db store.DB) (insolar.PulseNumber, error) {
+	buf, err := db.Get(prunedKey{})
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) != insolar.PulseNumberSize {
+		return 0, errors.Errorf("malformed last pruned pulse %x", buf)
+	}
+	return insolar.NewPulseNumber(buf), nil
+}
+
+func savePruned(db store.DB, pn insolar.PulseNumber) error {
+	return db.Set(prunedKey{}, pn.Bytes())
+}
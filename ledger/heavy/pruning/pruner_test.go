@@ -0,0 +1,231 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pruning
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+type testStorage struct {
+	t     *testing.T
+	ctx   context.Context
+	jetID insolar.JetID
+
+	blobs   *blob.DB
+	records *object.RecordDB
+	indexes *object.IndexDB
+
+	seq byte
+}
+
+func newTestStorage(ctx context.Context, t *testing.T, db store.DB) *testStorage {
+	return &testStorage{
+		t:       t,
+		ctx:     ctx,
+		jetID:   insolar.ZeroJetID,
+		blobs:   blob.NewDB(db),
+		records: object.NewRecordDB(db),
+		indexes: object.NewIndexDB(db),
+	}
+}
+
+func (s *testStorage) id(pn insolar.PulseNumber) insolar.ID {
+	s.seq++
+	return *insolar.NewID(pn, []byte{s.seq})
+}
+
+func (s *testStorage) blob(pn insolar.PulseNumber, value []byte) insolar.ID {
+	id := s.id(pn)
+	err := s.blobs.Set(s.ctx, id, blob.Blob{JetID: s.jetID, Value: value})
+	require.NoError(s.t, err)
+	return id
+}
+
+func (s *testStorage) record(pn insolar.PulseNumber, rec record.Record) insolar.ID {
+	id := s.id(pn)
+	virtual := record.Wrap(rec)
+	err := s.records.Set(s.ctx, id, record.Material{Virtual: &virtual, JetID: s.jetID})
+	require.NoError(s.t, err)
+	return id
+}
+
+func (s *testStorage) lifeline(pn insolar.PulseNumber, objID, state insolar.ID, stateID record.StateID) {
+	err := s.indexes.Set(s.ctx, pn, objID, object.Lifeline{LatestState: &state, StateID: stateID, JetID: s.jetID})
+	require.NoError(s.t, err)
+}
+
+func TestPrunerDefault_Prune(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	s := newTestStorage(ctx, t, db)
+	first := gen.PulseNumber()
+	second := first + 10
+
+	// Amended object.
+	amendedFirst := s.blob(first, []byte{1})
+	amendedFirstState := s.record(first, record.Activate{Memory: amendedFirst})
+	amendedSecond := s.blob(second, []byte{2})
+	amendedSecondState := s.record(second, record.Amend{Memory: amendedSecond, PrevState: amendedFirstState})
+	s.lifeline(first, amendedFirstState, amendedFirstState, record.StateActivation)
+	s.lifeline(second, amendedFirstState, amendedSecondState, record.StateAmend)
+
+	// Deactivated object.
+	deactivated := s.blob(first, []byte{3})
+	deactivatedState := s.record(first, record.Activate{Memory: deactivated})
+	deactivateState := s.record(second, record.Deactivate{PrevState: deactivatedState})
+	s.lifeline(first, deactivatedState, deactivatedState, record.StateActivation)
+	s.lifeline(second, deactivatedState, deactivateState, record.StateDeactivation)
+
+	// Amended object with memory shared with an active object.
+	shared := s.blob(first, []byte{4})
+	sharedState := s.record(first, record.Activate{Memory: shared})
+	sharedAmendState := s.record(second, record.Amend{Memory: amendedSecond, PrevState: sharedState})
+	activeState := s.record(first, record.Activate{Memory: shared})
+	s.lifeline(first, sharedState, sharedState, record.StateActivation)
+	s.lifeline(second, sharedState, sharedAmendState, record.StateAmend)
+	s.lifeline(first, activeState, activeState, record.StateActivation)
+
+	pruner, err := NewPruner(db, pulse.NewCalculatorMock(t), 1, 0)
+	require.NoError(t, err)
+
+	t.Run("prunes superseded states of pulse", func(t *testing.T) {
+		count, err := pruner.Prune(ctx, first)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		_, err = s.blobs.ForID(ctx, amendedFirst)
+		assert.Equal(t, blob.ErrPruned, err)
+		_, err = s.blobs.ForID(ctx, deactivated)
+		assert.Equal(t, blob.ErrPruned, err)
+
+		for _, id := range []insolar.ID{amendedSecond, shared} {
+			b, err := s.blobs.ForID(ctx, id)
+			require.NoError(t, err)
+			assert.False(t, b.Pruned())
+		}
+	})
+
+	t.Run("keeps pruned hashes", func(t *testing.T) {
+		blobs := s.blobs.ForPulse(ctx, s.jetID, first)
+		require.Len(t, blobs, 3)
+		for _, b := range blobs {
+			if b.Pruned() {
+				assert.Nil(t, b.Value)
+				assert.NotNil(t, b.Hash)
+			}
+		}
+	})
+
+	t.Run("prunes nothing on repeat", func(t *testing.T) {
+		count, err := pruner.Prune(ctx, first)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("keeps latest states", func(t *testing.T) {
+		count, err := pruner.Prune(ctx, second)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+
+		b, err := s.blobs.ForID(ctx, amendedSecond)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{2}, b.Value)
+	})
+}
+
+func TestNewPruner(t *testing.T) {
+	db := store.NewMemoryMockDB()
+
+	_, err := NewPruner(db, pulse.NewCalculatorMock(t), 5, 5)
+	assert.Error(t, err, "depth should be greater than light chain limit")
+
+	pruner, err := NewPruner(db, pulse.NewCalculatorMock(t), 6, 5)
+	require.NoError(t, err)
+	assert.Equal(t, insolar.PulseNumber(0), pruner.pruned)
+
+	pn := gen.PulseNumber()
+	require.NoError(t, savePruned(db, pn))
+	pruner, err = NewPruner(db, pulse.NewCalculatorMock(t), 6, 5)
+	require.NoError(t, err)
+	assert.Equal(t, pn, pruner.pruned, "continues from stored pulse")
+}
+
+func TestPrunerDefault_NotifyAboutPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	s := newTestStorage(ctx, t, db)
+	pulses := pulse.NewDB(db)
+
+	oldest := gen.PulseNumber()
+	first := oldest + 10
+	current := first + 10
+	for _, pn := range []insolar.PulseNumber{oldest, first} {
+		err := pulses.Append(ctx, insolar.Pulse{PulseNumber: pn})
+		require.NoError(t, err)
+	}
+
+	// Pulses older than the one to prune are pruned as well.
+	oldMemory := s.blob(oldest, []byte{1})
+	oldState := s.record(oldest, record.Activate{Memory: oldMemory})
+	oldDeactivateState := s.record(current, record.Deactivate{PrevState: oldState})
+	s.lifeline(oldest, oldState, oldDeactivateState, record.StateDeactivation)
+
+	memory := s.blob(first, []byte{2})
+	state := s.record(first, record.Activate{Memory: memory})
+	deactivateState := s.record(current, record.Deactivate{PrevState: state})
+	s.lifeline(first, state, deactivateState, record.StateDeactivation)
+
+	pruner, err := NewPruner(db, pulses, 1, 0)
+	require.NoError(t, err)
+
+	// There is no pulse to prune yet.
+	pruner.NotifyAboutPulse(ctx, oldest)
+
+	err = pulses.Append(ctx, insolar.Pulse{PulseNumber: current})
+	require.NoError(t, err)
+	pruner.NotifyAboutPulse(ctx, current)
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		pruned, err := loadPruned(db)
+		if (err == nil && pruned == first) || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for _, id := range []insolar.ID{oldMemory, memory} {
+		_, err = s.blobs.ForID(ctx, id)
+		assert.Equal(t, blob.ErrPruned, err)
+	}
+	pruned, err := loadPruned(db)
+	require.NoError(t, err)
+	assert.Equal(t, first, pruned)
+}
@@ -25,6 +25,8 @@ import (
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/ledger/heavy/handler"
+	"github.com/insolar/insolar/ledger/heavy/pruning"
 	"github.com/pkg/errors"
 	"go.opencensus.io/trace"
 )
@@ -43,6 +45,11 @@ type PulseManager struct {
 	NodeSetter        node.Modifier             `inject:""`
 	Nodes             node.Accessor             `inject:""`
 	PulseAppender     pulse.Appender            `inject:""`
+	PulseAccessor     pulse.Accessor            `inject:""`
+
+	HistorySyncer handler.HistorySyncer
+	// Pruner is optional, states aren't pruned if it's nil.
+	Pruner pruning.Pruner
 
 	currentPulse insolar.Pulse
 
@@ -90,6 +97,11 @@ func (m *PulseManager) Set(ctx context.Context, newPulse insolar.Pulse, persist
 		inslogger.FromContext(ctx).Error(errors.Wrap(err, "MessageBus OnPulse() returns error"))
 	}
 
+	m.HistorySyncer.NotifyAboutPulse(ctx, newPulse.PulseNumber)
+	if m.Pruner != nil {
+		m.Pruner.NotifyAboutPulse(ctx, newPulse.PulseNumber)
+	}
+
 	return nil
 }
 
@@ -132,7 +144,8 @@ func (m *PulseManager) setUnderGilSection(ctx context.Context, newPulse insolar.
 	return nil
 }
 
-// Start starts pulse manager.
+// Start starts pulse manager. Pulse manager continues from the latest stored pulse, e.g. the last pulse restored
+// from backup.
 func (m *PulseManager) Start(ctx context.Context) error {
 	origin := m.NodeNet.GetOrigin()
 	err := m.NodeSetter.Set(insolar.FirstPulseNumber, []insolar.Node{{ID: origin.ID(), Role: origin.Role()}})
@@ -140,6 +153,17 @@ func (m *PulseManager) Start(ctx context.Context) error {
 		return err
 	}
 
+	latest, err := m.PulseAccessor.Latest(ctx)
+	if err != nil && err != pulse.ErrNotFound {
+		return errors.Wrap(err, "failed to fetch latest pulse")
+	}
+	if err == nil {
+		m.setLock.Lock()
+		m.currentPulse = latest
+		m.setLock.Unlock()
+		inslogger.FromContext(ctx).Infof("[ PulseManager ] continues from pulse %v", latest.PulseNumber)
+	}
+
 	return nil
 }
 
@@ -120,6 +120,8 @@ func NewMessageHandler(
 			p.Dep.RecordModifier = h.RecordModifier
 			p.Dep.PCS = h.PCS
 			p.Dep.PendingRequestsLimit = h.conf.PendingRequestsLimit
+			p.Dep.ObjectPendingRequestsLimit = h.conf.ObjectPendingRequestsLimit
+			p.Dep.PendingRetryDelay = h.conf.PendingRetryDelay
 		},
 		SetBlob: func(p *proc.SetBlob) {
 			p.Dep.BlobAccessor = h.BlobAccessor
@@ -186,6 +188,7 @@ func NewMessageHandler(
 			p.Dep.JetStorage = h.JetStorage
 			p.Dep.JetFetcher = h.jetTreeUpdater
 			p.Dep.JetReleaser = h.JetReleaser
+			p.Dep.PendingRequestsLimit = h.conf.PendingRequestsLimit
 		},
 	}
 
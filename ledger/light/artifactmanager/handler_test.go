@@ -208,7 +208,7 @@ func (s *handlerSuite) TestMessageHandler_HandleGetDelegate_FetchesIndexFromHeav
 	require.NoError(s.T(), err)
 
 	heavyRef := genRandomRef(0)
-	jc.HeavyMock.Return(heavyRef, nil)
+	jc.HeaviesMock.Return([]insolar.Reference{*heavyRef}, nil)
 
 	rep, err := h.FlowDispatcher.WrapBusHandle(s.ctx, fakeParcel)
 	require.NoError(s.T(), err)
@@ -598,6 +598,95 @@ func (s *handlerSuite) TestMessageHandler_HandleHotRecords() {
 	pendingMock.MinimockFinish()
 }
 
+func (s *handlerSuite) TestMessageHandler_HandleHotRecords_PendingRequestsLimit() {
+	mc := minimock.NewController(s.T())
+	jetID := gen.JetID()
+
+	oldest := insolar.NewID(insolar.FirstPulseNumber, []byte{1})
+	older := insolar.NewID(insolar.FirstPulseNumber, []byte{2})
+	newest := insolar.NewID(insolar.FirstPulseNumber, []byte{3})
+
+	hotData := &message.HotData{
+		Jet:         *insolar.NewReference(insolar.DomainID, insolar.ID(jetID)),
+		PulseNumber: insolar.FirstPulseNumber + 30,
+		PendingRequests: map[insolar.ID]recentstorage.PendingObjectContext{
+			*newest: {Requests: []insolar.ID{*insolar.NewID(insolar.FirstPulseNumber+20, nil)}},
+			*oldest: {Requests: []insolar.ID{*insolar.NewID(insolar.FirstPulseNumber, nil)}},
+			*older: {Requests: []insolar.ID{
+				*insolar.NewID(insolar.FirstPulseNumber+10, nil), *insolar.NewID(insolar.FirstPulseNumber+11, nil),
+			}},
+		},
+		Drop: drop.Drop{Pulse: insolar.FirstPulseNumber + 20, JetID: jetID},
+	}
+
+	notified := make(chan insolar.ID, len(hotData.PendingRequests))
+	mb := testutils.NewMessageBusMock(mc)
+	mb.MustRegisterMock.Return()
+	mb.SendFunc = func(p context.Context, p1 insolar.Message, p2 *insolar.MessageSendOptions) (r insolar.Reply, r1 error) {
+		parsedMsg, ok := p1.(*message.AbandonedRequestsNotification)
+		require.True(s.T(), ok)
+		notified <- parsedMsg.Object
+		return &reply.OK{}, nil
+	}
+
+	pendingMock := recentstorage.NewPendingStorageMock(s.T())
+	pendingMock.SetContextToObjectFunc = func(p context.Context, p1 insolar.ID, p2 recentstorage.PendingObjectContext) {
+		require.False(s.T(), p2.Active)
+	}
+	provideMock := recentstorage.NewProviderMock(s.T())
+	provideMock.GetPendingStorageMock.Return(pendingMock)
+
+	h := NewMessageHandler(
+		object.NewLifelineIndexMock(s.T()),
+		object.NewIndexBucketModifierMock(s.T()),
+		object.NewLifelineStateModifierMock(s.T()),
+		&configuration.Ledger{PendingRequestsLimit: 3},
+	)
+	h.JetCoordinator = jet.NewCoordinatorMock(mc)
+	h.RecentStorageProvider = provideMock
+	h.Bus = mb
+	h.JetStorage = s.jetStorage
+	h.Nodes = s.nodeStorage
+	h.DropModifier = s.dropModifier
+
+	jr := testutils.NewJetReleaserMock(s.T())
+	jr.UnlockMock.Return(nil)
+	h.JetReleaser = jr
+
+	err := h.Init(s.ctx)
+	require.NoError(s.T(), err)
+
+	replyTo := make(chan bus.Reply, 1)
+	p := proc.NewHotData(hotData, replyTo)
+	p.Dep.DropModifier = h.DropModifier
+	p.Dep.RecentStorageProvider = h.RecentStorageProvider
+	p.Dep.MessageBus = h.Bus
+	p.Dep.JetStorage = h.JetStorage
+	p.Dep.JetFetcher = h.jetTreeUpdater
+	p.Dep.JetReleaser = h.JetReleaser
+	p.Dep.PendingRequestsLimit = h.conf.PendingRequestsLimit
+	err = p.Proceed(s.ctx)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), &reply.OK{}, (<-replyTo).Reply)
+
+	var received []insolar.ID
+	for i := 0; i < 2; i++ {
+		select {
+		case id := <-notified:
+			received = append(received, id)
+		case <-time.After(time.Minute):
+			s.T().Fatal("notification is not sent")
+		}
+	}
+	require.ElementsMatch(s.T(), []insolar.ID{*oldest, *older}, received)
+
+	select {
+	case id := <-notified:
+		s.T().Fatalf("unexpected notification for %s", id.DebugString())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 func (s *handlerSuite) TestMessageHandler_HandleGetRequest() {
 	mc := minimock.NewController(s.T())
 	defer mc.Finish()
@@ -89,14 +89,15 @@ func (p *GetChildren) reply(ctx context.Context) bus.Reply {
 		return bus.Reply{Err: err}
 	}
 	if onHeavy {
-		node, err := p.Dep.Coordinator.Heavy(ctx, p.parcel.Pulse())
+		heavies, err := p.Dep.Coordinator.Heavies(ctx, p.parcel.Pulse())
 		if err != nil {
 			return bus.Reply{Err: err}
 		}
-		repl, err := reply.NewGetChildrenRedirect(p.Dep.DelegationTokenFactory, p.parcel, node, *currentChild)
+		repl, err := reply.NewGetChildrenRedirect(p.Dep.DelegationTokenFactory, p.parcel, &heavies[0], *currentChild)
 		if err != nil {
 			return bus.Reply{Err: err}
 		}
+		repl.Replicas = heavies[1:]
 		return bus.Reply{Reply: repl}
 
 	}
@@ -138,7 +139,6 @@ func (p *GetChildren) reply(ctx context.Context) bus.Reply {
 		if counter >= p.msg.Amount {
 			return bus.Reply{Reply: &reply.Children{Refs: refs, NextFrom: currentChild}}
 		}
-		counter++
 
 		rec, err := p.Dep.RecordAccessor.ForID(ctx, *currentChild)
 
@@ -158,6 +158,13 @@ func (p *GetChildren) reply(ctx context.Context) bus.Reply {
 		}
 		currentChild = &childRec.PrevChild
 
+		// Light keeps children of a few pulses only, so they are filtered while walking the list. Children of other
+		// prototypes are not counted, so the chunk is filled with children of the prototype.
+		if p.msg.Prototype != nil && (childRec.Prototype == nil || !childRec.Prototype.Equal(*p.msg.Prototype)) {
+			continue
+		}
+		counter++
+
 		// Skip records later than specified pulse.
 		recPulse := childRec.Ref.Record().Pulse()
 		if p.msg.FromPulse != nil && recPulse > *p.msg.FromPulse {
@@ -23,9 +23,10 @@ func TestGetChildren_RedirectsToHeavyWhenNoIndex(t *testing.T) {
 	}
 
 	heavyRef := genRandomRef(0)
+	replicaRef := genRandomRef(0)
 
 	jc := jet.NewCoordinatorMock(t)
-	jc.HeavyMock.Return(heavyRef, nil)
+	jc.HeaviesMock.Return([]insolar.Reference{*heavyRef, *replicaRef}, nil)
 	jc.IsBeyondLimitMock.Return(true, nil)
 
 	tf := testutils.NewDelegationTokenFactoryMock(t)
@@ -53,6 +54,7 @@ func TestGetChildren_RedirectsToHeavyWhenNoIndex(t *testing.T) {
 	token, ok := redirect.Token.(*delegationtoken.GetChildrenRedirectToken)
 	assert.Equal(t, []byte{1, 2, 3}, token.Signature)
 	assert.Equal(t, heavyRef, redirect.GetReceiver())
+	assert.Equal(t, []insolar.Reference{*replicaRef}, redirect.GetReplicas())
 }
 
 func TestGetChildren_RedirectToLight(t *testing.T) {
@@ -160,3 +162,64 @@ func TestGetChildren_RedirectToHeavy(t *testing.T) {
 	assert.Equal(t, []byte{1, 2, 3}, token.Signature)
 	assert.Equal(t, heavyRef, redirect.GetReceiver())
 }
+
+func TestGetChildren_FiltersByPrototype(t *testing.T) {
+	ctx := context.TODO()
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber + 1)
+
+	jc := jet.NewCoordinatorMock(t)
+	jc.IsBeyondLimitMock.Return(false, nil)
+
+	protoA, protoB := genRandomRef(0), genRandomRef(0)
+	records := object.NewRecordMemory()
+	var (
+		prev insolar.ID
+		refs []insolar.Reference
+		ids  []insolar.ID
+	)
+	for _, proto := range []*insolar.Reference{protoA, protoB, protoA} {
+		ref := genRandomRef(pn)
+		virtual := record.Wrap(record.Child{PrevChild: prev, Ref: *ref, Prototype: proto})
+		id := genRandomID(pn)
+		err := records.Set(ctx, *id, record.Material{Virtual: &virtual})
+		require.NoError(t, err)
+		prev = *id
+		refs = append(refs, *ref)
+		ids = append(ids, *id)
+	}
+
+	fetch := func(amount int) *reply.Children {
+		msg := message.GetChildren{
+			Parent:    *genRandomRef(0),
+			Prototype: protoA,
+			Amount:    amount,
+		}
+		gc := GetChildren{
+			index: object.Lifeline{ChildPointer: &prev},
+			msg:   &msg,
+			parcel: &message.Parcel{
+				Msg:         &msg,
+				Sender:      *genRandomRef(insolar.FirstPulseNumber),
+				PulseNumber: pn,
+			},
+		}
+		gc.Dep.Coordinator = jc
+		gc.Dep.JetStorage = jet.NewStore()
+		gc.Dep.JetStorage.Update(ctx, pn, true, insolar.ZeroJetID)
+		gc.Dep.RecordAccessor = records
+
+		rep := gc.reply(ctx)
+		require.NoError(t, rep.Err)
+		children, ok := rep.Reply.(*reply.Children)
+		require.True(t, ok)
+		return children
+	}
+
+	children := fetch(10)
+	assert.Equal(t, []insolar.Reference{refs[2], refs[0]}, children.Refs)
+	assert.True(t, children.NextFrom.IsEmpty())
+
+	children = fetch(1)
+	assert.Equal(t, []insolar.Reference{refs[2]}, children.Refs)
+	assert.Equal(t, &ids[1], children.NextFrom)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/ledger/blob"
 	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/messagebus"
 	"github.com/pkg/errors"
 )
 
@@ -60,14 +61,15 @@ func (p *GetCode) reply(ctx context.Context) bus.Reply {
 	codeID := *p.code.Record()
 	rec, err := p.Dep.RecordAccessor.ForID(ctx, codeID)
 	if err == object.ErrNotFound {
-		heavy, err := p.Dep.Coordinator.Heavy(ctx, flow.Pulse(ctx))
+		heavies, err := p.Dep.Coordinator.Heavies(ctx, flow.Pulse(ctx))
 		if err != nil {
 			return bus.Reply{Err: errors.Wrap(err, "failed to calculate heavy")}
 		}
-		genericReply, err := p.Dep.Bus.Send(ctx, &message.GetCode{
+		sender := messagebus.BuildSender(p.Dep.Bus.Send, messagebus.FailoverSender(heavies))
+		genericReply, err := sender(ctx, &message.GetCode{
 			Code: p.code,
 		}, &insolar.MessageSendOptions{
-			Receiver: heavy,
+			Receiver: &heavies[0],
 		})
 		if err != nil {
 			return bus.Reply{Err: errors.Wrap(err, "failed to fetch code from heavy")}
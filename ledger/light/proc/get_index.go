@@ -28,6 +28,7 @@ import (
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/messagebus"
 	"github.com/pkg/errors"
 )
 
@@ -90,14 +91,15 @@ func (p *GetIndex) process(ctx context.Context) error {
 	}
 
 	logger.Debug("failed to fetch index (fetching from heavy)")
-	heavy, err := p.Dep.Coordinator.Heavy(ctx, flow.Pulse(ctx))
+	heavies, err := p.Dep.Coordinator.Heavies(ctx, flow.Pulse(ctx))
 	if err != nil {
 		return errors.Wrap(err, "failed to calculate heavy")
 	}
-	genericReply, err := p.Dep.Bus.Send(ctx, &message.GetObjectIndex{
+	sender := messagebus.BuildSender(p.Dep.Bus.Send, messagebus.FailoverSender(heavies))
+	genericReply, err := sender(ctx, &message.GetObjectIndex{
 		Object: p.object,
 	}, &insolar.MessageSendOptions{
-		Receiver: heavy,
+		Receiver: &heavies[0],
 	})
 	if err != nil {
 		logger.WithFields(map[string]interface{}{
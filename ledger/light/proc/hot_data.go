@@ -18,8 +18,10 @@ package proc
 
 import (
 	"context"
+	"sort"
 
 	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/flow/bus"
@@ -27,6 +29,7 @@ import (
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
 	"github.com/insolar/insolar/ledger/drop"
 	"github.com/insolar/insolar/ledger/light/hot"
 	"github.com/insolar/insolar/ledger/light/recentstorage"
@@ -45,6 +48,7 @@ type HotData struct {
 		JetStorage            jet.Storage
 		JetFetcher            jet.Fetcher
 		JetReleaser           hot.JetReleaser
+		PendingRequestsLimit  int
 	}
 }
 
@@ -78,6 +82,12 @@ func (p *HotData) process(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "[jet]: drop error (pulse: %v)", p.msg.Drop.Pulse)
 	}
+	if p.msg.Sibling != nil {
+		err := p.Dep.DropModifier.Set(ctx, *p.msg.Sibling)
+		if err != nil && err != drop.ErrOverride {
+			return errors.Wrapf(err, "[jet]: sibling drop error (pulse: %v)", p.msg.Sibling.Pulse)
+		}
+	}
 
 	pendingStorage := p.Dep.RecentStorageProvider.GetPendingStorage(ctx, insolar.ID(jetID))
 	logger.Debugf("received %d pending requests", len(p.msg.PendingRequests))
@@ -92,6 +102,8 @@ func (p *HotData) process(ctx context.Context) error {
 		pendingStorage.SetContextToObject(ctx, objID, objContext)
 	}
 
+	notificationList = p.limitNotifications(ctx, notificationList)
+
 	go func() {
 		for _, objID := range notificationList {
 			go func(objID insolar.ID) {
@@ -139,6 +151,13 @@ func (p *HotData) process(ctx context.Context) error {
 	p.Dep.JetStorage.Update(
 		ctx, p.msg.PulseNumber, true, jetID,
 	)
+	if p.msg.Sibling != nil {
+		// Siblings were merged, so the jet has no branches in the new pulse.
+		err := p.Dep.JetStorage.Merge(ctx, p.msg.PulseNumber, jetID)
+		if err != nil {
+			return errors.Wrap(err, "failed to merge jet")
+		}
+	}
 
 	p.Dep.JetFetcher.Release(ctx, jetID, p.msg.PulseNumber)
 
@@ -148,6 +167,49 @@ func (p *HotData) process(ctx context.Context) error {
 	return nil
 }
 
+// limitNotifications orders objects by the age of their oldest pending request and cuts the list, so the number of
+// abandoned requests of notified objects fits PendingRequestsLimit. The oldest object is always notified. Objects that
+// don't fit stay inactive, so they are picked up on the next pulse.
+func (p *HotData) limitNotifications(ctx context.Context, objects []insolar.ID) []insolar.ID {
+	oldest := func(objID insolar.ID) insolar.PulseNumber {
+		requests := p.msg.PendingRequests[objID].Requests
+		if len(requests) == 0 {
+			return p.msg.PulseNumber
+		}
+		return requests[0].Pulse()
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return oldest(objects[i]) < oldest(objects[j])
+	})
+
+	var skipped []insolar.ID
+	requests := 0
+	for i, objID := range objects {
+		requests += len(p.msg.PendingRequests[objID].Requests)
+		if p.Dep.PendingRequestsLimit > 0 && i > 0 && requests > p.Dep.PendingRequestsLimit {
+			objects, skipped = objects[:i], objects[i:]
+			break
+		}
+	}
+	if len(skipped) > 0 {
+		inslogger.FromContext(ctx).Warnf(
+			"too many objects with abandoned requests, %d of them will be notified on the next pulse", len(skipped),
+		)
+	}
+
+	ctx = insmetrics.InsertTag(ctx, tagJet, p.msg.Jet.Record().DebugString())
+	for _, objID := range objects {
+		stats.Record(ctx, statAbandonedRequestAge.M(int64(p.msg.PulseNumber-oldest(objID))))
+	}
+	stats.Record(
+		ctx,
+		statAbandonedNotified.M(int64(len(objects))),
+		statAbandonedSkipped.M(int64(len(skipped))),
+	)
+
+	return objects
+}
+
 func (p *HotData) releaseHotDataWaiters(ctx context.Context) {
 	jetID := p.msg.Jet.Record()
 	err := p.Dep.JetReleaser.Unlock(ctx, *jetID)
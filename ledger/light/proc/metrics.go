@@ -0,0 +1,115 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package proc
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+var (
+	tagJet    = insmetrics.MustTagKey("jet")
+	tagReason = insmetrics.MustTagKey("reason")
+	tagObject = insmetrics.MustTagKey("object")
+)
+
+var (
+	statAbandonedNotified = stats.Int64(
+		"light/pending/abandoned/notified/count",
+		"objects with abandoned requests reported to virtual executor",
+		stats.UnitDimensionless,
+	)
+	statAbandonedSkipped = stats.Int64(
+		"light/pending/abandoned/skipped/count",
+		"objects with abandoned requests postponed because of pending requests limit",
+		stats.UnitDimensionless,
+	)
+	statAbandonedRequestAge = stats.Int64(
+		"light/pending/abandoned/age",
+		"age of the oldest abandoned request of object in pulse numbers",
+		stats.UnitDimensionless,
+	)
+	statPendingObjectRequests = stats.Int64(
+		"light/pending/object/requests",
+		"pending requests of object at the moment a new request to it is registered",
+		stats.UnitDimensionless,
+	)
+	statHotObjectRequests = stats.Int64(
+		"light/pending/hot/requests",
+		"pending requests of hot object at the moment a new request to it is registered",
+		stats.UnitDimensionless,
+	)
+	statPendingDeclined = stats.Int64(
+		"light/pending/declined/count",
+		"requests declined because of pending requests limits",
+		stats.UnitDimensionless,
+	)
+)
+
+func init() {
+	commontags := []tag.Key{tagJet}
+	err := view.Register(
+		&view.View{
+			Name:        statAbandonedNotified.Name(),
+			Description: statAbandonedNotified.Description(),
+			Measure:     statAbandonedNotified,
+			Aggregation: view.Sum(),
+			TagKeys:     commontags,
+		},
+		&view.View{
+			Name:        statAbandonedSkipped.Name(),
+			Description: statAbandonedSkipped.Description(),
+			Measure:     statAbandonedSkipped,
+			Aggregation: view.Sum(),
+			TagKeys:     commontags,
+		},
+		&view.View{
+			Name:        statAbandonedRequestAge.Name(),
+			Description: statAbandonedRequestAge.Description(),
+			Measure:     statAbandonedRequestAge,
+			Aggregation: view.Distribution(10, 20, 30, 60, 120, 300, 600, 1800, 3600),
+			TagKeys:     commontags,
+		},
+		&view.View{
+			Name:        statPendingObjectRequests.Name(),
+			Description: statPendingObjectRequests.Description(),
+			Measure:     statPendingObjectRequests,
+			Aggregation: view.Distribution(1, 5, 10, 25, 50, 100, 250, 500, 1000),
+			TagKeys:     commontags,
+		},
+		&view.View{
+			Name:        statHotObjectRequests.Name(),
+			Description: statHotObjectRequests.Description(),
+			Measure:     statHotObjectRequests,
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{tagJet, tagObject},
+		},
+		&view.View{
+			Name:        statPendingDeclined.Name(),
+			Description: statPendingDeclined.Description(),
+			Measure:     statPendingDeclined,
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{tagJet, tagReason},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
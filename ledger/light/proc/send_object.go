@@ -89,16 +89,16 @@ func (p *SendObject) handle(
 		return nil, err
 	}
 	if onHeavy {
-		hNode, err := p.Dep.Coordinator.Heavy(ctx, parcel.Pulse())
+		heavies, err := p.Dep.Coordinator.Heavies(ctx, parcel.Pulse())
 		if err != nil {
 			return nil, err
 		}
 		logger.WithFields(map[string]interface{}{
 			"state":    stateID.DebugString(),
-			"going_to": hNode.String(),
+			"going_to": heavies[0].String(),
 		}).Debug("fetching object (on heavy)")
 
-		obj, err := p.fetchObject(ctx, msg.Head, *hNode, stateID, parcel.Pulse())
+		obj, err := p.fetchObject(ctx, msg.Head, heavies, stateID, parcel.Pulse())
 		if err != nil {
 			if err == insolar.ErrDeactivated {
 				return &reply.Error{ErrType: reply.ErrDeactivated}, nil
@@ -143,7 +143,7 @@ func (p *SendObject) handle(
 			"going_to": suitNode.String(),
 		}).Debug("fetching object (record not found)")
 
-		obj, err := p.fetchObject(ctx, msg.Head, *suitNode, stateID, parcel.Pulse())
+		obj, err := p.fetchObject(ctx, msg.Head, []insolar.Reference{*suitNode}, stateID, parcel.Pulse())
 		if err != nil {
 			if err == insolar.ErrDeactivated {
 				return &reply.Error{ErrType: reply.ErrDeactivated}, nil
@@ -192,11 +192,11 @@ func (p *SendObject) handle(
 	if state.GetMemory() != nil && state.GetMemory().NotEmpty() {
 		b, err := p.Dep.Blobs.ForID(ctx, *state.GetMemory())
 		if err == blob.ErrNotFound {
-			hNode, err := p.Dep.Coordinator.Heavy(ctx, parcel.Pulse())
+			heavies, err := p.Dep.Coordinator.Heavies(ctx, parcel.Pulse())
 			if err != nil {
 				return nil, err
 			}
-			obj, err := p.fetchObject(ctx, msg.Head, *hNode, stateID, parcel.Pulse())
+			obj, err := p.fetchObject(ctx, msg.Head, heavies, stateID, parcel.Pulse())
 			if err != nil {
 				return nil, err
 			}
@@ -215,13 +215,15 @@ func (p *SendObject) handle(
 	return &rep, nil
 }
 
+// fetchObject fetches object from the first of provided nodes. Other nodes are replicas to fail over to.
 func (p *SendObject) fetchObject(
-	ctx context.Context, obj insolar.Reference, node insolar.Reference, stateID *insolar.ID, pulse insolar.PulseNumber,
+	ctx context.Context, obj insolar.Reference, nodes []insolar.Reference, stateID *insolar.ID, pulse insolar.PulseNumber,
 ) (*reply.Object, error) {
 	sender := messagebus.BuildSender(
 		p.Dep.Bus.Send,
 		messagebus.FollowRedirectSender(p.Dep.Bus),
 		messagebus.RetryJetSender(p.Dep.Jets),
+		messagebus.FailoverSender(nodes),
 	)
 	genericReply, err := sender(
 		ctx,
@@ -230,7 +232,7 @@ func (p *SendObject) fetchObject(
 			State:    stateID,
 		},
 		&insolar.MessageSendOptions{
-			Receiver: &node,
+			Receiver: &nodes[0],
 			Token:    &delegationtoken.GetObjectRedirectToken{},
 		},
 	)
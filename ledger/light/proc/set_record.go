@@ -19,8 +19,10 @@ package proc
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/flow"
@@ -28,6 +30,7 @@ import (
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
 	"github.com/insolar/insolar/ledger/light/recentstorage"
 	"github.com/insolar/insolar/ledger/object"
 )
@@ -42,6 +45,9 @@ type SetRecord struct {
 		PCS                   insolar.PlatformCryptographyScheme
 		RecordModifier        object.RecordModifier
 		PendingRequestsLimit  int
+
+		ObjectPendingRequestsLimit int
+		PendingRetryDelay          time.Duration
 	}
 }
 
@@ -75,11 +81,10 @@ func (p *SetRecord) reply(ctx context.Context) bus.Reply {
 			if r.Object == nil {
 				return bus.Reply{Err: errors.New("method call request without object reference")}
 			}
-			if p.Dep.RecentStorageProvider.Count() > p.Dep.PendingRequestsLimit {
-				return bus.Reply{Reply: &reply.Error{ErrType: reply.ErrTooManyPendingRequests}}
-			}
 			recentStorage := p.Dep.RecentStorageProvider.GetPendingStorage(ctx, insolar.ID(p.jet))
-			recentStorage.AddPendingRequest(ctx, *r.Object.Record(), *calculatedID)
+			if overloaded := p.addPending(ctx, recentStorage, *r.Object, *calculatedID); overloaded != nil {
+				return bus.Reply{Reply: overloaded}
+			}
 		}
 	case *record.Result:
 		recentStorage := p.Dep.RecentStorageProvider.GetPendingStorage(ctx, insolar.ID(p.jet))
@@ -104,3 +109,49 @@ func (p *SetRecord) reply(ctx context.Context) bus.Reply {
 
 	return bus.Reply{Reply: &reply.ID{ID: *id}}
 }
+
+// hot checks if an object with provided number of pending requests is hot, i.e. it has at least a half of pending
+// requests allowed for an object. There are at most 2*PendingRequestsLimit/ObjectPendingRequestsLimit hot objects
+// in a jet at a time.
+func (p *SetRecord) hot(objectPending int) bool {
+	return p.Dep.ObjectPendingRequestsLimit > 0 && objectPending*2 >= p.Dep.ObjectPendingRequestsLimit
+}
+
+// addPending adds the request to pending requests of the object unless pending requests limit of the object or of
+// the jet is reached. Zero limits are not checked. It returns a reply declining the request if it's not added.
+func (p *SetRecord) addPending(
+	ctx context.Context, pending recentstorage.PendingStorage, obj insolar.Reference, req insolar.ID,
+) *reply.ObjectOverloaded {
+	objectPending, err := pending.AddPendingRequestLimited(
+		ctx, *obj.Record(), req, p.Dep.ObjectPendingRequestsLimit, p.Dep.PendingRequestsLimit,
+	)
+
+	ctx = insmetrics.InsertTag(ctx, tagJet, p.jet.DebugString())
+	stats.Record(ctx, statPendingObjectRequests.M(int64(objectPending)))
+	if p.hot(objectPending) {
+		// Only hot objects are tagged, so the number of tagged objects of a jet is bounded by the limits.
+		stats.Record(
+			insmetrics.InsertTag(ctx, tagObject, obj.Record().DebugString()),
+			statHotObjectRequests.M(int64(objectPending)),
+		)
+	}
+
+	var reason string
+	switch err {
+	case nil:
+		return nil
+	case recentstorage.ErrObjectPendingLimit:
+		reason = "object"
+	default:
+		reason = "jet"
+	}
+
+	// Declined objects are logged, hot objects are already tagged above.
+	ctx = insmetrics.InsertTag(ctx, tagReason, reason)
+	stats.Record(ctx, statPendingDeclined.M(1))
+	inslogger.FromContext(ctx).Warnf(
+		"request to object %s is declined: %s pending requests limit is reached", obj.Record().String(), reason,
+	)
+
+	return &reply.ObjectOverloaded{Object: obj, RetryAfter: p.Dep.PendingRetryDelay}
+}
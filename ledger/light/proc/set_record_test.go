@@ -0,0 +1,85 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package proc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/light/recentstorage"
+)
+
+func TestSetRecord_Overloaded(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	jetID := gen.JetID()
+	obj := gen.Reference()
+	other := gen.Reference()
+
+	newSetRecord := func() *SetRecord {
+		p := NewSetRecord(jetID, nil, nil)
+		p.Dep.ObjectPendingRequestsLimit = 2
+		p.Dep.PendingRequestsLimit = 3
+		p.Dep.PendingRetryDelay = time.Second
+		return p
+	}
+
+	t.Run("accepts requests under limits", func(t *testing.T) {
+		pending := recentstorage.NewPendingStorage(insolar.ID(jetID))
+		pending.AddPendingRequest(ctx, *obj.Record(), gen.ID())
+
+		req := gen.ID()
+		assert.Nil(t, newSetRecord().addPending(ctx, pending, obj, req))
+		assert.Contains(t, pending.GetRequestsForObject(*obj.Record()), req)
+	})
+
+	t.Run("declines requests over object limit", func(t *testing.T) {
+		pending := recentstorage.NewPendingStorage(insolar.ID(jetID))
+		pending.AddPendingRequest(ctx, *obj.Record(), gen.ID())
+		pending.AddPendingRequest(ctx, *obj.Record(), gen.ID())
+
+		rep := newSetRecord().addPending(ctx, pending, obj, gen.ID())
+		require.NotNil(t, rep)
+		assert.Equal(t, &reply.ObjectOverloaded{Object: obj, RetryAfter: time.Second}, rep)
+		assert.Len(t, pending.GetRequestsForObject(*obj.Record()), 2, "declined request is not added")
+		assert.Nil(t, newSetRecord().addPending(ctx, pending, other, gen.ID()), "other objects are not affected")
+	})
+
+	t.Run("declines requests over jet limit", func(t *testing.T) {
+		pending := recentstorage.NewPendingStorage(insolar.ID(jetID))
+		pending.AddPendingRequest(ctx, *obj.Record(), gen.ID())
+		pending.AddPendingRequest(ctx, *other.Record(), gen.ID())
+		pending.AddPendingRequest(ctx, *other.Record(), gen.ID())
+
+		rep := newSetRecord().addPending(ctx, pending, obj, gen.ID())
+		require.NotNil(t, rep)
+		assert.Equal(t, obj, rep.Object)
+	})
+
+	t.Run("zero limits are not checked", func(t *testing.T) {
+		pending := recentstorage.NewPendingStorage(insolar.ID(jetID))
+		pending.AddPendingRequest(ctx, *obj.Record(), gen.ID())
+
+		p := NewSetRecord(jetID, nil, nil)
+		assert.Nil(t, p.addPending(ctx, pending, obj, gen.ID()))
+	})
+}
@@ -25,6 +25,8 @@ var (
 	statCleanLatencyTotal = stats.Int64("lightcleanup/latency/total", "Light storage cleanup time in milliseconds", stats.UnitMilliseconds)
 	statHotObjectsSent    = stats.Int64("hotdata/objects/total", "Amount of hot objects sent to the next executor", stats.UnitDimensionless)
 	statPendingSent       = stats.Int64("hotdata/pending/total", "Amount of pending requests sent to the next executor", stats.UnitDimensionless)
+	statJetSplit          = stats.Int64("jets/split/total", "Amount of performed jet splits", stats.UnitDimensionless)
+	statJetMerge          = stats.Int64("jets/merge/total", "Amount of performed jet merges", stats.UnitDimensionless)
 )
 
 func init() {
@@ -50,6 +52,20 @@ func init() {
 			Measure:     statPendingSent,
 			Aggregation: view.Sum(),
 		},
+
+		&view.View{
+			Name:        statJetSplit.Name(),
+			Description: statJetSplit.Description(),
+			Measure:     statJetSplit,
+			Aggregation: view.Sum(),
+		},
+
+		&view.View{
+			Name:        statJetMerge.Name(),
+			Description: statJetMerge.Description(),
+			Measure:     statJetMerge,
+			Aggregation: view.Sum(),
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -19,7 +19,6 @@ package pulsemanager
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -106,17 +105,47 @@ type jetInfo struct {
 	left     *jetInfo
 	right    *jetInfo
 	split    bool
+	// merge indicates that left and right are the merged children of the jet.
+	merge bool
+	load  drop.Load
 }
 
 // Just store ledger configuration in PM. This is not required.
 type pmOptions struct {
 	// enableSync            bool
-	splitThreshold   uint64
-	storeLightPulses int
+	splitThreshold         uint64
+	splitRecordsThreshold  uint64
+	splitRequestsThreshold uint64
+	loadWindow             uint64
+	mergeRatio             uint64
+	storeLightPulses       int
 	// heavySyncMessageLimit int
 	lightChainLimit int
 }
 
+// overloaded checks if the averaged load of a jet exceeds any of split thresholds.
+func (o pmOptions) overloaded(load drop.Load) bool {
+	exceeds := func(value, threshold uint64) bool {
+		return threshold > 0 && value > threshold
+	}
+	return exceeds(load.Size, o.splitThreshold) ||
+		exceeds(load.Records, o.splitRecordsThreshold) ||
+		exceeds(load.Requests, o.splitRequestsThreshold)
+}
+
+// cold checks if the averaged load of a jet is mergeRatio times below all split thresholds.
+func (o pmOptions) cold(load drop.Load) bool {
+	if o.mergeRatio == 0 {
+		return false
+	}
+	below := func(value, threshold uint64) bool {
+		return threshold == 0 || value*o.mergeRatio < threshold
+	}
+	return below(load.Size, o.splitThreshold) &&
+		below(load.Records, o.splitRecordsThreshold) &&
+		below(load.Requests, o.splitRequestsThreshold)
+}
+
 // NewPulseManager creates PulseManager instance.
 func NewPulseManager(
 	conf configuration.Ledger,
@@ -134,9 +163,13 @@ func NewPulseManager(
 	pm := &PulseManager{
 		currentPulse: *insolar.GenesisPulse,
 		options: pmOptions{
-			splitThreshold:   pmconf.SplitThreshold,
-			storeLightPulses: conf.LightChainLimit,
-			lightChainLimit:  conf.LightChainLimit,
+			splitThreshold:         pmconf.SplitThreshold,
+			splitRecordsThreshold:  pmconf.SplitRecordsThreshold,
+			splitRequestsThreshold: pmconf.SplitRequestsThreshold,
+			loadWindow:             pmconf.LoadWindow,
+			mergeRatio:             pmconf.MergeRatio,
+			storeLightPulses:       conf.LightChainLimit,
+			lightChainLimit:        conf.LightChainLimit,
 		},
 		DropCleaner:         dropCleaner,
 		BlobCleaner:         blobCleaner,
@@ -161,31 +194,46 @@ func (m *PulseManager) processEndPulse(
 	defer span.End()
 
 	logger := inslogger.FromContext(ctx)
+	sender := func(msg message.HotData, jetID insolar.JetID) {
+		ctx, span := instracer.StartSpan(ctx, "pulse.send_hot")
+		defer span.End()
+		msg.Jet = *insolar.NewReference(insolar.DomainID, insolar.ID(jetID))
+		genericRep, err := m.Bus.Send(ctx, &msg, nil)
+		if err != nil {
+			logger.WithField("err", err).Error("failed to send hot data")
+			return
+		}
+		if _, ok := genericRep.(*reply.OK); !ok {
+			logger.WithField(
+				"err",
+				fmt.Sprintf("unexpected reply: %T", genericRep),
+			).Error("failed to send hot data")
+			return
+		}
+	}
+
 	for _, i := range jets {
 		info := i
 
 		g.Go(func() error {
-			drop, dropSerialized, _, err := m.createDrop(ctx, info, currentPulse.PulseNumber)
-			if err != nil {
-				return errors.Wrapf(err, "create drop on pulse %v failed", currentPulse.PulseNumber)
-			}
-
-			sender := func(msg message.HotData, jetID insolar.JetID) {
-				ctx, span := instracer.StartSpan(ctx, "pulse.send_hot")
-				defer span.End()
-				msg.Jet = *insolar.NewReference(insolar.DomainID, insolar.ID(jetID))
-				genericRep, err := m.Bus.Send(ctx, &msg, nil)
+			if info.merge {
+				msg, err := m.getMergedHotData(
+					ctx, info, prevPulseNumber, currentPulse.PulseNumber, newPulse.PulseNumber,
+				)
 				if err != nil {
-					logger.WithField("err", err).Error("failed to send hot data")
-					return
-				}
-				if _, ok := genericRep.(*reply.OK); !ok {
-					logger.WithField(
-						"err",
-						fmt.Sprintf("unexpected reply: %T", genericRep),
-					).Error("failed to send hot data")
-					return
+					return errors.Wrapf(err, "getMergedHotData failed for jet id %v", info.id)
 				}
+				// Merge happened.
+				go sender(*msg, info.id)
+
+				m.RecentStorageProvider.RemovePendingStorage(ctx, insolar.ID(info.left.id))
+				m.RecentStorageProvider.RemovePendingStorage(ctx, insolar.ID(info.right.id))
+				return nil
+			}
+
+			drop, dropSerialized, _, err := m.createDrop(ctx, info, prevPulseNumber, currentPulse.PulseNumber)
+			if err != nil {
+				return errors.Wrapf(err, "create drop on pulse %v failed", currentPulse.PulseNumber)
 			}
 
 			if info.left == nil && info.right == nil {
@@ -225,6 +273,7 @@ func (m *PulseManager) processEndPulse(
 func (m *PulseManager) createDrop(
 	ctx context.Context,
 	info jetInfo,
+	prevPulse insolar.PulseNumber,
 	currentPulse insolar.PulseNumber,
 ) (
 	block *drop.Drop,
@@ -232,10 +281,20 @@ func (m *PulseManager) createDrop(
 	messages [][]byte,
 	err error,
 ) {
+	prevHash, _ := m.prevDrop(ctx, info.id, prevPulse)
 	block = &drop.Drop{
-		Pulse: currentPulse,
+		Pulse:    currentPulse,
+		PrevHash: prevHash,
+		Hash: drop.Hash(
+			m.PlatformCryptographyScheme,
+			prevHash,
+			currentPulse,
+			m.RecSyncAccessor.ForPulse(ctx, info.id, currentPulse),
+			m.BlobSyncAccessor.ForPulse(ctx, info.id, currentPulse),
+		),
 		JetID: info.id,
 		Split: info.split,
+		Load:  info.load,
 	}
 
 	err = m.DropModifier.Set(ctx, *block)
@@ -247,6 +306,52 @@ func (m *PulseManager) createDrop(
 	return
 }
 
+// prevDrop returns hash and load of the drop of jet for previous pulse. If jet was created by split in previous pulse,
+// hash of the parent's drop and a half of its load are returned. If jet was created by merge in previous pulse, merged
+// hash and summed load of children drops are returned. Drop of previous pulse is created by this node or received
+// with hot data.
+func (m *PulseManager) prevDrop(
+	ctx context.Context, jetID insolar.JetID, prevPulse insolar.PulseNumber,
+) ([]byte, drop.Load) {
+	prev, err := m.DropAccessor.ForPulse(ctx, jetID, prevPulse)
+	if err == nil {
+		return prev.Hash, prev.Load
+	}
+	parent, err := m.DropAccessor.ForPulse(ctx, jet.Parent(jetID), prevPulse)
+	if err == nil {
+		return parent.Hash, parent.Load.Half()
+	}
+	leftID, rightID := jet.Children(jetID)
+	left, leftErr := m.DropAccessor.ForPulse(ctx, leftID, prevPulse)
+	right, rightErr := m.DropAccessor.ForPulse(ctx, rightID, prevPulse)
+	if leftErr == nil && rightErr == nil {
+		return drop.MergedHash(m.PlatformCryptographyScheme, left.Hash, right.Hash), left.Load.Add(right.Load)
+	}
+
+	inslogger.FromContext(ctx).Debugf(
+		"previous drop of jet %v for pulse %v is not found, drop chain starts", jetID.DebugString(), prevPulse,
+	)
+	return nil, drop.Load{}
+}
+
+// jetLoad calculates load of the jet for current pulse and averages it with the load of the previous drop.
+func (m *PulseManager) jetLoad(ctx context.Context, jetID insolar.JetID, previous, current insolar.PulseNumber) drop.Load {
+	var load drop.Load
+	for _, rec := range m.RecSyncAccessor.ForPulse(ctx, jetID, current) {
+		load.Size += uint64(rec.Size())
+		load.Records++
+		if rec.Virtual != nil && rec.Virtual.GetRequest() != nil {
+			load.Requests++
+		}
+	}
+	for _, b := range m.BlobSyncAccessor.ForPulse(ctx, jetID, current) {
+		load.Size += uint64(len(b.Value))
+	}
+
+	_, prevLoad := m.prevDrop(ctx, jetID, previous)
+	return prevLoad.Average(load, m.options.loadWindow)
+}
+
 func (m *PulseManager) getExecutorHotData(
 	ctx context.Context,
 	jetID insolar.JetID,
@@ -310,7 +415,36 @@ func (m *PulseManager) getExecutorHotData(
 	return msg, nil
 }
 
-var splitCount = 5
+// getMergedHotData creates drops of merged children of the jet and collects their hot data into one message
+// for the jet.
+func (m *PulseManager) getMergedHotData(
+	ctx context.Context,
+	info jetInfo,
+	prevPulse insolar.PulseNumber,
+	currentPN insolar.PulseNumber,
+	newPulsePN insolar.PulseNumber,
+) (*message.HotData, error) {
+	var msgs []*message.HotData
+	for _, child := range []*jetInfo{info.left, info.right} {
+		drop, dropSerialized, _, err := m.createDrop(ctx, *child, prevPulse, currentPN)
+		if err != nil {
+			return nil, errors.Wrapf(err, "create drop on pulse %v failed", currentPN)
+		}
+		msg, err := m.getExecutorHotData(ctx, child.id, currentPN, newPulsePN, drop, dropSerialized)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getExecutorData failed for jet id %v", child.id)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	msg, sibling := msgs[0], msgs[1]
+	msg.Sibling = &sibling.Drop
+	msg.HotIndexes = append(msg.HotIndexes, sibling.HotIndexes...)
+	for objID, objContext := range sibling.PendingRequests {
+		msg.PendingRequests[objID] = objContext
+	}
+	return msg, nil
+}
 
 func (m *PulseManager) processJets(ctx context.Context, previous, current, new insolar.PulseNumber) ([]jetInfo, error) {
 	ctx, span := instracer.StartSpan(ctx, "jets.process")
@@ -330,25 +464,55 @@ func (m *PulseManager) processJets(ctx context.Context, previous, current, new i
 		return nil, err
 	}
 
+	loads := make(map[insolar.JetID]drop.Load, len(ids))
+	splitting := map[insolar.JetID]struct{}{}
 	var withoutSplitIntention []insolar.JetID
 	for _, id := range ids {
+		loads[id] = m.jetLoad(ctx, id, previous, current)
 		if m.hasSplitIntention(ctx, previous, id) {
-			results = append(results, jetInfo{id: id})
+			splitting[id] = struct{}{}
+			results = append(results, jetInfo{id: id, load: loads[id]})
 		} else {
 			withoutSplitIntention = append(withoutSplitIntention, id)
 		}
 	}
 
-	if len(withoutSplitIntention) == 0 {
-		return results, nil
+	// Siblings are merged only if both of them are ours, because the load of both is required.
+	merged := map[insolar.JetID]struct{}{}
+	for _, jetID := range withoutSplitIntention {
+		if jetID.Depth() == 0 {
+			continue
+		}
+		leftID, rightID := jet.Children(jet.Parent(jetID))
+		if jetID != leftID {
+			continue
+		}
+		rightLoad, ok := loads[rightID]
+		if _, rightSplitting := splitting[rightID]; !ok || rightSplitting {
+			continue
+		}
+		if !m.options.cold(loads[leftID].Add(rightLoad)) {
+			continue
+		}
+
+		info, err := m.mergeJets(
+			ctx, jetInfo{id: leftID, load: loads[leftID]}, jetInfo{id: rightID, load: rightLoad}, new,
+		)
+		if err != nil {
+			return nil, err
+		}
+		merged[leftID] = struct{}{}
+		merged[rightID] = struct{}{}
+		results = append(results, info)
 	}
 
-	indexToSplit := rand.Intn(len(withoutSplitIntention))
-	for i, jetID := range withoutSplitIntention {
-		info := jetInfo{id: jetID}
-		if indexToSplit == i && splitCount > 0 {
-			splitCount--
+	for _, jetID := range withoutSplitIntention {
+		if _, ok := merged[jetID]; ok {
+			continue
+		}
 
+		info := jetInfo{id: jetID, load: loads[jetID]}
+		if m.options.overloaded(info.load) {
 			info.split = true
 		} else {
 			// Set actual because we are the last executor for jet.
@@ -366,6 +530,35 @@ func (m *PulseManager) processJets(ctx context.Context, previous, current, new i
 	return results, nil
 }
 
+// mergeJets merges sibling jets into their parent in the tree for new pulse.
+func (m *PulseManager) mergeJets(ctx context.Context, left, right jetInfo, new insolar.PulseNumber) (jetInfo, error) {
+	parentID := jet.Parent(left.id)
+	err := m.JetModifier.Merge(ctx, new, parentID)
+	if err != nil {
+		return jetInfo{}, errors.Wrap(err, "failed to merge jet tree")
+	}
+	// Set actual because we are the last executor for jet.
+	m.JetModifier.Update(ctx, new, true, parentID)
+
+	info := jetInfo{id: parentID, merge: true, left: &left, right: &right}
+	nextExecutor, err := m.JetCoordinator.LightExecutorForJet(ctx, insolar.ID(parentID), new)
+	if err != nil {
+		return jetInfo{}, err
+	}
+	if *nextExecutor == m.JetCoordinator.Me() {
+		info.mineNext = true
+	}
+
+	inslogger.FromContext(ctx).WithFields(map[string]interface{}{
+		"jet_id":      parentID.DebugString(),
+		"left_child":  left.id.DebugString(),
+		"right_child": right.id.DebugString(),
+	}).Info("jet merge performed")
+	stats.Record(ctx, statJetMerge.M(1))
+
+	return info, nil
+}
+
 func (m *PulseManager) filterOtherExecutors(ctx context.Context, pulse insolar.PulseNumber, ids []insolar.JetID) ([]insolar.JetID, error) {
 	me := m.JetCoordinator.Me()
 	result := []insolar.JetID{}
@@ -553,7 +746,10 @@ func (m *PulseManager) splitJets(ctx context.Context, jets []jetInfo, previous,
 	})
 
 	for i, jet := range jets {
-		info := jetInfo{id: jet.id}
+		if jet.merge {
+			continue
+		}
+		info := jetInfo{id: jet.id, load: jet.load}
 		if m.hasSplitIntention(ctx, previous, jet.id) {
 			leftJetID, rightJetID, err := m.JetModifier.Split(
 				ctx,
@@ -591,6 +787,7 @@ func (m *PulseManager) splitJets(ctx context.Context, jets []jetInfo, previous,
 				"left_child":  leftJetID.DebugString(),
 				"right_child": rightJetID.DebugString(),
 			}).Info("jet split performed")
+			stats.Record(ctx, statJetSplit.M(1))
 
 			jets[i] = info
 		}
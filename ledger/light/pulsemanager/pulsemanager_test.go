@@ -0,0 +1,254 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package pulsemanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/light/recentstorage"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils/network"
+)
+
+func TestPmOptions_OverloadedAndCold(t *testing.T) {
+	t.Parallel()
+
+	options := pmOptions{splitThreshold: 1000, splitRecordsThreshold: 100, mergeRatio: 4}
+	for _, tc := range []struct {
+		name       string
+		load       drop.Load
+		overloaded bool
+		cold       bool
+	}{
+		{name: "empty", load: drop.Load{}, cold: true},
+		{name: "size exceeds", load: drop.Load{Size: 1001}, overloaded: true},
+		{name: "records exceed", load: drop.Load{Records: 101}, overloaded: true},
+		{name: "disabled threshold is ignored", load: drop.Load{Requests: 1000000}, cold: true},
+		{name: "threshold isn't exceeded", load: drop.Load{Size: 1000, Records: 100}},
+		{name: "not cold enough", load: drop.Load{Size: 250}},
+		{name: "cold", load: drop.Load{Size: 249, Records: 24}, cold: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.overloaded, options.overloaded(tc.load))
+			assert.Equal(t, tc.cold, options.cold(tc.load))
+		})
+	}
+
+	assert.False(t, pmOptions{}.cold(drop.Load{}), "zero merge ratio disables merge")
+}
+
+// testPulseManager is a pulse manager of a light node, that is the executor of all jets.
+type testPulseManager struct {
+	*PulseManager
+	me      insolar.Reference
+	jets    *jet.Store
+	drops   drop.Accessor
+	records *object.RecordMemory
+	indexes *object.InMemoryIndex
+	pending *recentstorage.RecentStorageProvider
+}
+
+func newTestPulseManager(t *testing.T, options pmOptions) *testPulseManager {
+	me := gen.Reference()
+	origin := network.NewNetworkNodeMock(t)
+	origin.RoleMock.Return(insolar.StaticRoleLightMaterial)
+	nodeNet := network.NewNodeNetworkMock(t)
+	nodeNet.GetOriginMock.Return(origin)
+	coordinator := jet.NewCoordinatorMock(t)
+	coordinator.MeMock.Return(me)
+	coordinator.LightExecutorForJetMock.Return(&me, nil)
+	pulses := pulse.NewCalculatorMock(t)
+	pulses.BackwardsMock.Return(insolar.Pulse{}, pulse.ErrNotFound)
+
+	pm := &testPulseManager{
+		me:      me,
+		jets:    jet.NewStore(),
+		drops:   drop.NewStorageMemory(),
+		records: object.NewRecordMemory(),
+		indexes: object.NewInMemoryIndex(),
+		pending: recentstorage.NewRecentStorageProvider(),
+	}
+	pm.PulseManager = &PulseManager{
+		NodeNet:                    nodeNet,
+		JetCoordinator:             coordinator,
+		PlatformCryptographyScheme: platformpolicy.NewPlatformCryptographyScheme(),
+		RecentStorageProvider:      pm.pending,
+		JetAccessor:                pm.jets,
+		JetModifier:                pm.jets,
+		IndexBucketAccessor:        pm.indexes,
+		DropAccessor:               pm.drops,
+		DropModifier:               pm.drops.(drop.Modifier),
+		PulseCalculator:            pulses,
+		BlobSyncAccessor:           blob.NewStorageMemory(),
+		RecSyncAccessor:            pm.records,
+		options:                    options,
+	}
+	return pm
+}
+
+// addRequests adds requests to the jet for the pulse and returns IDs of their objects.
+func (pm *testPulseManager) addRequests(t *testing.T, jetID insolar.JetID, pn insolar.PulseNumber, count int) []insolar.ID {
+	ctx := inslogger.TestContext(t)
+	var objects []insolar.ID
+	for i := 0; i < count; i++ {
+		obj := gen.Reference()
+		virtual := record.Wrap(record.Request{Object: &obj})
+		id := gen.ID()
+		err := pm.records.Set(ctx, *insolar.NewID(pn, id.Hash()), record.Material{Virtual: &virtual, JetID: jetID})
+		require.NoError(t, err)
+		objects = append(objects, *obj.Record())
+	}
+	return objects
+}
+
+func TestPulseManager_processJets(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+	previous := gen.PulseNumber()
+	current, new := previous+10, previous+20
+
+	pm := newTestPulseManager(t, pmOptions{splitRequestsThreshold: 8, mergeRatio: 4, loadWindow: 1})
+
+	// Tree of current pulse has jets left-left, left-right and right.
+	left, right, err := pm.jets.Split(ctx, current, insolar.ZeroJetID)
+	require.NoError(t, err)
+	leftLeft, leftRight, err := pm.jets.Split(ctx, current, left)
+	require.NoError(t, err)
+	pm.jets.Update(ctx, current, true, leftLeft, leftRight, right)
+
+	// Left children are cold together, right jet is overloaded.
+	pm.addRequests(t, leftLeft, current, 1)
+	pm.addRequests(t, leftRight, current, 0)
+	pm.addRequests(t, right, current, 9)
+
+	jets, err := pm.processJets(ctx, previous, current, new)
+	require.NoError(t, err)
+	require.Len(t, jets, 2)
+
+	merged := jets[0]
+	assert.Equal(t, left, merged.id)
+	assert.True(t, merged.merge)
+	require.NotNil(t, merged.left)
+	require.NotNil(t, merged.right)
+	assert.Equal(t, leftLeft, merged.left.id)
+	assert.Equal(t, leftRight, merged.right.id)
+	assert.Equal(t, uint64(1), merged.left.load.Requests)
+	assert.True(t, merged.mineNext)
+
+	overloaded := jets[1]
+	assert.Equal(t, right, overloaded.id)
+	assert.True(t, overloaded.split, "overloaded jet is marked to split")
+	assert.Equal(t, uint64(9), overloaded.load.Requests)
+	assert.Nil(t, overloaded.left, "jet isn't split before its drop with split intention")
+
+	assert.ElementsMatch(t, []insolar.JetID{left, right}, pm.jets.All(ctx, new), "siblings are merged in the tree")
+}
+
+func TestPulseManager_splitsOverloadedJet(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+	previous := gen.PulseNumber()
+	current, new, next := previous+10, previous+20, previous+30
+
+	pm := newTestPulseManager(t, pmOptions{splitRequestsThreshold: 8, loadWindow: 1})
+	pm.jets.Update(ctx, current, true, insolar.ZeroJetID)
+	pm.addRequests(t, insolar.ZeroJetID, current, 9)
+
+	// Overloaded jet gets a drop with split intention.
+	jets, err := pm.processJets(ctx, previous, current, new)
+	require.NoError(t, err)
+	require.Len(t, jets, 1)
+	require.True(t, jets[0].split)
+	d, _, _, err := pm.createDrop(ctx, jets[0], previous, current)
+	require.NoError(t, err)
+	require.True(t, d.Split)
+
+	// The jet is split on the next pulse.
+	jets, err = pm.processJets(ctx, current, new, next)
+	require.NoError(t, err)
+	jets, err = pm.splitJets(ctx, jets, current, new, next)
+	require.NoError(t, err)
+	require.Len(t, jets, 1)
+	left, right := jet.Children(insolar.ZeroJetID)
+	require.NotNil(t, jets[0].left)
+	require.NotNil(t, jets[0].right)
+	assert.Equal(t, left, jets[0].left.id)
+	assert.Equal(t, right, jets[0].right.id)
+	assert.ElementsMatch(t, []insolar.JetID{left, right}, pm.jets.All(ctx, next))
+}
+
+func TestPulseManager_getMergedHotData(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+	previous := gen.PulseNumber()
+	current, new := previous+10, previous+20
+
+	pm := newTestPulseManager(t, pmOptions{loadWindow: 1})
+	left, right := jet.Children(insolar.ZeroJetID)
+	leftObj := pm.addRequests(t, left, current, 1)[0]
+	rightObj := pm.addRequests(t, right, current, 2)[0]
+	for _, bucket := range []object.IndexBucket{
+		{ObjID: leftObj, Lifeline: object.Lifeline{JetID: left}, LifelineLastUsed: current},
+		{ObjID: rightObj, Lifeline: object.Lifeline{JetID: right}, LifelineLastUsed: current},
+	} {
+		require.NoError(t, pm.indexes.SetBucket(ctx, current, bucket))
+	}
+	leftReq, rightReq := gen.ID(), gen.ID()
+	pm.pending.GetPendingStorage(ctx, insolar.ID(left)).AddPendingRequest(ctx, leftObj, leftReq)
+	pm.pending.GetPendingStorage(ctx, insolar.ID(right)).AddPendingRequest(ctx, rightObj, rightReq)
+
+	info := jetInfo{
+		id:    insolar.ZeroJetID,
+		merge: true,
+		left:  &jetInfo{id: left},
+		right: &jetInfo{id: right},
+	}
+	msg, err := pm.getMergedHotData(ctx, info, previous, current, new)
+	require.NoError(t, err)
+
+	leftDrop, err := pm.drops.ForPulse(ctx, left, current)
+	require.NoError(t, err)
+	rightDrop, err := pm.drops.ForPulse(ctx, right, current)
+	require.NoError(t, err)
+	assert.Equal(t, leftDrop, msg.Drop)
+	require.NotNil(t, msg.Sibling, "drop of the right child is sent as sibling")
+	assert.Equal(t, rightDrop, *msg.Sibling)
+	assert.Equal(t, new, msg.PulseNumber)
+
+	var objects []insolar.ID
+	for _, idx := range msg.HotIndexes {
+		if idx.Index != nil {
+			objects = append(objects, idx.ObjID)
+		}
+	}
+	assert.ElementsMatch(t, []insolar.ID{leftObj, rightObj}, objects, "indexes of both children are sent")
+	assert.Equal(t, map[insolar.ID]recentstorage.PendingObjectContext{
+		leftObj:  {Active: true, Requests: []insolar.ID{leftReq}},
+		rightObj: {Active: true, Requests: []insolar.ID{rightReq}},
+	}, msg.PendingRequests, "pending requests of both children are sent")
+}
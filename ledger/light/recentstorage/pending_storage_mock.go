@@ -25,6 +25,16 @@ type PendingStorageMock struct {
 	AddPendingRequestPreCounter uint64
 	AddPendingRequestMock       mPendingStorageMockAddPendingRequest
 
+	AddPendingRequestLimitedFunc       func(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 int, p4 int) (r int, r1 error)
+	AddPendingRequestLimitedCounter    uint64
+	AddPendingRequestLimitedPreCounter uint64
+	AddPendingRequestLimitedMock       mPendingStorageMockAddPendingRequestLimited
+
+	CountFunc       func() (r int)
+	CountCounter    uint64
+	CountPreCounter uint64
+	CountMock       mPendingStorageMockCount
+
 	GetRequestsFunc       func() (r map[insolar.ID]PendingObjectContext)
 	GetRequestsCounter    uint64
 	GetRequestsPreCounter uint64
@@ -55,6 +65,8 @@ func NewPendingStorageMock(t minimock.Tester) *PendingStorageMock {
 	}
 
 	m.AddPendingRequestMock = mPendingStorageMockAddPendingRequest{mock: m}
+	m.AddPendingRequestLimitedMock = mPendingStorageMockAddPendingRequestLimited{mock: m}
+	m.CountMock = mPendingStorageMockCount{mock: m}
 	m.GetRequestsMock = mPendingStorageMockGetRequests{mock: m}
 	m.GetRequestsForObjectMock = mPendingStorageMockGetRequestsForObject{mock: m}
 	m.RemovePendingRequestMock = mPendingStorageMockRemovePendingRequest{mock: m}
@@ -188,6 +200,294 @@ func (m *PendingStorageMock) AddPendingRequestFinished() bool {
 	return true
 }
 
+type mPendingStorageMockAddPendingRequestLimited struct {
+	mock              *PendingStorageMock
+	mainExpectation   *PendingStorageMockAddPendingRequestLimitedExpectation
+	expectationSeries []*PendingStorageMockAddPendingRequestLimitedExpectation
+}
+
+type PendingStorageMockAddPendingRequestLimitedExpectation struct {
+	input  *PendingStorageMockAddPendingRequestLimitedInput
+	result *PendingStorageMockAddPendingRequestLimitedResult
+}
+
+type PendingStorageMockAddPendingRequestLimitedInput struct {
+	p  context.Context
+	p1 insolar.ID
+	p2 insolar.ID
+	p3 int
+	p4 int
+}
+
+type PendingStorageMockAddPendingRequestLimitedResult struct {
+	r  int
+	r1 error
+}
+
+//Expect specifies that invocation of PendingStorage.AddPendingRequestLimited is expected from 1 to Infinity times
+func (m *mPendingStorageMockAddPendingRequestLimited) Expect(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 int, p4 int) *mPendingStorageMockAddPendingRequestLimited {
+	m.mock.AddPendingRequestLimitedFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PendingStorageMockAddPendingRequestLimitedExpectation{}
+	}
+	m.mainExpectation.input = &PendingStorageMockAddPendingRequestLimitedInput{p, p1, p2, p3, p4}
+	return m
+}
+
+//Return specifies results of invocation of PendingStorage.AddPendingRequestLimited
+func (m *mPendingStorageMockAddPendingRequestLimited) Return(r int, r1 error) *PendingStorageMock {
+	m.mock.AddPendingRequestLimitedFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PendingStorageMockAddPendingRequestLimitedExpectation{}
+	}
+	m.mainExpectation.result = &PendingStorageMockAddPendingRequestLimitedResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of PendingStorage.AddPendingRequestLimited is expected once
+func (m *mPendingStorageMockAddPendingRequestLimited) ExpectOnce(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 int, p4 int) *PendingStorageMockAddPendingRequestLimitedExpectation {
+	m.mock.AddPendingRequestLimitedFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &PendingStorageMockAddPendingRequestLimitedExpectation{}
+	expectation.input = &PendingStorageMockAddPendingRequestLimitedInput{p, p1, p2, p3, p4}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *PendingStorageMockAddPendingRequestLimitedExpectation) Return(r int, r1 error) {
+	e.result = &PendingStorageMockAddPendingRequestLimitedResult{r, r1}
+}
+
+//Set uses given function f as a mock of PendingStorage.AddPendingRequestLimited method
+func (m *mPendingStorageMockAddPendingRequestLimited) Set(f func(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 int, p4 int) (r int, r1 error)) *PendingStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.AddPendingRequestLimitedFunc = f
+	return m.mock
+}
+
+//AddPendingRequestLimited implements github.com/insolar/insolar/ledger/light/recentstorage.PendingStorage interface
+func (m *PendingStorageMock) AddPendingRequestLimited(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 int, p4 int) (r int, r1 error) {
+	counter := atomic.AddUint64(&m.AddPendingRequestLimitedPreCounter, 1)
+	defer atomic.AddUint64(&m.AddPendingRequestLimitedCounter, 1)
+
+	if len(m.AddPendingRequestLimitedMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.AddPendingRequestLimitedMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to PendingStorageMock.AddPendingRequestLimited. %v %v %v %v %v", p, p1, p2, p3, p4)
+			return
+		}
+
+		input := m.AddPendingRequestLimitedMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, PendingStorageMockAddPendingRequestLimitedInput{p, p1, p2, p3, p4}, "PendingStorage.AddPendingRequestLimited got unexpected parameters")
+
+		result := m.AddPendingRequestLimitedMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the PendingStorageMock.AddPendingRequestLimited")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.AddPendingRequestLimitedMock.mainExpectation != nil {
+
+		input := m.AddPendingRequestLimitedMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, PendingStorageMockAddPendingRequestLimitedInput{p, p1, p2, p3, p4}, "PendingStorage.AddPendingRequestLimited got unexpected parameters")
+		}
+
+		result := m.AddPendingRequestLimitedMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the PendingStorageMock.AddPendingRequestLimited")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.AddPendingRequestLimitedFunc == nil {
+		m.t.Fatalf("Unexpected call to PendingStorageMock.AddPendingRequestLimited. %v %v %v %v %v", p, p1, p2, p3, p4)
+		return
+	}
+
+	return m.AddPendingRequestLimitedFunc(p, p1, p2, p3, p4)
+}
+
+//AddPendingRequestLimitedMinimockCounter returns a count of PendingStorageMock.AddPendingRequestLimitedFunc invocations
+func (m *PendingStorageMock) AddPendingRequestLimitedMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.AddPendingRequestLimitedCounter)
+}
+
+//AddPendingRequestLimitedMinimockPreCounter returns the value of PendingStorageMock.AddPendingRequestLimited invocations
+func (m *PendingStorageMock) AddPendingRequestLimitedMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.AddPendingRequestLimitedPreCounter)
+}
+
+//AddPendingRequestLimitedFinished returns true if mock invocations count is ok
+func (m *PendingStorageMock) AddPendingRequestLimitedFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.AddPendingRequestLimitedMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.AddPendingRequestLimitedCounter) == uint64(len(m.AddPendingRequestLimitedMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.AddPendingRequestLimitedMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.AddPendingRequestLimitedCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.AddPendingRequestLimitedFunc != nil {
+		return atomic.LoadUint64(&m.AddPendingRequestLimitedCounter) > 0
+	}
+
+	return true
+}
+
+type mPendingStorageMockCount struct {
+	mock              *PendingStorageMock
+	mainExpectation   *PendingStorageMockCountExpectation
+	expectationSeries []*PendingStorageMockCountExpectation
+}
+
+type PendingStorageMockCountExpectation struct {
+	result *PendingStorageMockCountResult
+}
+
+type PendingStorageMockCountResult struct {
+	r int
+}
+
+//Expect specifies that invocation of PendingStorage.Count is expected from 1 to Infinity times
+func (m *mPendingStorageMockCount) Expect() *mPendingStorageMockCount {
+	m.mock.CountFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PendingStorageMockCountExpectation{}
+	}
+
+	return m
+}
+
+//Return specifies results of invocation of PendingStorage.Count
+func (m *mPendingStorageMockCount) Return(r int) *PendingStorageMock {
+	m.mock.CountFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &PendingStorageMockCountExpectation{}
+	}
+	m.mainExpectation.result = &PendingStorageMockCountResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of PendingStorage.Count is expected once
+func (m *mPendingStorageMockCount) ExpectOnce() *PendingStorageMockCountExpectation {
+	m.mock.CountFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &PendingStorageMockCountExpectation{}
+
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *PendingStorageMockCountExpectation) Return(r int) {
+	e.result = &PendingStorageMockCountResult{r}
+}
+
+//Set uses given function f as a mock of PendingStorage.Count method
+func (m *mPendingStorageMockCount) Set(f func() (r int)) *PendingStorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.CountFunc = f
+	return m.mock
+}
+
+//Count implements github.com/insolar/insolar/ledger/light/recentstorage.PendingStorage interface
+func (m *PendingStorageMock) Count() (r int) {
+	counter := atomic.AddUint64(&m.CountPreCounter, 1)
+	defer atomic.AddUint64(&m.CountCounter, 1)
+
+	if len(m.CountMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.CountMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to PendingStorageMock.Count.")
+			return
+		}
+
+		result := m.CountMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the PendingStorageMock.Count")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.CountMock.mainExpectation != nil {
+
+		result := m.CountMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the PendingStorageMock.Count")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.CountFunc == nil {
+		m.t.Fatalf("Unexpected call to PendingStorageMock.Count.")
+		return
+	}
+
+	return m.CountFunc()
+}
+
+//CountMinimockCounter returns a count of PendingStorageMock.CountFunc invocations
+func (m *PendingStorageMock) CountMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.CountCounter)
+}
+
+//CountMinimockPreCounter returns the value of PendingStorageMock.Count invocations
+func (m *PendingStorageMock) CountMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.CountPreCounter)
+}
+
+//CountFinished returns true if mock invocations count is ok
+func (m *PendingStorageMock) CountFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.CountMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.CountCounter) == uint64(len(m.CountMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.CountMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.CountCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.CountFunc != nil {
+		return atomic.LoadUint64(&m.CountCounter) > 0
+	}
+
+	return true
+}
+
 type mPendingStorageMockGetRequests struct {
 	mock              *PendingStorageMock
 	mainExpectation   *PendingStorageMockGetRequestsExpectation
@@ -727,6 +1027,14 @@ func (m *PendingStorageMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to PendingStorageMock.AddPendingRequest")
 	}
 
+	if !m.AddPendingRequestLimitedFinished() {
+		m.t.Fatal("Expected call to PendingStorageMock.AddPendingRequestLimited")
+	}
+
+	if !m.CountFinished() {
+		m.t.Fatal("Expected call to PendingStorageMock.Count")
+	}
+
 	if !m.GetRequestsFinished() {
 		m.t.Fatal("Expected call to PendingStorageMock.GetRequests")
 	}
@@ -764,6 +1072,14 @@ func (m *PendingStorageMock) MinimockFinish() {
 		m.t.Fatal("Expected call to PendingStorageMock.AddPendingRequest")
 	}
 
+	if !m.AddPendingRequestLimitedFinished() {
+		m.t.Fatal("Expected call to PendingStorageMock.AddPendingRequestLimited")
+	}
+
+	if !m.CountFinished() {
+		m.t.Fatal("Expected call to PendingStorageMock.Count")
+	}
+
 	if !m.GetRequestsFinished() {
 		m.t.Fatal("Expected call to PendingStorageMock.GetRequests")
 	}
@@ -795,6 +1111,8 @@ func (m *PendingStorageMock) MinimockWait(timeout time.Duration) {
 	for {
 		ok := true
 		ok = ok && m.AddPendingRequestFinished()
+		ok = ok && m.AddPendingRequestLimitedFinished()
+		ok = ok && m.CountFinished()
 		ok = ok && m.GetRequestsFinished()
 		ok = ok && m.GetRequestsForObjectFinished()
 		ok = ok && m.RemovePendingRequestFinished()
@@ -811,6 +1129,14 @@ func (m *PendingStorageMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to PendingStorageMock.AddPendingRequest")
 			}
 
+			if !m.AddPendingRequestLimitedFinished() {
+				m.t.Error("Expected call to PendingStorageMock.AddPendingRequestLimited")
+			}
+
+			if !m.CountFinished() {
+				m.t.Error("Expected call to PendingStorageMock.Count")
+			}
+
 			if !m.GetRequestsFinished() {
 				m.t.Error("Expected call to PendingStorageMock.GetRequests")
 			}
@@ -843,6 +1169,14 @@ func (m *PendingStorageMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.AddPendingRequestLimitedFinished() {
+		return false
+	}
+
+	if !m.CountFinished() {
+		return false
+	}
+
 	if !m.GetRequestsFinished() {
 		return false
 	}
@@ -19,9 +19,18 @@ package recentstorage
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
 	"github.com/insolar/insolar/insolar"
 )
 
+var (
+	// ErrObjectPendingLimit is returned when a limit of pending requests of an object is reached.
+	ErrObjectPendingLimit = errors.New("pending requests limit of object is reached")
+	// ErrPendingLimit is returned when a limit of pending requests of a storage is reached.
+	ErrPendingLimit = errors.New("pending requests limit is reached")
+)
+
 //go:generate minimock -i github.com/insolar/insolar/ledger/light/recentstorage.Provider -o ./ -s _mock.go
 
 // Provider provides different types of storages for a specific jet
@@ -38,10 +47,16 @@ type Provider interface {
 //go:generate minimock -i github.com/insolar/insolar/ledger/light/recentstorage.PendingStorage -o ./ -s _mock.go
 type PendingStorage interface {
 	AddPendingRequest(ctx context.Context, obj, req insolar.ID)
+	// AddPendingRequestLimited adds a request unless objectLimit requests of the object or limit requests of all
+	// objects are pending. Zero limits are not checked. Check and addition are atomic. It returns a number of pending
+	// requests of the object before the addition and ErrObjectPendingLimit or ErrPendingLimit if the request is not
+	// added.
+	AddPendingRequestLimited(ctx context.Context, obj, req insolar.ID, objectLimit, limit int) (int, error)
 	SetContextToObject(ctx context.Context, obj insolar.ID, objContext PendingObjectContext)
 
 	GetRequests() map[insolar.ID]PendingObjectContext
 	GetRequestsForObject(obj insolar.ID) []insolar.ID
+	Count() int
 
 	RemovePendingRequest(ctx context.Context, obj, req insolar.ID)
 }
@@ -19,6 +19,7 @@ package recentstorage
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/insmetrics"
@@ -77,10 +78,7 @@ func (p *RecentStorageProvider) ClonePendingStorage(ctx context.Context, fromJet
 	}
 
 	fromStorage.lock.RLock()
-	toStorage := &PendingStorageConcrete{
-		jetID:    toJetID,
-		requests: map[insolar.ID]*lockedPendingObjectContext{},
-	}
+	toStorage := NewPendingStorage(toJetID)
 	for objID, pendingContext := range fromStorage.requests {
 		if len(pendingContext.Context.Requests) == 0 {
 			continue
@@ -95,6 +93,7 @@ func (p *RecentStorageProvider) ClonePendingStorage(ctx context.Context, fromJet
 
 		clone.Requests = append(clone.Requests, pendingContext.Context.Requests...)
 		toStorage.requests[objID] = &lockedPendingObjectContext{Context: &clone}
+		toStorage.count += int64(len(clone.Requests))
 
 		pendingContext.lock.Unlock()
 	}
@@ -124,6 +123,10 @@ func (p *RecentStorageProvider) RemovePendingStorage(ctx context.Context, id ins
 
 // PendingStorageConcrete contains indexes of unclosed requests (pendings) for a specific object id
 type PendingStorageConcrete struct {
+	// count is a number of pending requests of all objects. It's changed atomically, because requests are removed
+	// under the read lock of the storage. It goes first to be aligned for atomic operations.
+	count int64
+
 	lock sync.RWMutex
 
 	jetID insolar.ID
@@ -159,6 +162,36 @@ func (r *PendingStorageConcrete) AddPendingRequest(ctx context.Context, obj, req
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
+	r.add(ctx, obj, req)
+}
+
+// AddPendingRequestLimited adds a request on object to cache if pending requests limits are not reached
+func (r *PendingStorageConcrete) AddPendingRequestLimited(
+	ctx context.Context, obj, req insolar.ID, objectLimit, limit int,
+) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	objectCount := 0
+	if objContext, ok := r.requests[obj]; ok {
+		objContext.lock.RLock()
+		objectCount = len(objContext.Context.Requests)
+		objContext.lock.RUnlock()
+	}
+
+	if objectLimit > 0 && objectCount >= objectLimit {
+		return objectCount, ErrObjectPendingLimit
+	}
+	if limit > 0 && atomic.LoadInt64(&r.count) >= int64(limit) {
+		return objectCount, ErrPendingLimit
+	}
+
+	r.add(ctx, obj, req)
+	return objectCount, nil
+}
+
+// add adds a request on object to cache. Storage lock should be held for writing.
+func (r *PendingStorageConcrete) add(ctx context.Context, obj, req insolar.ID) {
 	var objectContext *lockedPendingObjectContext
 	var ok bool
 	if objectContext, ok = r.requests[obj]; !ok {
@@ -176,6 +209,7 @@ func (r *PendingStorageConcrete) AddPendingRequest(ctx context.Context, obj, req
 	defer objectContext.lock.Unlock()
 
 	objectContext.Context.Requests = append(objectContext.Context.Requests, req)
+	atomic.AddInt64(&r.count, 1)
 
 	ctx = insmetrics.InsertTag(ctx, tagJet, r.jetID.DebugString())
 	stats.Record(ctx, statRecentStoragePendingsAdded.M(1))
@@ -186,9 +220,15 @@ func (r *PendingStorageConcrete) SetContextToObject(ctx context.Context, obj ins
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
+	if old, ok := r.requests[obj]; ok {
+		old.lock.RLock()
+		atomic.AddInt64(&r.count, -int64(len(old.Context.Requests)))
+		old.lock.RUnlock()
+	}
 	r.requests[obj] = &lockedPendingObjectContext{
 		Context: &objContext,
 	}
+	atomic.AddInt64(&r.count, int64(len(objContext.Requests)))
 	ctx = insmetrics.InsertTag(ctx, tagJet, r.jetID.DebugString())
 	stats.Record(ctx, statRecentStoragePendingsAdded.M(int64(len(objContext.Requests))))
 }
@@ -234,6 +274,11 @@ func (r *PendingStorageConcrete) GetRequestsForObject(obj insolar.ID) []insolar.
 	return results
 }
 
+// Count returns a number of pending requests of all objects in the storage
+func (r *PendingStorageConcrete) Count() int {
+	return int(atomic.LoadInt64(&r.count))
+}
+
 // RemovePendingRequest removes a request on object from cache
 func (r *PendingStorageConcrete) RemovePendingRequest(ctx context.Context, obj, req insolar.ID) {
 	r.lock.RLock()
@@ -268,6 +313,7 @@ func (r *PendingStorageConcrete) RemovePendingRequest(ctx context.Context, obj,
 		return
 	}
 
+	atomic.AddInt64(&r.count, -1)
 	if len(objContext.Context.Requests) == 1 {
 		objContext.Context.Requests = []insolar.ID{}
 		return
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 
 	"github.com/stretchr/testify/require"
@@ -81,6 +82,71 @@ func TestPendingStorage_AddPendingRequest(t *testing.T) {
 	}
 }
 
+func TestPendingStorage_Count(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+	jetID := *insolar.NewID(123, []byte{99})
+
+	s := NewPendingStorage(jetID)
+	require.Equal(t, 0, s.Count())
+
+	obj1 := *insolar.NewID(0, nil)
+	obj2 := *insolar.NewID(1, nil)
+	s.AddPendingRequest(ctx, obj1, *insolar.NewID(123, []byte{1}))
+	s.AddPendingRequest(ctx, obj1, *insolar.NewID(123, []byte{2}))
+	s.AddPendingRequest(ctx, obj2, *insolar.NewID(123, []byte{3}))
+	require.Equal(t, 3, s.Count())
+
+	s.RemovePendingRequest(ctx, obj1, *insolar.NewID(123, []byte{2}))
+	require.Equal(t, 2, s.Count())
+	s.RemovePendingRequest(ctx, obj1, *insolar.NewID(123, []byte{4}))
+	require.Equal(t, 2, s.Count(), "missing request isn't counted")
+
+	s.SetContextToObject(ctx, obj2, PendingObjectContext{Requests: []insolar.ID{
+		*insolar.NewID(123, []byte{5}), *insolar.NewID(123, []byte{6}),
+	}})
+	require.Equal(t, 3, s.Count())
+
+	provider := NewRecentStorageProvider()
+	from, to := gen.ID(), gen.ID()
+	provider.GetPendingStorage(ctx, from).AddPendingRequest(ctx, obj1, *insolar.NewID(123, []byte{7}))
+	provider.ClonePendingStorage(ctx, from, to)
+	require.Equal(t, 1, provider.GetPendingStorage(ctx, to).Count())
+}
+
+func TestPendingStorage_AddPendingRequestLimited(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+	jetID := *insolar.NewID(123, []byte{99})
+
+	s := NewPendingStorage(jetID)
+	obj1 := *insolar.NewID(0, nil)
+	obj2 := *insolar.NewID(1, nil)
+
+	// Concurrent requests don't exceed the limit.
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			_, _ = s.AddPendingRequestLimited(ctx, obj1, *insolar.NewID(123, []byte{byte(i)}), 3, 0)
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+	require.Len(t, s.GetRequestsForObject(obj1), 3)
+
+	count, err := s.AddPendingRequestLimited(ctx, obj1, *insolar.NewID(123, []byte{20}), 3, 0)
+	require.Equal(t, ErrObjectPendingLimit, err)
+	require.Equal(t, 3, count)
+
+	count, err = s.AddPendingRequestLimited(ctx, obj2, *insolar.NewID(123, []byte{21}), 3, 4)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	_, err = s.AddPendingRequestLimited(ctx, obj2, *insolar.NewID(123, []byte{22}), 3, 4)
+	require.Equal(t, ErrPendingLimit, err)
+	require.Equal(t, 4, s.Count())
+}
+
 func TestPendingStorage_RemovePendingRequest(t *testing.T) {
 	t.Parallel()
 	ctx := inslogger.TestContext(t)
@@ -207,6 +273,22 @@ func TestPendingStorageConcrete_GetRequestsForObject_NoObject(t *testing.T) {
 	require.Nil(t, requests)
 }
 
+func TestPendingStorage_AddPendingRequest_NewObjectIsActive(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+
+	s := NewPendingStorage(*insolar.NewID(123, []byte{99}))
+	obj := *insolar.NewID(123, []byte{1})
+	req := *insolar.NewID(123, []byte{2})
+
+	s.AddPendingRequest(ctx, obj, req)
+	require.Equal(t, true, s.GetRequests()[obj].Active)
+
+	s.AddPendingRequest(ctx, obj, *insolar.NewID(124, []byte{3}))
+	s.RemovePendingRequest(ctx, obj, req)
+	require.Equal(t, true, s.GetRequests()[obj].Active)
+}
+
 func TestPendingStorageConcrete_SetContextToObject(t *testing.T) {
 	t.Parallel()
 
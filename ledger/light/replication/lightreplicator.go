@@ -20,13 +20,20 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/jet"
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
-	"go.opencensus.io/stats"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/utils/backoff"
 )
 
 // LightReplicator is a base interface for a sync component
@@ -35,6 +42,15 @@ type LightReplicator interface {
 	NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber)
 }
 
+// ConfirmedStorage keeps the last pulses confirmed by all the heavy replicas for jets, so confirmed data isn't
+// replicated again after a restart of the node.
+type ConfirmedStorage interface {
+	// SetConfirmed saves the last confirmed pulse of a jet.
+	SetConfirmed(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) error
+	// Confirmed returns the last confirmed pulses of all the saved jets.
+	Confirmed(ctx context.Context) (map[insolar.JetID]insolar.PulseNumber, error)
+}
+
 // LightReplicatorDefault is a base impl of LightReplicator
 type LightReplicatorDefault struct {
 	once sync.Once
@@ -45,7 +61,19 @@ type LightReplicatorDefault struct {
 	dataGatherer    DataGatherer
 	cleaner         Cleaner
 	msgBus          insolar.MessageBus
+	jetCoordinator  jet.Coordinator
 	pulseCalculator pulse.Calculator
+	backoff         configuration.Backoff
+
+	lock sync.RWMutex
+	// confirmed holds the last pulse confirmed by all the heavy replicas for every jet.
+	confirmed map[insolar.JetID]insolar.PulseNumber
+	// accepted holds the last pulse accepted by every heavy replica for jets, that aren't confirmed yet.
+	accepted map[insolar.JetID]map[insolar.Reference]insolar.PulseNumber
+	// synced is a pulse, all the pulses before which (including itself) are confirmed by a heavy.
+	synced insolar.PulseNumber
+	// storage persists confirmed pulses. It's nil if confirmations are kept in memory only.
+	storage ConfirmedStorage
 }
 
 // NewReplicatorDefault creates new instance of LightReplicator
@@ -54,14 +82,20 @@ func NewReplicatorDefault(
 	dataGatherer DataGatherer,
 	cleaner Cleaner,
 	msgBus insolar.MessageBus,
+	jetCoordinator jet.Coordinator,
 	calculator pulse.Calculator,
+	backoff configuration.Backoff,
 ) *LightReplicatorDefault {
 	return &LightReplicatorDefault{
 		jetCalculator:     jetCalculator,
 		dataGatherer:      dataGatherer,
 		cleaner:           cleaner,
 		msgBus:            msgBus,
+		jetCoordinator:    jetCoordinator,
 		pulseCalculator:   calculator,
+		backoff:           backoff,
+		confirmed:         map[insolar.JetID]insolar.PulseNumber{},
+		accepted:          map[insolar.JetID]map[insolar.Reference]insolar.PulseNumber{},
 		syncWaitingPulses: make(chan insolar.PulseNumber),
 	}
 }
@@ -70,8 +104,8 @@ func NewReplicatorDefault(
 // When it's called, a provided pulse is added to a channel.
 // There is a special gorutine that is reading that channel. When a new pulse is being received,
 // the routine starts to gather data (with using of LightDataGatherer). After gathering all the data,
-// it attempts to send it to the heavy. After sending a heavy payload to a heavy, data is deleted
-// with help of Cleaner
+// it attempts to send it to all the heavy replicas. After all the jets of a pulse are confirmed by all the heavies,
+// data is deleted with help of Cleaner
 func (t *LightReplicatorDefault) NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber) {
 	t.once.Do(func() {
 		go t.sync(ctx)
@@ -90,39 +124,244 @@ func (t *LightReplicatorDefault) NotifyAboutPulse(ctx context.Context, pn insola
 	t.syncWaitingPulses <- prevPN.PulseNumber
 }
 
+// RestoreConfirmed loads confirmed pulses of jets from the storage and saves new confirmations to it. It should be
+// called before the first pulse is received.
+func (t *LightReplicatorDefault) RestoreConfirmed(ctx context.Context, storage ConfirmedStorage) error {
+	confirmed, err := storage.Confirmed(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to restore confirmed pulses")
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for jetID, pn := range confirmed {
+		t.confirmed[jetID] = pn
+	}
+	t.storage = storage
+	return nil
+}
+
+// LastConfirmed returns the last pulse confirmed by a heavy for a provided jet.
+func (t *LightReplicatorDefault) LastConfirmed(jetID insolar.JetID) (insolar.PulseNumber, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	pn, ok := t.confirmed[jetID]
+	return pn, ok
+}
+
 func (t *LightReplicatorDefault) sync(ctx context.Context) {
 	logger := inslogger.FromContext(ctx)
 	for pn := range t.syncWaitingPulses {
 		logger.Debugf("[Replicator][sync] pn received - %v", pn)
 
-		jets := t.jetCalculator.MineForPulse(ctx, pn)
-		logger.Debugf("[Replicator][sync] founds %v jets", len(jets))
-		for _, jID := range jets {
-			msg, err := t.dataGatherer.ForPulseAndJet(ctx, pn, jID)
-			if err != nil {
-				panic(
-					fmt.Sprintf(
-						"[Replicator][sync] Problems with gather data for a pulse - %v and jet - %v. err - %v",
-						pn,
-						jID.DebugString(),
-						err,
-					),
-				)
+		// Pulses are replicated strictly in order. Every pulse, that hasn't been confirmed yet
+		// (because of a heavy failure or a restart of the node), is replicated before the received one.
+		for _, unsynced := range t.unsyncedPulses(ctx, pn) {
+			if !t.syncPulse(ctx, unsynced, pn) {
+				logger.Errorf("[Replicator][sync] pulse %v isn't confirmed by a heavy, will retry on the next pulse", unsynced)
+				break
+			}
+
+			t.lock.Lock()
+			t.synced = unsynced
+			t.lock.Unlock()
+
+			t.cleaner.NotifyAboutPulse(ctx, unsynced)
+		}
+	}
+}
+
+// unsyncedPulses returns known pulses after the last synced one up to the provided pulse in ascending order.
+func (t *LightReplicatorDefault) unsyncedPulses(ctx context.Context, pn insolar.PulseNumber) []insolar.PulseNumber {
+	t.lock.RLock()
+	synced := t.synced
+	t.lock.RUnlock()
+
+	if pn <= synced {
+		return nil
+	}
+
+	pulses := []insolar.PulseNumber{pn}
+	for current := pn; ; {
+		prev, err := t.pulseCalculator.Backwards(ctx, current, 1)
+		if err == pulse.ErrNotFound {
+			break
+		}
+		if err != nil {
+			inslogger.FromContext(ctx).Error("[Replicator][unsyncedPulses] failed to calculate pulse", err)
+			break
+		}
+		if prev.PulseNumber <= synced {
+			break
+		}
+		current = prev.PulseNumber
+		pulses = append(pulses, current)
+	}
+
+	for i, j := 0, len(pulses)-1; i < j; i, j = i+1, j-1 {
+		pulses[i], pulses[j] = pulses[j], pulses[i]
+	}
+	return pulses
+}
+
+// syncPulse replicates all the jets of a pulse, that aren't confirmed yet. It returns true if all the jets are confirmed.
+func (t *LightReplicatorDefault) syncPulse(ctx context.Context, pn insolar.PulseNumber, latest insolar.PulseNumber) bool {
+	logger := inslogger.FromContext(ctx)
+
+	jets := t.jetCalculator.MineForPulse(ctx, pn)
+	logger.Debugf("[Replicator][sync] founds %v jets", len(jets))
+	if len(jets) == 0 {
+		return true
+	}
+
+	heavies, err := t.jetCoordinator.Heavies(ctx, latest)
+	if err != nil {
+		logger.Error("[Replicator][sync] Problems with calculating heavy nodes", err)
+		return false
+	}
+
+	ok := true
+	for _, jID := range jets {
+		if confirmed, found := t.LastConfirmed(jID); found && confirmed >= pn {
+			continue
+		}
+
+		msg, err := t.dataGatherer.ForPulseAndJet(ctx, pn, jID)
+		if errors.Cause(err) == drop.ErrNotFound {
+			logger.Warnf("[Replicator][sync] No data for a pulse - %v and jet - %v. Skipping", pn, jID.DebugString())
+			continue
+		}
+		if err != nil {
+			panic(
+				fmt.Sprintf(
+					"[Replicator][sync] Problems with gather data for a pulse - %v and jet - %v. err - %v",
+					pn,
+					jID.DebugString(),
+					err,
+				),
+			)
+		}
+
+		missing := t.notAccepted(jID, pn, heavies)
+		accepted := t.sendToReplicas(ctx, msg, missing)
+		t.lock.Lock()
+		if t.accepted[jID] == nil {
+			t.accepted[jID] = map[insolar.Reference]insolar.PulseNumber{}
+		}
+		for _, heavy := range accepted {
+			t.accepted[jID][heavy] = pn
+		}
+		if len(accepted) == len(missing) {
+			t.confirmed[jID] = pn
+			delete(t.accepted, jID)
+		}
+		storage := t.storage
+		t.lock.Unlock()
+
+		if storage != nil && len(accepted) == len(missing) {
+			if err := storage.SetConfirmed(ctx, jID, pn); err != nil {
+				logger.Error(errors.Wrapf(err, "[Replicator][sync] failed to save confirmed pulse %v of jet %v", pn, jID.DebugString()))
 			}
-			err = t.sendToHeavy(ctx, msg)
+		}
+
+		if len(accepted) < len(missing) {
+			logger.Errorf("[Replicator][sync]  Data is accepted by %v of %v heavy nodes, pn - %v, jetID - %v",
+				len(heavies)-len(missing)+len(accepted), len(heavies), pn, jID.DebugString())
+			ok = false
+		} else {
+			logger.Debugf("[Replicator][sync]  Data has been sent to heavies. pn - %v, jetID - %v", msg.PulseNum, msg.JetID.DebugString())
+		}
+
+		confirmed, found := t.LastConfirmed(jID)
+		if !found {
+			confirmed = pn
+		}
+		jetCtx := insmetrics.InsertTag(ctx, tagJet, jID.DebugString())
+		stats.Record(jetCtx, statHeavySyncLag.M(int64(latest)-int64(confirmed)))
+	}
+
+	return ok
+}
+
+// notAccepted returns the heavy replicas, that haven't accepted a payload of a provided pulse and jet yet.
+func (t *LightReplicatorDefault) notAccepted(
+	jetID insolar.JetID, pn insolar.PulseNumber, heavies []insolar.Reference,
+) []insolar.Reference {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	var missing []insolar.Reference
+	for _, heavy := range heavies {
+		if accepted, ok := t.accepted[jetID][heavy]; ok && accepted >= pn {
+			continue
+		}
+		missing = append(missing, heavy)
+	}
+	return missing
+}
+
+// sendToReplicas sends a heavy payload to the replicas concurrently and returns the replicas, that have accepted it.
+// A jet is confirmed only when all the replicas have accepted its payload, so data isn't cleaned until it is stored
+// on every replica. Replicas, that have failed, get the payload again on the next pulse before any later payload.
+func (t *LightReplicatorDefault) sendToReplicas(
+	ctx context.Context, msg insolar.Message, heavies []insolar.Reference,
+) []insolar.Reference {
+	results := make(chan insolar.Reference, len(heavies))
+	for _, heavy := range heavies {
+		go func(heavy insolar.Reference) {
+			err := t.sendWithRetries(ctx, msg, heavy)
 			if err != nil {
-				logger.Errorf("[Replicator][sync]  Problems with sending msg to a heavy node", err)
-			} else {
-				logger.Debugf("[Replicator][sync]  Data has been sent to a heavy. pn - %v, jetID - %v", msg.PulseNum, msg.JetID.DebugString())
+				inslogger.FromContext(ctx).Errorf(
+					"[Replicator][sync]  Problems with sending msg to a heavy node %v: %v", heavy, err,
+				)
+				results <- insolar.Reference{}
+				return
 			}
+			results <- heavy
+		}(heavy)
+	}
+
+	var accepted []insolar.Reference
+	for range heavies {
+		heavy := <-results
+		if heavy.IsEmpty() {
+			continue
+		}
+		accepted = append(accepted, heavy)
+	}
+	return accepted
+}
+
+// sendWithRetries sends a heavy payload to a heavy until it is confirmed or attempts are exhausted.
+func (t *LightReplicatorDefault) sendWithRetries(ctx context.Context, msg insolar.Message, heavy insolar.Reference) error {
+	bo := backoff.Backoff{
+		Factor: t.backoff.Factor,
+		Jitter: t.backoff.Jitter,
+		Min:    t.backoff.Min,
+		Max:    t.backoff.Max,
+	}
+
+	for {
+		err := t.sendToHeavy(ctx, msg, heavy)
+		if err == nil {
+			return nil
+		}
+		if bo.Attempt()+1 >= t.backoff.MaxAttempts {
+			return err
 		}
 
-		t.cleaner.NotifyAboutPulse(ctx, pn)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(bo.Duration()):
+		}
 	}
 }
 
-func (t *LightReplicatorDefault) sendToHeavy(ctx context.Context, data insolar.Message) error {
-	rep, err := t.msgBus.Send(ctx, data, nil)
+func (t *LightReplicatorDefault) sendToHeavy(ctx context.Context, data insolar.Message, heavy insolar.Reference) error {
+	rep, err := t.msgBus.Send(ctx, data, &insolar.MessageSendOptions{Receiver: &heavy})
 	if err != nil {
 		stats.Record(ctx,
 			statErrHeavyPayloadCount.M(1),
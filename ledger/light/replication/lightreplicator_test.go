@@ -17,11 +17,14 @@
 package replication
 
 import (
+	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/gojuno/minimock"
+	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/insolar/jet"
@@ -29,6 +32,7 @@ import (
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
 	"github.com/insolar/insolar/testutils"
 	"github.com/stretchr/testify/require"
 )
@@ -40,7 +44,9 @@ func TestNewReplicatorDefault(t *testing.T) {
 		NewDataGathererMock(t),
 		NewCleanerMock(t),
 		testutils.NewMessageBusMock(t),
+		jet.NewCoordinatorMock(t),
 		pulse.NewCalculatorMock(t),
+		configuration.Backoff{},
 	)
 	defer close(r.syncWaitingPulses)
 
@@ -48,6 +54,7 @@ func TestNewReplicatorDefault(t *testing.T) {
 	require.NotNil(t, r.dataGatherer)
 	require.NotNil(t, r.cleaner)
 	require.NotNil(t, r.msgBus)
+	require.NotNil(t, r.jetCoordinator)
 	require.NotNil(t, r.pulseCalculator)
 	require.NotNil(t, r.syncWaitingPulses)
 }
@@ -60,7 +67,7 @@ func TestLightReplicator_sendToHeavy(t *testing.T) {
 		msgBus: mb,
 	}
 
-	res := r.sendToHeavy(inslogger.TestContext(t), nil)
+	res := r.sendToHeavy(inslogger.TestContext(t), nil, gen.Reference())
 
 	require.Nil(t, res)
 }
@@ -73,7 +80,7 @@ func TestLightReplicator_sendToHeavy_ErrReturned(t *testing.T) {
 		msgBus: mb,
 	}
 
-	res := r.sendToHeavy(inslogger.TestContext(t), nil)
+	res := r.sendToHeavy(inslogger.TestContext(t), nil, gen.Reference())
 
 	require.Equal(t, res, errors.New("expected"))
 }
@@ -87,7 +94,7 @@ func TestLightReplicator_sendToHeavy_HeavyErr(t *testing.T) {
 		msgBus: mb,
 	}
 
-	res := r.sendToHeavy(inslogger.TestContext(t), nil)
+	res := r.sendToHeavy(inslogger.TestContext(t), nil, gen.Reference())
 
 	require.Equal(t, &heavyErr, res)
 }
@@ -101,12 +108,16 @@ func TestLightReplicatorDefault_sync(t *testing.T) {
 	mb := testutils.NewMessageBusMock(ctrl)
 	pc := pulse.NewCalculatorMock(ctrl)
 	dg := NewDataGathererMock(ctrl)
+	hc := jet.NewCoordinatorMock(ctrl)
+	hc.HeaviesMock.Return([]insolar.Reference{gen.Reference()}, nil)
 	r := NewReplicatorDefault(
 		jc,
 		dg,
 		c,
 		mb,
+		hc,
 		pc,
+		configuration.Backoff{},
 	)
 	defer close(r.syncWaitingPulses)
 
@@ -117,6 +128,7 @@ func TestLightReplicatorDefault_sync(t *testing.T) {
 	}
 
 	jetID := gen.JetID()
+	pc.BackwardsMock.Expect(ctx, pn, 1).Return(insolar.Pulse{}, pulse.ErrNotFound)
 	jc.MineForPulseMock.Expect(ctx, pn).Return([]insolar.JetID{jetID})
 	dg.ForPulseAndJetMock.Return(&msg, nil)
 	mb.SendMock.Return(&reply.OK{}, nil)
@@ -140,15 +152,25 @@ func TestLightReplicatorDefault_NotifyAboutPulse(t *testing.T) {
 	inputPN := gen.PulseNumber()
 	expectedPN := gen.PulseNumber()
 
+	hc := jet.NewCoordinatorMock(ctrl)
+	hc.HeaviesMock.Return([]insolar.Reference{gen.Reference()}, nil)
 	pc := pulse.NewCalculatorMock(ctrl)
-	pc.BackwardsMock.Expect(ctx, inputPN, 1).Return(insolar.Pulse{PulseNumber: expectedPN}, nil)
+	pc.BackwardsMock.Set(func(_ context.Context, pn insolar.PulseNumber, steps int) (insolar.Pulse, error) {
+		require.Equal(t, 1, steps)
+		if pn == inputPN {
+			return insolar.Pulse{PulseNumber: expectedPN}, nil
+		}
+		return insolar.Pulse{}, pulse.ErrNotFound
+	})
 	dg := NewDataGathererMock(ctrl)
 	r := NewReplicatorDefault(
 		jc,
 		dg,
 		c,
 		mb,
+		hc,
 		pc,
+		configuration.Backoff{},
 	)
 	defer close(r.syncWaitingPulses)
 
@@ -168,3 +190,205 @@ func TestLightReplicatorDefault_NotifyAboutPulse(t *testing.T) {
 	ctrl.Wait(time.Minute)
 	ctrl.Finish()
 }
+
+func TestLightReplicatorDefault_sync_CatchUp(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+	jc := jet.NewCalculatorMock(t)
+	c := NewCleanerMock(t)
+	mb := testutils.NewMessageBusMock(t)
+	pc := pulse.NewCalculatorMock(t)
+	dg := NewDataGathererMock(t)
+	hc := jet.NewCoordinatorMock(t)
+	hc.HeaviesMock.Return([]insolar.Reference{gen.Reference()}, nil)
+	r := NewReplicatorDefault(
+		jc,
+		dg,
+		c,
+		mb,
+		hc,
+		pc,
+		configuration.Backoff{Min: time.Millisecond, Max: time.Millisecond, MaxAttempts: 2},
+	)
+
+	first := gen.PulseNumber()
+	second := first + 10
+	jetID := gen.JetID()
+
+	pc.BackwardsMock.Set(func(_ context.Context, pn insolar.PulseNumber, steps int) (insolar.Pulse, error) {
+		if pn == second {
+			return insolar.Pulse{PulseNumber: first}, nil
+		}
+		return insolar.Pulse{}, pulse.ErrNotFound
+	})
+	jc.MineForPulseMock.Return([]insolar.JetID{jetID})
+	dg.ForPulseAndJetMock.Set(func(_ context.Context, pn insolar.PulseNumber, jetID insolar.JetID) (*message.HeavyPayload, error) {
+		return &message.HeavyPayload{JetID: jetID, PulseNum: pn}, nil
+	})
+
+	var sent []insolar.PulseNumber
+	mb.SendMock.Set(func(_ context.Context, msg insolar.Message, _ *insolar.MessageSendOptions) (insolar.Reply, error) {
+		sent = append(sent, msg.(*message.HeavyPayload).PulseNum)
+		// Heavy is unavailable for the whole first round.
+		if len(sent) <= 2 {
+			return nil, errors.New("heavy is unavailable")
+		}
+		return &reply.OK{}, nil
+	})
+
+	var cleaned []insolar.PulseNumber
+	c.NotifyAboutPulseMock.Set(func(_ context.Context, pn insolar.PulseNumber) {
+		cleaned = append(cleaned, pn)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		r.sync(ctx)
+		close(done)
+	}()
+
+	r.syncWaitingPulses <- first
+	r.syncWaitingPulses <- second
+	close(r.syncWaitingPulses)
+	<-done
+
+	require.Equal(t, []insolar.PulseNumber{first, first, first, second}, sent)
+	require.Equal(t, []insolar.PulseNumber{first, second}, cleaned)
+	confirmed, ok := r.LastConfirmed(jetID)
+	require.True(t, ok)
+	require.Equal(t, second, confirmed)
+}
+
+func TestLightReplicatorDefault_syncPulse(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+	pn := gen.PulseNumber()
+	jetID := gen.JetID()
+
+	t.Run("already confirmed jet is skipped", func(t *testing.T) {
+		jc := jet.NewCalculatorMock(t)
+		jc.MineForPulseMock.Return([]insolar.JetID{jetID})
+		hc := jet.NewCoordinatorMock(t)
+		hc.HeaviesMock.Return([]insolar.Reference{gen.Reference()}, nil)
+		r := NewReplicatorDefault(jc, nil, nil, nil, hc, nil, configuration.Backoff{})
+		r.confirmed[jetID] = pn
+
+		require.True(t, r.syncPulse(ctx, pn, pn))
+	})
+
+	t.Run("jet without drop is skipped", func(t *testing.T) {
+		jc := jet.NewCalculatorMock(t)
+		jc.MineForPulseMock.Return([]insolar.JetID{jetID})
+		dg := NewDataGathererMock(t)
+		dg.ForPulseAndJetMock.Return(nil, drop.ErrNotFound)
+		hc := jet.NewCoordinatorMock(t)
+		hc.HeaviesMock.Return([]insolar.Reference{gen.Reference()}, nil)
+		r := NewReplicatorDefault(jc, dg, nil, nil, hc, nil, configuration.Backoff{})
+
+		require.True(t, r.syncPulse(ctx, pn, pn))
+		_, ok := r.LastConfirmed(jetID)
+		require.False(t, ok)
+	})
+
+	t.Run("heavy error isn't confirmed", func(t *testing.T) {
+		jc := jet.NewCalculatorMock(t)
+		jc.MineForPulseMock.Return([]insolar.JetID{jetID})
+		dg := NewDataGathererMock(t)
+		dg.ForPulseAndJetMock.Return(&message.HeavyPayload{JetID: jetID, PulseNum: pn}, nil)
+		mb := testutils.NewMessageBusMock(t)
+		mb.SendMock.Return(&reply.HeavyError{JetID: jetID, PulseNum: pn}, nil)
+		hc := jet.NewCoordinatorMock(t)
+		hc.HeaviesMock.Return([]insolar.Reference{gen.Reference()}, nil)
+		r := NewReplicatorDefault(jc, dg, nil, mb, hc, nil, configuration.Backoff{
+			Min:         time.Millisecond,
+			Max:         time.Millisecond,
+			MaxAttempts: 3,
+		})
+
+		require.False(t, r.syncPulse(ctx, pn, pn))
+		require.Equal(t, uint64(3), mb.SendCounter)
+		_, ok := r.LastConfirmed(jetID)
+		require.False(t, ok)
+	})
+
+	t.Run("jet is confirmed when all the replicas accept it", func(t *testing.T) {
+		jc := jet.NewCalculatorMock(t)
+		jc.MineForPulseMock.Return([]insolar.JetID{jetID})
+		dg := NewDataGathererMock(t)
+		dg.ForPulseAndJetMock.Return(&message.HeavyPayload{JetID: jetID, PulseNum: pn}, nil)
+		primary, first, second := gen.Reference(), gen.Reference(), gen.Reference()
+		hc := jet.NewCoordinatorMock(t)
+		hc.HeaviesMock.Expect(ctx, pn+1).Return([]insolar.Reference{primary, first, second}, nil)
+
+		var lock sync.Mutex
+		failed := map[insolar.Reference]bool{first: true, second: true}
+		sent := map[insolar.Reference]int{}
+		mb := testutils.NewMessageBusMock(t)
+		mb.SendMock.Set(func(_ context.Context, _ insolar.Message, o *insolar.MessageSendOptions) (insolar.Reply, error) {
+			lock.Lock()
+			defer lock.Unlock()
+			sent[*o.Receiver]++
+			if failed[*o.Receiver] {
+				return nil, errors.New("replica is unavailable")
+			}
+			return &reply.OK{}, nil
+		})
+		r := NewReplicatorDefault(jc, dg, nil, mb, hc, nil, configuration.Backoff{MaxAttempts: 1})
+
+		require.False(t, r.syncPulse(ctx, pn, pn+1))
+		_, ok := r.LastConfirmed(jetID)
+		require.False(t, ok)
+
+		lock.Lock()
+		failed[first] = false
+		lock.Unlock()
+		require.False(t, r.syncPulse(ctx, pn, pn+1))
+		_, ok = r.LastConfirmed(jetID)
+		require.False(t, ok)
+
+		lock.Lock()
+		failed[second] = false
+		lock.Unlock()
+		require.True(t, r.syncPulse(ctx, pn, pn+1))
+		confirmed, ok := r.LastConfirmed(jetID)
+		require.True(t, ok)
+		require.Equal(t, pn, confirmed)
+		require.Equal(t, map[insolar.Reference]int{primary: 1, first: 2, second: 3}, sent)
+	})
+	t.Run("confirmations are restored and saved", func(t *testing.T) {
+		restoredJet, newJet := gen.JetID(), gen.JetID()
+		storage := &confirmedStorage{confirmed: map[insolar.JetID]insolar.PulseNumber{restoredJet: pn}}
+
+		jc := jet.NewCalculatorMock(t)
+		jc.MineForPulseMock.Return([]insolar.JetID{restoredJet, newJet})
+		dg := NewDataGathererMock(t)
+		dg.ForPulseAndJetMock.Expect(ctx, pn, newJet).Return(&message.HeavyPayload{JetID: newJet, PulseNum: pn}, nil)
+		mb := testutils.NewMessageBusMock(t)
+		mb.SendMock.Return(&reply.OK{}, nil)
+		hc := jet.NewCoordinatorMock(t)
+		hc.HeaviesMock.Return([]insolar.Reference{gen.Reference()}, nil)
+		r := NewReplicatorDefault(jc, dg, nil, mb, hc, nil, configuration.Backoff{MaxAttempts: 1})
+		require.NoError(t, r.RestoreConfirmed(ctx, storage))
+
+		require.True(t, r.syncPulse(ctx, pn, pn))
+		require.Equal(t, uint64(1), mb.SendCounter, "restored jet isn't sent again")
+		require.Equal(t, map[insolar.JetID]insolar.PulseNumber{restoredJet: pn, newJet: pn}, storage.confirmed)
+	})
+}
+
+type confirmedStorage struct {
+	confirmed map[insolar.JetID]insolar.PulseNumber
+}
+
+func (s *confirmedStorage) SetConfirmed(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) error {
+	s.confirmed[jetID] = pn
+	return nil
+}
+
+func (s *confirmedStorage) Confirmed(ctx context.Context) (map[insolar.JetID]insolar.PulseNumber, error) {
+	res := map[insolar.JetID]insolar.PulseNumber{}
+	for jetID, pn := range s.confirmed {
+		res[jetID] = pn
+	}
+	return res, nil
+}
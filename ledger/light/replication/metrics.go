@@ -19,6 +19,13 @@ package replication
 import (
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+var (
+	tagJet = insmetrics.MustTagKey("jet")
 )
 
 var (
@@ -32,6 +39,11 @@ var (
 		"How many heavy-payload messages were failed",
 		stats.UnitDimensionless,
 	)
+	statHeavySyncLag = stats.Int64(
+		"lightsyncer/lag",
+		"Difference between the latest pulse and the last pulse confirmed by a heavy node for a jet",
+		stats.UnitDimensionless,
+	)
 )
 
 func init() {
@@ -48,6 +60,13 @@ func init() {
 			Measure:     statErrHeavyPayloadCount,
 			Aggregation: view.Count(),
 		},
+		&view.View{
+			Name:        statHeavySyncLag.Name(),
+			Description: statHeavySyncLag.Description(),
+			Measure:     statHeavySyncLag,
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{tagJet},
+		},
 	)
 	if err != nil {
 		panic(err)
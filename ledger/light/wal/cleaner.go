@@ -0,0 +1,45 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wal
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/light/replication"
+)
+
+type cleaner struct {
+	log  *Log
+	next replication.Cleaner
+}
+
+// Cleaner returns a cleaner, that truncates the log when a pulse is confirmed by heavy and notifies provided
+// cleaner after that.
+func (l *Log) Cleaner(next replication.Cleaner) replication.Cleaner {
+	return &cleaner{log: l, next: next}
+}
+
+func (c *cleaner) NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber) {
+	if err := c.log.Truncate(ctx, pn); err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "[WAL] failed to truncate log"))
+	}
+	c.next.NotifyAboutPulse(ctx, pn)
+}
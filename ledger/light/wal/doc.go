@@ -0,0 +1,27 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package wal provides a write-ahead log of light node hot data.
+//
+// Storages of hot data are wrapped by the log, so every change is written to the log before it's applied. On start
+// the log is replayed into empty storages. Jets of replayed drops are marked as actual, so not replicated pulses are
+// sent to heavy again. Entries of a pulse are removed from the log after heavy confirms the pulse.
+//
+// Pending requests are not bound to a pulse, so the log keeps their latest state instead of changes.
+//
+// The log also keeps the last pulses of jets confirmed by all the heavy replicas, so a restarted node doesn't send
+// confirmed jets of partially replicated pulses again.
+package wal
@@ -0,0 +1,380 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wal
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/node"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/light/recentstorage"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+type entryKind uint8
+
+const (
+	kindRecord entryKind = iota + 1
+	kindBlob
+	kindLifeline
+	kindBucket
+	kindLifelineUsage
+	kindDrop
+	kindPulse
+	kindNodes
+)
+
+// entry is a single change of hot data. Only fields of its kind are set.
+type entry struct {
+	Kind  entryKind
+	ID    insolar.ID
+	Pulse insolar.PulseNumber
+
+	Record    []byte
+	Lifeline  []byte
+	Bucket    []byte
+	Blob      *blob.Blob
+	Drop      *drop.Drop
+	PulseData *insolar.Pulse
+	Nodes     []insolar.Node
+}
+
+type entryKey struct {
+	pn  insolar.PulseNumber
+	seq uint64
+}
+
+func (k entryKey) Scope() store.Scope {
+	return store.ScopeWAL
+}
+
+func (k entryKey) ID() []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, k.seq)
+	return append(k.pn.Bytes(), buf...)
+}
+
+type pendingKey struct {
+	jetID insolar.ID
+	obj   insolar.ID
+}
+
+func (k pendingKey) Scope() store.Scope {
+	return store.ScopeWALPending
+}
+
+func (k pendingKey) ID() []byte {
+	return append(k.jetID.Bytes(), k.obj.Bytes()...)
+}
+
+type confirmedKey insolar.JetID
+
+func (k confirmedKey) Scope() store.Scope {
+	return store.ScopeWALConfirmed
+}
+
+func (k confirmedKey) ID() []byte {
+	return k[:]
+}
+
+// Storages are hot data storages the log is replayed into.
+type Storages struct {
+	Records    object.RecordModifier
+	Blobs      blob.Modifier
+	Lifelines  object.LifelineModifier
+	Buckets    object.IndexBucketModifier
+	IndexState object.LifelineStateModifier
+	Drops      drop.Modifier
+	Pulses     pulse.Appender
+	Nodes      node.Modifier
+	Jets       jet.Modifier
+	Pendings   recentstorage.Provider
+}
+
+// Log is a write-ahead log of light node hot data.
+type Log struct {
+	db  store.DB
+	seq uint64
+}
+
+// NewLog creates a log on top of provided db. Entries of the log are appended after the existing ones.
+func NewLog(db store.DB) *Log {
+	l := &Log{db: db}
+
+	it := db.NewIterator(store.ScopeWAL, nil)
+	for it.Next() {
+		id := it.ID()
+		seq := binary.BigEndian.Uint64(id[len(id)-8:])
+		if seq > l.seq {
+			l.seq = seq
+		}
+	}
+	it.Close()
+
+	return l
+}
+
+func (l *Log) append(pn insolar.PulseNumber, e entry) error {
+	e.Pulse = pn
+	var buf bytes.Buffer
+	enc := codec.NewEncoder(&buf, &codec.CborHandle{})
+	if err := enc.Encode(e); err != nil {
+		return errors.Wrap(err, "failed to encode wal entry")
+	}
+
+	key := entryKey{pn: pn, seq: atomic.AddUint64(&l.seq, 1)}
+	return errors.Wrap(l.db.Set(key, buf.Bytes()), "failed to write wal entry")
+}
+
+// Replay applies all entries of the log to provided storages in order they were written. Entries, that are already
+// applied, are skipped.
+func (l *Log) Replay(ctx context.Context, s Storages) error {
+	logger := inslogger.FromContext(ctx)
+
+	var entries [][]byte
+	it := l.db.NewIterator(store.ScopeWAL, nil)
+	for it.Next() {
+		buf, err := it.Value()
+		if err != nil {
+			it.Close()
+			return errors.Wrap(err, "failed to read wal entry")
+		}
+		entries = append(entries, buf)
+	}
+	it.Close()
+
+	for _, buf := range entries {
+		var e entry
+		dec := codec.NewDecoderBytes(buf, &codec.CborHandle{})
+		if err := dec.Decode(&e); err != nil {
+			return errors.Wrap(err, "failed to decode wal entry")
+		}
+		if err := apply(ctx, s, e); err != nil {
+			return errors.Wrapf(err, "failed to replay wal entry of pulse %v", e.Pulse)
+		}
+	}
+
+	count, err := l.replayPendings(ctx, s.Pendings)
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("[WAL] replayed %d entries and %d pending objects", len(entries), count)
+	return nil
+}
+
+func apply(ctx context.Context, s Storages, e entry) error {
+	var err error
+	switch e.Kind {
+	case kindRecord:
+		rec := record.Material{}
+		if err = rec.Unmarshal(e.Record); err != nil {
+			return err
+		}
+		err = s.Records.Set(ctx, e.ID, rec)
+		if err == object.ErrOverride {
+			err = nil
+		}
+	case kindBlob:
+		err = s.Blobs.Set(ctx, e.ID, *e.Blob)
+		if err == blob.ErrOverride {
+			err = nil
+		}
+	case kindLifeline:
+		lifeline := object.Lifeline{}
+		if err = lifeline.Unmarshal(e.Lifeline); err != nil {
+			return err
+		}
+		err = s.Lifelines.Set(ctx, e.Pulse, e.ID, lifeline)
+	case kindBucket:
+		bucket := object.IndexBucket{}
+		if err = bucket.Unmarshal(e.Bucket); err != nil {
+			return err
+		}
+		err = s.Buckets.SetBucket(ctx, e.Pulse, bucket)
+	case kindLifelineUsage:
+		err = s.IndexState.SetLifelineUsage(ctx, e.Pulse, e.ID)
+		if err == object.ErrLifelineNotFound {
+			err = nil
+		}
+	case kindDrop:
+		err = s.Drops.Set(ctx, *e.Drop)
+		if err == drop.ErrOverride {
+			err = nil
+		}
+		// Jets of stored drops are actual, so the drops are replicated to heavy.
+		s.Jets.Update(ctx, e.Drop.Pulse, true, e.Drop.JetID)
+	case kindPulse:
+		err = s.Pulses.Append(ctx, *e.PulseData)
+		if err == pulse.ErrBadPulse {
+			err = nil
+		}
+	case kindNodes:
+		err = s.Nodes.Set(e.Pulse, e.Nodes)
+		if err == node.ErrOverride {
+			err = nil
+		}
+	default:
+		err = errors.Errorf("unknown entry kind %d", e.Kind)
+	}
+	return err
+}
+
+// Truncate removes entries and confirmations of all pulses up to provided pulse (including it).
+func (l *Log) Truncate(ctx context.Context, pn insolar.PulseNumber) error {
+	var pulses []insolar.PulseNumber
+	it := l.db.NewRangeIterator(store.ScopeWAL, nil, (pn + 1).Bytes())
+	for it.Next() {
+		entryPN := insolar.NewPulseNumber(it.ID())
+		if len(pulses) == 0 || pulses[len(pulses)-1] != entryPN {
+			pulses = append(pulses, entryPN)
+		}
+	}
+	it.Close()
+
+	count := 0
+	for _, entryPN := range pulses {
+		deleted, err := store.DeletePrefix(l.db, store.ScopeWAL, entryPN.Bytes())
+		count += deleted
+		if err != nil {
+			return errors.Wrapf(err, "failed to truncate wal for pulse %v", entryPN)
+		}
+	}
+
+	// Data of truncated pulses is not replicated again, so their confirmations are not needed.
+	var confirmed []insolar.JetID
+	it = l.db.NewIterator(store.ScopeWALConfirmed, nil)
+	for it.Next() {
+		buf, err := it.Value()
+		if err != nil {
+			it.Close()
+			return errors.Wrap(err, "failed to read confirmed pulse")
+		}
+		if insolar.NewPulseNumber(buf) <= pn {
+			var jetID insolar.JetID
+			copy(jetID[:], it.ID())
+			confirmed = append(confirmed, jetID)
+		}
+	}
+	it.Close()
+	err := l.db.Update(func(txn store.Transaction) error {
+		for _, jetID := range confirmed {
+			if err := txn.Delete(confirmedKey(jetID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete confirmed pulses")
+	}
+
+	inslogger.FromContext(ctx).Debugf("[WAL] truncated %d entries up to pulse %v", count, pn)
+	return nil
+}
+
+// SetConfirmed saves the last pulse of a jet confirmed by heavy nodes. Confirmations are kept until the pulse is
+// truncated from the log.
+func (l *Log) SetConfirmed(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) error {
+	return l.db.Set(confirmedKey(jetID), pn.Bytes())
+}
+
+// Confirmed returns the last pulses of jets confirmed by heavy nodes, that aren't truncated yet.
+func (l *Log) Confirmed(ctx context.Context) (map[insolar.JetID]insolar.PulseNumber, error) {
+	it := l.db.NewIterator(store.ScopeWALConfirmed, nil)
+	defer it.Close()
+
+	confirmed := map[insolar.JetID]insolar.PulseNumber{}
+	for it.Next() {
+		buf, err := it.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read confirmed pulse")
+		}
+		var jetID insolar.JetID
+		copy(jetID[:], it.ID())
+		confirmed[jetID] = insolar.NewPulseNumber(buf)
+	}
+	return confirmed, nil
+}
+
+func (l *Log) setPending(jetID, obj insolar.ID, objContext *recentstorage.PendingObjectContext) error {
+	key := pendingKey{jetID: jetID, obj: obj}
+	if objContext == nil || len(objContext.Requests) == 0 {
+		return l.db.Update(func(txn store.Transaction) error {
+			return txn.Delete(key)
+		})
+	}
+
+	var buf bytes.Buffer
+	enc := codec.NewEncoder(&buf, &codec.CborHandle{})
+	if err := enc.Encode(objContext); err != nil {
+		return err
+	}
+	return l.db.Set(key, buf.Bytes())
+}
+
+func (l *Log) setPendings(jetID insolar.ID, contexts map[insolar.ID]recentstorage.PendingObjectContext) error {
+	if _, err := store.DeletePrefix(l.db, store.ScopeWALPending, jetID.Bytes()); err != nil {
+		return err
+	}
+	for obj, objContext := range contexts {
+		objContext := objContext
+		if err := l.setPending(jetID, obj, &objContext); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Log) replayPendings(ctx context.Context, pendings recentstorage.Provider) (int, error) {
+	it := l.db.NewIterator(store.ScopeWALPending, nil)
+	defer it.Close()
+
+	count := 0
+	for it.Next() {
+		buf, err := it.Value()
+		if err != nil {
+			return count, errors.Wrap(err, "failed to read pending requests")
+		}
+		var objContext recentstorage.PendingObjectContext
+		dec := codec.NewDecoderBytes(buf, &codec.CborHandle{})
+		if err := dec.Decode(&objContext); err != nil {
+			return count, errors.Wrap(err, "failed to decode pending requests")
+		}
+
+		id := it.ID()
+		var jetID, obj insolar.ID
+		copy(jetID[:], id[:insolar.RecordIDSize])
+		copy(obj[:], id[insolar.RecordIDSize:])
+		pendings.GetPendingStorage(ctx, jetID).SetContextToObject(ctx, obj, objContext)
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,254 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/node"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/light/recentstorage"
+	"github.com/insolar/insolar/ledger/light/replication"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+type hotStorages struct {
+	records *object.RecordMemory
+	blobs   *blob.StorageMemory
+	indexes *object.InMemoryIndex
+	drops   interface {
+		drop.Accessor
+		drop.Modifier
+	}
+	pulses   *pulse.StorageMem
+	nodes    *node.Storage
+	jets     *jet.Store
+	pendings *recentstorage.RecentStorageProvider
+}
+
+func newHotStorages() *hotStorages {
+	return &hotStorages{
+		records:  object.NewRecordMemory(),
+		blobs:    blob.NewStorageMemory(),
+		indexes:  object.NewInMemoryIndex(),
+		drops:    drop.NewStorageMemory(),
+		pulses:   pulse.NewStorageMem(),
+		nodes:    node.NewStorage(),
+		jets:     jet.NewStore(),
+		pendings: recentstorage.NewRecentStorageProvider(),
+	}
+}
+
+func (s *hotStorages) storages() Storages {
+	return Storages{
+		Records:    s.records,
+		Blobs:      s.blobs,
+		Lifelines:  s.indexes,
+		Buckets:    s.indexes,
+		IndexState: s.indexes,
+		Drops:      s.drops,
+		Pulses:     s.pulses,
+		Nodes:      s.nodes,
+		Jets:       s.jets,
+		Pendings:   s.pendings,
+	}
+}
+
+type hotData struct {
+	pulses  []insolar.PulseNumber
+	jetID   insolar.JetID
+	recID   insolar.ID
+	rec     record.Material
+	blobID  insolar.ID
+	objID   insolar.ID
+	nodes   []insolar.Node
+	request insolar.ID
+}
+
+// write saves data of two pulses through the log.
+func write(ctx context.Context, t *testing.T, l *Log, s *hotStorages) hotData {
+	d := hotData{
+		jetID:   *insolar.NewJetID(1, []byte{0x80}),
+		objID:   gen.ID(),
+		nodes:   []insolar.Node{{ID: gen.Reference(), Role: insolar.StaticRoleLightMaterial}},
+		request: gen.ID(),
+	}
+	for i := 0; i < 2; i++ {
+		pn := insolar.FirstPulseNumber + insolar.PulseNumber(i*10)
+		require.NoError(t, l.PulseAppender(s.pulses).Append(ctx, insolar.Pulse{PulseNumber: pn}))
+		require.NoError(t, l.NodeModifier(s.nodes).Set(pn, d.nodes))
+		d.pulses = append(d.pulses, pn)
+	}
+	pn := d.pulses[0]
+
+	d.recID = *insolar.NewID(pn, []byte{1})
+	d.rec = record.Material{
+		Virtual: &record.Virtual{Union: &record.Virtual_Code{Code: &record.Code{Code: gen.ID()}}},
+		JetID:   d.jetID,
+	}
+	require.NoError(t, l.RecordModifier(s.records).Set(ctx, d.recID, d.rec))
+	d.blobID = *insolar.NewID(pn, []byte{2})
+	require.NoError(t, l.BlobStorage(s.blobs).Set(ctx, d.blobID, blob.Blob{Value: []byte{3}, JetID: d.jetID}))
+	require.NoError(t, l.LifelineIndex(s.indexes).Set(ctx, pn, d.objID, object.Lifeline{
+		LatestState: &d.recID,
+		JetID:       d.jetID,
+	}))
+	require.NoError(t, l.LifelineStateModifier(s.indexes).SetLifelineUsage(ctx, pn, d.objID))
+	require.NoError(t, l.IndexBucketModifier(s.indexes).SetBucket(ctx, d.pulses[1], object.IndexBucket{
+		ObjID:    d.objID,
+		Lifeline: object.Lifeline{LatestState: &d.recID, JetID: d.jetID},
+	}))
+	require.NoError(t, l.DropModifier(s.drops).Set(ctx, drop.Drop{Pulse: pn, JetID: d.jetID, Hash: []byte{4}}))
+
+	pendings := l.PendingProvider(s.pendings)
+	pendings.GetPendingStorage(ctx, insolar.ID(d.jetID)).AddPendingRequest(ctx, d.objID, d.request)
+	return d
+}
+
+func TestLog_Replay(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	d := write(ctx, t, NewLog(db), newHotStorages())
+
+	// Log is opened again after restart.
+	restored := newHotStorages()
+	err := NewLog(db).Replay(ctx, restored.storages())
+	require.NoError(t, err)
+
+	latest, err := restored.pulses.Latest(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, d.pulses[1], latest.PulseNumber)
+	nodes, err := restored.nodes.All(d.pulses[0])
+	require.NoError(t, err)
+	assert.Equal(t, d.nodes, nodes)
+
+	rec, err := restored.records.ForID(ctx, d.recID)
+	require.NoError(t, err)
+	assert.Equal(t, d.rec, rec)
+	b, err := restored.blobs.ForID(ctx, d.blobID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{3}, b.Value)
+
+	for _, pn := range d.pulses {
+		lifeline, err := restored.indexes.ForID(ctx, pn, d.objID)
+		require.NoError(t, err)
+		assert.Equal(t, &d.recID, lifeline.LatestState)
+	}
+
+	dr, err := restored.drops.ForPulse(ctx, d.jetID, d.pulses[0])
+	require.NoError(t, err)
+	assert.Equal(t, []byte{4}, dr.Hash)
+	jetID, actual := restored.jets.ForID(ctx, d.pulses[0], *insolar.NewID(d.pulses[0], []byte{0x80}))
+	assert.Equal(t, d.jetID, jetID)
+	assert.True(t, actual, "jet of drop is actual")
+
+	requests := restored.pendings.GetPendingStorage(ctx, insolar.ID(d.jetID)).GetRequestsForObject(d.objID)
+	assert.Equal(t, []insolar.ID{d.request}, requests)
+
+	t.Run("replays into the same storages", func(t *testing.T) {
+		err := NewLog(db).Replay(ctx, restored.storages())
+		require.NoError(t, err)
+	})
+}
+
+func TestLog_Truncate(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	l := NewLog(db)
+	d := write(ctx, t, l, newHotStorages())
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+	next := replication.NewCleanerMock(mc)
+	next.NotifyAboutPulseMock.Expect(ctx, d.pulses[0])
+	l.Cleaner(next).NotifyAboutPulse(ctx, d.pulses[0])
+
+	restored := newHotStorages()
+	err := NewLog(db).Replay(ctx, restored.storages())
+	require.NoError(t, err)
+
+	_, err = restored.pulses.ForPulseNumber(ctx, d.pulses[0])
+	assert.Equal(t, pulse.ErrNotFound, err)
+	_, err = restored.records.ForID(ctx, d.recID)
+	assert.Equal(t, object.ErrNotFound, err)
+	_, err = restored.indexes.ForID(ctx, d.pulses[0], d.objID)
+	assert.Equal(t, object.ErrLifelineNotFound, err)
+
+	_, err = restored.indexes.ForID(ctx, d.pulses[1], d.objID)
+	assert.NoError(t, err, "entries of not confirmed pulse are kept")
+	requests := restored.pendings.GetPendingStorage(ctx, insolar.ID(d.jetID)).GetRequestsForObject(d.objID)
+	assert.Equal(t, []insolar.ID{d.request}, requests, "pending requests are kept")
+}
+
+func TestLog_Confirmed(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	l := NewLog(db)
+
+	pn := gen.PulseNumber()
+	old, recent := gen.JetID(), gen.JetID()
+	require.NoError(t, l.SetConfirmed(ctx, old, pn))
+	require.NoError(t, l.SetConfirmed(ctx, recent, pn+1))
+
+	confirmed, err := NewLog(db).Confirmed(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[insolar.JetID]insolar.PulseNumber{old: pn, recent: pn + 1}, confirmed)
+
+	require.NoError(t, l.Truncate(ctx, pn))
+	confirmed, err = l.Confirmed(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[insolar.JetID]insolar.PulseNumber{recent: pn + 1}, confirmed, "truncated pulses are removed")
+}
+
+func TestLog_Pendings(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	l := NewLog(db)
+	pendings := l.PendingProvider(recentstorage.NewRecentStorageProvider())
+
+	jetID, clonedJetID := gen.ID(), gen.ID()
+	closed, open := gen.ID(), gen.ID()
+	req := gen.ID()
+	storage := pendings.GetPendingStorage(ctx, jetID)
+	storage.AddPendingRequest(ctx, closed, req)
+	storage.AddPendingRequest(ctx, open, req)
+	storage.RemovePendingRequest(ctx, closed, req)
+	pendings.ClonePendingStorage(ctx, jetID, clonedJetID)
+	pendings.RemovePendingStorage(ctx, jetID)
+
+	restored := recentstorage.NewRecentStorageProvider()
+	count, err := l.replayPendings(ctx, restored)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, map[insolar.ID]recentstorage.PendingObjectContext{
+		open: {Active: true, Requests: []insolar.ID{req}},
+	}, restored.GetPendingStorage(ctx, clonedJetID).GetRequests())
+	assert.Empty(t, restored.GetPendingStorage(ctx, jetID).GetRequests())
+}
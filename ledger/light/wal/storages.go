@@ -0,0 +1,270 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wal
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/node"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/light/recentstorage"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+type recordModifier struct {
+	log  *Log
+	next object.RecordModifier
+}
+
+// RecordModifier returns a modifier, that logs records before saving them to provided modifier.
+func (l *Log) RecordModifier(next object.RecordModifier) object.RecordModifier {
+	return &recordModifier{log: l, next: next}
+}
+
+func (m *recordModifier) Set(ctx context.Context, id insolar.ID, rec record.Material) error {
+	buf, err := rec.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal record")
+	}
+	if err := m.log.append(id.Pulse(), entry{Kind: kindRecord, ID: id, Record: buf}); err != nil {
+		return err
+	}
+	return m.next.Set(ctx, id, rec)
+}
+
+type blobStorage struct {
+	blob.Storage
+	log *Log
+}
+
+// BlobStorage returns a storage, that logs blobs before saving them to provided storage.
+func (l *Log) BlobStorage(next blob.Storage) blob.Storage {
+	return &blobStorage{Storage: next, log: l}
+}
+
+func (s *blobStorage) Set(ctx context.Context, id insolar.ID, b blob.Blob) error {
+	if err := s.log.append(id.Pulse(), entry{Kind: kindBlob, ID: id, Blob: &b}); err != nil {
+		return err
+	}
+	return s.Storage.Set(ctx, id, b)
+}
+
+type lifelineIndex struct {
+	object.LifelineIndex
+	log *Log
+}
+
+// LifelineIndex returns an index, that logs lifelines before saving them to provided index.
+func (l *Log) LifelineIndex(next object.LifelineIndex) object.LifelineIndex {
+	return &lifelineIndex{LifelineIndex: next, log: l}
+}
+
+func (i *lifelineIndex) Set(
+	ctx context.Context, pn insolar.PulseNumber, objID insolar.ID, lifeline object.Lifeline,
+) error {
+	buf, err := lifeline.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal lifeline")
+	}
+	if err := i.log.append(pn, entry{Kind: kindLifeline, ID: objID, Lifeline: buf}); err != nil {
+		return err
+	}
+	return i.LifelineIndex.Set(ctx, pn, objID, lifeline)
+}
+
+type bucketModifier struct {
+	log  *Log
+	next object.IndexBucketModifier
+}
+
+// IndexBucketModifier returns a modifier, that logs buckets before saving them to provided modifier.
+func (l *Log) IndexBucketModifier(next object.IndexBucketModifier) object.IndexBucketModifier {
+	return &bucketModifier{log: l, next: next}
+}
+
+func (m *bucketModifier) SetBucket(ctx context.Context, pn insolar.PulseNumber, bucket object.IndexBucket) error {
+	buf, err := bucket.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal bucket")
+	}
+	if err := m.log.append(pn, entry{Kind: kindBucket, ID: bucket.ObjID, Bucket: buf}); err != nil {
+		return err
+	}
+	return m.next.SetBucket(ctx, pn, bucket)
+}
+
+type stateModifier struct {
+	log  *Log
+	next object.LifelineStateModifier
+}
+
+// LifelineStateModifier returns a modifier, that logs usage of lifelines before saving it to provided modifier.
+func (l *Log) LifelineStateModifier(next object.LifelineStateModifier) object.LifelineStateModifier {
+	return &stateModifier{log: l, next: next}
+}
+
+func (m *stateModifier) SetLifelineUsage(ctx context.Context, pn insolar.PulseNumber, objID insolar.ID) error {
+	if err := m.log.append(pn, entry{Kind: kindLifelineUsage, ID: objID}); err != nil {
+		return err
+	}
+	return m.next.SetLifelineUsage(ctx, pn, objID)
+}
+
+type dropModifier struct {
+	log  *Log
+	next drop.Modifier
+}
+
+// DropModifier returns a modifier, that logs drops before saving them to provided modifier.
+func (l *Log) DropModifier(next drop.Modifier) drop.Modifier {
+	return &dropModifier{log: l, next: next}
+}
+
+func (m *dropModifier) Set(ctx context.Context, d drop.Drop) error {
+	if err := m.log.append(d.Pulse, entry{Kind: kindDrop, Drop: &d}); err != nil {
+		return err
+	}
+	return m.next.Set(ctx, d)
+}
+
+type pulseAppender struct {
+	log  *Log
+	next pulse.Appender
+}
+
+// PulseAppender returns an appender, that logs pulses before appending them to provided appender.
+func (l *Log) PulseAppender(next pulse.Appender) pulse.Appender {
+	return &pulseAppender{log: l, next: next}
+}
+
+func (a *pulseAppender) Append(ctx context.Context, p insolar.Pulse) error {
+	if err := a.log.append(p.PulseNumber, entry{Kind: kindPulse, PulseData: &p}); err != nil {
+		return err
+	}
+	return a.next.Append(ctx, p)
+}
+
+type nodeModifier struct {
+	node.Modifier
+	log *Log
+}
+
+// NodeModifier returns a modifier, that logs active nodes before saving them to provided modifier.
+func (l *Log) NodeModifier(next node.Modifier) node.Modifier {
+	return &nodeModifier{Modifier: next, log: l}
+}
+
+func (m *nodeModifier) Set(pn insolar.PulseNumber, nodes []insolar.Node) error {
+	if err := m.log.append(pn, entry{Kind: kindNodes, Nodes: nodes}); err != nil {
+		return err
+	}
+	return m.Modifier.Set(pn, nodes)
+}
+
+type pendingProvider struct {
+	recentstorage.Provider
+	log *Log
+
+	// lock serializes changes of pendings with saving their state.
+	lock sync.Mutex
+}
+
+// PendingProvider returns a provider, that saves state of pending requests after every change of provided provider.
+func (l *Log) PendingProvider(next recentstorage.Provider) recentstorage.Provider {
+	return &pendingProvider{Provider: next, log: l}
+}
+
+func (p *pendingProvider) GetPendingStorage(ctx context.Context, jetID insolar.ID) recentstorage.PendingStorage {
+	return &pendingStorage{
+		PendingStorage: p.Provider.GetPendingStorage(ctx, jetID),
+		provider:       p,
+		jetID:          jetID,
+	}
+}
+
+func (p *pendingProvider) ClonePendingStorage(ctx context.Context, fromJetID, toJetID insolar.ID) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.Provider.ClonePendingStorage(ctx, fromJetID, toJetID)
+	contexts := p.Provider.GetPendingStorage(ctx, toJetID).GetRequests()
+	if err := p.log.setPendings(toJetID, contexts); err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "[WAL] failed to save cloned pending requests"))
+	}
+}
+
+func (p *pendingProvider) RemovePendingStorage(ctx context.Context, jetID insolar.ID) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.Provider.RemovePendingStorage(ctx, jetID)
+	if _, err := store.DeletePrefix(p.log.db, store.ScopeWALPending, jetID.Bytes()); err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "[WAL] failed to remove pending requests"))
+	}
+}
+
+type pendingStorage struct {
+	recentstorage.PendingStorage
+	provider *pendingProvider
+	jetID    insolar.ID
+}
+
+// save writes the current state of object pendings.
+func (s *pendingStorage) save(ctx context.Context, obj insolar.ID) {
+	var objContext *recentstorage.PendingObjectContext
+	if current, ok := s.PendingStorage.GetRequests()[obj]; ok {
+		objContext = &current
+	}
+	if err := s.provider.log.setPending(s.jetID, obj, objContext); err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "[WAL] failed to save pending requests"))
+	}
+}
+
+func (s *pendingStorage) AddPendingRequest(ctx context.Context, obj, req insolar.ID) {
+	s.provider.lock.Lock()
+	defer s.provider.lock.Unlock()
+
+	s.PendingStorage.AddPendingRequest(ctx, obj, req)
+	s.save(ctx, obj)
+}
+
+func (s *pendingStorage) SetContextToObject(
+	ctx context.Context, obj insolar.ID, objContext recentstorage.PendingObjectContext,
+) {
+	s.provider.lock.Lock()
+	defer s.provider.lock.Unlock()
+
+	s.PendingStorage.SetContextToObject(ctx, obj, objContext)
+	s.save(ctx, obj)
+}
+
+func (s *pendingStorage) RemovePendingRequest(ctx context.Context, obj, req insolar.ID) {
+	s.provider.lock.Lock()
+	defer s.provider.lock.Unlock()
+
+	s.PendingStorage.RemovePendingRequest(ctx, obj, req)
+	s.save(ctx, obj)
+}
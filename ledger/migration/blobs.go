@@ -0,0 +1,66 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package migration
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+)
+
+// blobsPage is how many blobs are read at once by shareBlobs. Progress is logged after every page.
+const blobsPage = 1000
+
+// shareBlobs moves values of blobs stored before deduplication to shared values. Values are moved without
+// compression, as the codec is a setting of the node and new values are compressed with it.
+func shareBlobs(ctx context.Context, db store.DB) error {
+	logger := inslogger.FromContext(ctx)
+	blobs := blob.NewDB(db)
+
+	total := 0
+	from := []byte{}
+	for {
+		var ids []insolar.ID
+		it := db.NewRangeIterator(store.ScopeBlob, from, nil)
+		for len(ids) < blobsPage && it.Next() {
+			var id insolar.ID
+			copy(id[:], it.ID())
+			ids = append(ids, id)
+		}
+		it.Close()
+
+		for _, id := range ids {
+			err := blobs.Share(ctx, id)
+			if err != nil {
+				return errors.Wrapf(err, "failed to share value of blob %v", id.DebugString())
+			}
+		}
+		total += len(ids)
+		if len(ids) < blobsPage {
+			logger.Infof("[Migration] values of %d blobs are shared", total)
+			return nil
+		}
+		logger.Infof("[Migration] values of %d blobs are shared", total)
+		// The next page starts right after the last read ID.
+		from = append(ids[len(ids)-1].Bytes(), 0)
+	}
+}
@@ -0,0 +1,77 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package migration
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+)
+
+func TestShareBlobs(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	pn := gen.PulseNumber()
+	jetID := gen.JetID()
+
+	// Blobs are stored with inline values, as they were before deduplication.
+	stored := map[insolar.ID][]byte{}
+	for i := 0; i < blobsPage+10; i++ {
+		value := []byte{byte(i), byte(i >> 8)}
+		id := *insolar.NewID(pn, value)
+		var buf bytes.Buffer
+		err := codec.NewEncoder(&buf, &codec.CborHandle{}).Encode(blob.Blob{Value: value, JetID: jetID})
+		require.NoError(t, err)
+		require.NoError(t, db.Set(key{scope: store.ScopeBlob, id: id.Bytes()}, buf.Bytes()))
+		stored[id] = value
+	}
+	// Blob stored after deduplication is left as is.
+	shared := *insolar.NewID(pn, []byte{1, 2, 3})
+	blobs := blob.NewDB(db)
+	require.NoError(t, blobs.Set(ctx, shared, blob.Blob{Value: []byte{1, 2, 3}, JetID: jetID}))
+	stored[shared] = []byte{1, 2, 3}
+
+	require.NoError(t, shareBlobs(ctx, db))
+
+	for id, value := range stored {
+		b, err := blobs.ForID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, blob.Blob{Value: value, JetID: jetID}, b)
+	}
+	values := 0
+	it := db.NewIterator(store.ScopeBlobValue, nil)
+	for it.Next() {
+		values++
+	}
+	it.Close()
+	assert.Equal(t, len(stored), values, "values are shared")
+
+	// Shared values are released with their blobs.
+	blobs.DeleteForPN(ctx, pn)
+	it = db.NewIterator(store.ScopeBlobValue, nil)
+	assert.False(t, it.Next())
+	it.Close()
+}
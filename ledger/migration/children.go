@@ -0,0 +1,130 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package migration
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// indexProgress is how often progress of children indexing is logged, in objects.
+const indexProgress = 10000
+
+// indexChildren builds the index of children by prototype for all stored objects. Children were registered without
+// prototype before, so prototype is taken from the latest state of the child. Deactivated children are indexed only by
+// position.
+func indexChildren(ctx context.Context, db store.DB) error {
+	logger := inslogger.FromContext(ctx)
+	indexes := object.NewIndexDB(db)
+	records := object.NewRecordDB(db)
+	children := object.NewChildIndexDB(db)
+
+	parents := indexes.ObjectIDs(ctx)
+	total := 0
+	for i, parent := range parents {
+		bucket, err := indexes.LastKnownForID(ctx, parent)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch index of %s", parent.DebugString())
+		}
+		count, err := indexChildrenOf(ctx, indexes, records, children, parent, bucket.Lifeline)
+		if err != nil {
+			return errors.Wrapf(err, "failed to index children of %s", parent.DebugString())
+		}
+		total += count
+		if (i+1)%indexProgress == 0 {
+			logger.Infof("[Migration] children of %d of %d objects are indexed", i+1, len(parents))
+		}
+	}
+	logger.Infof("[Migration] %d children of %d objects are indexed", total, len(parents))
+	return nil
+}
+
+func indexChildrenOf(
+	ctx context.Context,
+	indexes *object.IndexDB,
+	records *object.RecordDB,
+	children *object.ChildIndexDB,
+	parent insolar.ID,
+	lifeline object.Lifeline,
+) (int, error) {
+	var (
+		count  int
+		pn     insolar.PulseNumber
+		number uint32
+	)
+	// Children records are linked from the latest to the first one. Children are numbered inside of the pulse.
+	for next := lifeline.ChildPointer; next != nil && !next.IsEmpty(); {
+		id := *next
+		if id.Pulse() != pn {
+			pn, number = id.Pulse(), 0
+		}
+
+		rec, err := records.ForID(ctx, id)
+		if err == object.ErrNotFound {
+			// Node may keep only recent data, so earlier children can be missing.
+			inslogger.FromContext(ctx).Warnf("[Migration] child %s is not found, earlier children are skipped", id.DebugString())
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		child, ok := record.Unwrap(rec.Virtual).(*record.Child)
+		if !ok {
+			return count, errors.Errorf("child pointer %s doesn't point to a child record", id.DebugString())
+		}
+
+		prototype := child.Prototype
+		if prototype == nil {
+			prototype = prototypeOf(ctx, indexes, records, child.Ref)
+		}
+		err = children.Set(ctx, parent, id, number, prototype, child.Ref)
+		if err != nil {
+			return count, err
+		}
+
+		count++
+		number++
+		next = &child.PrevChild
+	}
+	return count, nil
+}
+
+// prototypeOf returns prototype of the object or nil if the object is deactivated or its state is not stored.
+func prototypeOf(
+	ctx context.Context, indexes *object.IndexDB, records *object.RecordDB, obj insolar.Reference,
+) *insolar.Reference {
+	bucket, err := indexes.LastKnownForID(ctx, *obj.Record())
+	if err != nil || bucket.Lifeline.LatestState == nil {
+		return nil
+	}
+	rec, err := records.ForID(ctx, *bucket.Lifeline.LatestState)
+	if err != nil {
+		return nil
+	}
+	state, ok := record.Unwrap(rec.Virtual).(record.State)
+	if !ok || state.ID() == record.StateDeactivation {
+		return nil
+	}
+	return state.GetImage()
+}
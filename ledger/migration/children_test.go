@@ -0,0 +1,83 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+func TestIndexChildren(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	indexes := object.NewIndexDB(db)
+	records := object.NewRecordDB(db)
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber)
+
+	setRecord := func(pn insolar.PulseNumber, rec interface{}) insolar.ID {
+		virtual := record.Wrap(rec)
+		hash := gen.ID()
+		id := *insolar.NewID(pn, hash.Hash())
+		err := records.Set(ctx, id, record.Material{Virtual: &virtual})
+		require.NoError(t, err)
+		return id
+	}
+	newObject := func(state interface{}) insolar.Reference {
+		ref := gen.Reference()
+		latest := setRecord(pn, state)
+		err := indexes.Set(ctx, pn, *ref.Record(), object.Lifeline{LatestState: &latest})
+		require.NoError(t, err)
+		return ref
+	}
+
+	protoA, protoB := gen.Reference(), gen.Reference()
+	legacy := newObject(record.Activate{Image: protoA})
+	registered := newObject(record.Activate{Image: protoA})
+	deactivated := newObject(record.Deactivate{})
+
+	first := setRecord(pn, record.Child{Ref: legacy})
+	second := setRecord(pn+1, record.Child{PrevChild: first, Ref: registered, Prototype: &protoB})
+	last := setRecord(pn+1, record.Child{PrevChild: second, Ref: deactivated})
+	parent := gen.ID()
+	err := indexes.Set(ctx, pn, parent, object.Lifeline{ChildPointer: &last})
+	require.NoError(t, err)
+
+	err = indexChildren(ctx, db)
+	require.NoError(t, err)
+
+	children := object.NewChildIndexDB(db)
+	refs, _, err := children.ForPrototype(ctx, parent, protoA, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.Reference{legacy}, refs, "prototype of legacy child is taken from state")
+
+	refs, _, err = children.ForPrototype(ctx, parent, protoB, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.Reference{registered}, refs, "prototype of child record is used")
+
+	refs, _, err = children.ForPrototype(ctx, parent, protoA, &last, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.Reference{legacy}, refs, "position of deactivated child is known")
+}
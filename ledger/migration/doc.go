@@ -0,0 +1,25 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package migration migrates persisted ledger data between versions of data format.
+//
+// Version of data format is stored in the storage. Registry holds ordered migrations, every migration converts data
+// of the previous version to its own one. On start a node runs migrations from the stored version to the latest one
+// and refuses to start if the stored version is newer than the latest known one. New storages get the latest version
+// without running migrations.
+//
+// Change of persisted format, e.g. of lifeline.proto, should be accompanied by a migration appended to Default.
+package migration
@@ -0,0 +1,162 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package migration
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+const (
+	// dropsPage is how many drops are read at once by indexDrops. Progress is logged after every page.
+	dropsPage = 1000
+	// rehashProgress is how often progress of rehashing of drops is logged, in pulses.
+	rehashProgress = 1000
+)
+
+// indexDrops builds the index of drops by pulse for drops stored before the index was introduced.
+func indexDrops(ctx context.Context, db store.DB) error {
+	logger := inslogger.FromContext(ctx)
+	drops := drop.NewDB(db)
+
+	total := 0
+	cursor := drop.Drop{JetID: insolar.ZeroJetID}
+	for {
+		page, err := drops.After(ctx, cursor.JetID, cursor.Pulse, dropsPage)
+		if err != nil {
+			return errors.Wrap(err, "failed to fetch drops")
+		}
+		for _, d := range page {
+			err := drops.Reindex(ctx, d)
+			if err != nil {
+				return errors.Wrapf(err, "failed to index drop of jet %v for pulse %v", d.JetID.DebugString(), d.Pulse)
+			}
+			cursor = d
+		}
+		total += len(page)
+		if len(page) < dropsPage {
+			logger.Infof("[Migration] %d drops are indexed by pulse", total)
+			return nil
+		}
+		logger.Infof("[Migration] %d drops are indexed by pulse", total)
+	}
+}
+
+// rehashDrops calculates hashes of stored drops with Merkle roots of their records and blobs and chains the drops
+// again. Drops stored before had hashes of another scheme or no hashes at all. Drops are processed by pulses in
+// ascending order, so a drop is rehashed after the drops it continues. Previous drop is the drop of the jet, of its
+// parent or merged drops of its children for the previous pulse, as drop chains are verified by heavy nodes.
+// If there is no previous drop, the drop starts the chain.
+func rehashDrops(ctx context.Context, db store.DB) error {
+	logger := inslogger.FromContext(ctx)
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	drops := drop.NewDB(db)
+	pulses := pulse.NewDB(db)
+	records := object.NewRecordDB(db)
+	blobs := blob.NewDB(db)
+
+	pns, err := dropPulses(db)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch pulses of drops")
+	}
+
+	var (
+		total     int
+		lastPulse insolar.PulseNumber
+		last      map[insolar.JetID]drop.Drop
+	)
+	for i, pn := range pns {
+		var prev map[insolar.JetID]drop.Drop
+		prevPulse, err := pulses.Backwards(ctx, pn, 1)
+		if err == nil && prevPulse.PulseNumber == lastPulse {
+			prev = last
+		} else if err != nil && err != pulse.ErrNotFound {
+			return errors.Wrapf(err, "failed to calculate pulse before %v", pn)
+		}
+
+		page, err := drops.All(ctx, pn)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch drops for pulse %v", pn)
+		}
+		rehashed := make(map[insolar.JetID]drop.Drop, len(page))
+		for _, d := range page {
+			d.PrevHash = prevDropHash(pcs, prev, d.JetID)
+			d.Hash = drop.Hash(pcs, d.PrevHash, pn, records.ForPulse(ctx, d.JetID, pn), blobs.ForPulse(ctx, d.JetID, pn))
+			err := drops.Rewrite(ctx, d)
+			if err != nil {
+				return errors.Wrapf(err, "failed to store drop of jet %v for pulse %v", d.JetID.DebugString(), pn)
+			}
+			rehashed[d.JetID] = d
+		}
+		lastPulse, last = pn, rehashed
+
+		total += len(page)
+		if (i+1)%rehashProgress == 0 {
+			logger.Infof("[Migration] drops of %d of %d pulses are rehashed", i+1, len(pns))
+		}
+	}
+	logger.Infof("[Migration] %d drops of %d pulses are rehashed", total, len(pns))
+	return nil
+}
+
+// prevDropHash returns the hash, that a drop of the jet continues, from drops of the previous pulse.
+func prevDropHash(pcs insolar.PlatformCryptographyScheme, prev map[insolar.JetID]drop.Drop, jetID insolar.JetID) []byte {
+	if d, ok := prev[jetID]; ok {
+		return d.Hash
+	}
+	if jetID != insolar.ZeroJetID {
+		if d, ok := prev[jet.Parent(jetID)]; ok {
+			return d.Hash
+		}
+	}
+	leftID, rightID := jet.Children(jetID)
+	left, leftOK := prev[leftID]
+	right, rightOK := prev[rightID]
+	if leftOK && rightOK {
+		return drop.MergedHash(pcs, left.Hash, right.Hash)
+	}
+	return nil
+}
+
+// dropPulses returns pulses of stored drops in ascending order. Drops are taken from the index of drops by pulse.
+func dropPulses(db store.DB) ([]insolar.PulseNumber, error) {
+	it := db.NewIterator(store.ScopeJetDropPulse, nil)
+	defer it.Close()
+
+	var pns []insolar.PulseNumber
+	for it.Next() {
+		id := it.ID()
+		if len(id) < insolar.PulseNumberSize {
+			return nil, errors.Errorf("malformed key of drop index %x", id)
+		}
+		pn := insolar.NewPulseNumber(id[:insolar.PulseNumberSize])
+		if len(pns) == 0 || pns[len(pns)-1] != pn {
+			pns = append(pns, pn)
+		}
+	}
+	return pns, nil
+}
@@ -0,0 +1,121 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package migration
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/heavy/integrity"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/drop"
+)
+
+func TestIndexDrops(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	pn := gen.PulseNumber()
+
+	// Drops are stored without the index, as they were before the index was introduced.
+	var drops []drop.Drop
+	for i := 0; i < 3; i++ {
+		d := drop.Drop{JetID: *insolar.NewJetID(2, []byte{byte(i) << 6}), Pulse: pn}
+		err := db.Set(key{scope: store.ScopeJetDrop, id: append(d.JetID.Prefix(), d.Pulse.Bytes()...)}, drop.MustEncode(&d))
+		require.NoError(t, err)
+		drops = append(drops, d)
+	}
+	all, err := drop.NewDB(db).All(ctx, pn)
+	require.NoError(t, err)
+	require.Empty(t, all)
+
+	require.NoError(t, indexDrops(ctx, db))
+
+	all, err = drop.NewDB(db).All(ctx, pn)
+	require.NoError(t, err)
+	assert.Equal(t, drops, all)
+}
+
+func TestRehashDrops(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	pn := gen.PulseNumber()
+	pulses := []insolar.PulseNumber{pn, pn + 10, pn + 20, pn + 30, pn + 40}
+	for _, p := range pulses {
+		require.NoError(t, pulse.NewDB(db).Append(ctx, insolar.Pulse{PulseNumber: p}))
+	}
+
+	// Root jet is split in the second pulse and merged back in the third one. Drops have hashes of the previous
+	// scheme or no hashes at all. The last drop doesn't continue the chain, because its previous pulse has no drops.
+	left, right := jet.Children(insolar.ZeroJetID)
+	setDrop := func(jetID insolar.JetID, pn insolar.PulseNumber, hash []byte) {
+		obj := gen.Reference()
+		virtual := record.Wrap(record.Request{Object: &obj})
+		id := insolar.NewID(pn, record.HashVirtual(pcs.ReferenceHasher(), virtual))
+		require.NoError(t, object.NewRecordDB(db).Set(ctx, *id, record.Material{Virtual: &virtual, JetID: jetID}))
+		value := append(jetID.Prefix(), pn.Bytes()...)
+		blobID := object.CalculateIDForBlob(pcs, pn, value)
+		require.NoError(t, blob.NewDB(db).Set(ctx, *blobID, blob.Blob{Value: value, JetID: jetID}))
+
+		d := drop.Drop{JetID: jetID, Pulse: pn, Hash: hash, PrevHash: hash}
+		require.NoError(t, drop.NewDB(db).Set(ctx, d))
+	}
+	setDrop(insolar.ZeroJetID, pulses[0], nil)
+	setDrop(left, pulses[1], []byte{1})
+	setDrop(right, pulses[1], []byte{2})
+	setDrop(insolar.ZeroJetID, pulses[2], []byte{3})
+	setDrop(insolar.ZeroJetID, pulses[4], []byte{4})
+
+	require.NoError(t, rehashDrops(ctx, db))
+
+	report, err := integrity.NewChecker(db, pcs).Check(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, report.Problems)
+
+	drops := drop.NewDB(db)
+	first, err := drops.ForPulse(ctx, insolar.ZeroJetID, pulses[0])
+	require.NoError(t, err)
+	assert.Nil(t, first.PrevHash)
+	assert.NotEmpty(t, first.Hash)
+	leftDrop, err := drops.ForPulse(ctx, left, pulses[1])
+	require.NoError(t, err)
+	assert.Equal(t, first.Hash, leftDrop.PrevHash, "split jet continues parent")
+	rightDrop, err := drops.ForPulse(ctx, right, pulses[1])
+	require.NoError(t, err)
+	merged, err := drops.ForPulse(ctx, insolar.ZeroJetID, pulses[2])
+	require.NoError(t, err)
+	assert.Equal(t, drop.MergedHash(pcs, leftDrop.Hash, rightDrop.Hash), merged.PrevHash, "merged jet continues children")
+	last, err := drops.ForPulse(ctx, insolar.ZeroJetID, pulses[4])
+	require.NoError(t, err)
+	assert.Nil(t, last.PrevHash, "drop without previous drop starts the chain")
+
+	require.NoError(t, rehashDrops(ctx, db))
+	again, err := drops.ForPulse(ctx, insolar.ZeroJetID, pulses[2])
+	require.NoError(t, err)
+	assert.Equal(t, merged, again, "rehashing is repeatable")
+}
@@ -0,0 +1,231 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package migration
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+var (
+	// ErrNewerVersion is returned if stored data has a version newer than the latest known one.
+	ErrNewerVersion = errors.New("data version is newer than supported")
+	// ErrOlderVersion is returned if stored data should be migrated to the latest version before use.
+	ErrOlderVersion = errors.New("data version is older than supported")
+)
+
+// Migration converts data of the previous version to Version.
+type Migration struct {
+	// Version is a version of data after migration.
+	Version uint32
+	// Description is a short description of the change for logs.
+	Description string
+	// Migrate converts data. Migration is not atomic, interrupted migration is run again on the next start,
+	// so it should handle partially converted data.
+	Migrate func(ctx context.Context, db store.DB) error
+}
+
+// Registry holds ordered migrations.
+type Registry struct {
+	migrations []Migration
+}
+
+// NewRegistry creates a registry. Versions of migrations should be consecutive starting with one.
+func NewRegistry(migrations ...Migration) (*Registry, error) {
+	for i, m := range migrations {
+		if m.Version != uint32(i+1) {
+			return nil, errors.Errorf("migration %d has version %d, expected %d", i, m.Version, i+1)
+		}
+		if m.Migrate == nil {
+			return nil, errors.Errorf("migration to version %d has no func", m.Version)
+		}
+	}
+	return &Registry{migrations: migrations}, nil
+}
+
+// Latest returns the latest version of data.
+func (r *Registry) Latest() uint32 {
+	return uint32(len(r.migrations))
+}
+
+// Check returns ErrNewerVersion if data of db has a version newer than the latest one.
+func (r *Registry) Check(db store.Reader) error {
+	version, err := store.SchemaVersion(db)
+	if err == store.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to read data version")
+	}
+	if version > r.Latest() {
+		return errors.Wrapf(ErrNewerVersion, "data version is %d, supported version is %d", version, r.Latest())
+	}
+	return nil
+}
+
+// CheckMigrated returns ErrOlderVersion if data of db isn't migrated to the latest version and ErrNewerVersion if
+// data has a version newer than the latest one. It's used by tools, that can't migrate data.
+func (r *Registry) CheckMigrated(db store.Reader) error {
+	err := r.Check(db)
+	if err != nil {
+		return err
+	}
+	version, err := store.SchemaVersion(db)
+	if err == store.ErrNotFound {
+		if store.Empty(db) {
+			return nil
+		}
+		version = 0
+	} else if err != nil {
+		return errors.Wrap(err, "failed to read data version")
+	}
+	if version < r.Latest() {
+		return errors.Wrapf(
+			ErrOlderVersion, "data version is %d, start the node to migrate data to version %d", version, r.Latest(),
+		)
+	}
+	return nil
+}
+
+// Migrate runs migrations from the version of data stored in db to the latest one. Data stored before versions were
+// introduced has zero version. Empty storage gets the latest version without migrations.
+func (r *Registry) Migrate(ctx context.Context, db store.DB) error {
+	logger := inslogger.FromContext(ctx)
+
+	err := r.Check(db)
+	if err != nil {
+		return err
+	}
+
+	version, err := store.SchemaVersion(db)
+	if err == store.ErrNotFound {
+		if store.Empty(db) {
+			logger.Infof("[Migration] new storage, data version is %d", r.Latest())
+			return r.setVersion(db, r.Latest())
+		}
+		version = 0
+	} else if err != nil {
+		return errors.Wrap(err, "failed to read data version")
+	}
+
+	if version == r.Latest() {
+		logger.Debugf("[Migration] data version is %d, nothing to migrate", version)
+		return nil
+	}
+
+	logger.Infof("[Migration] migrating data from version %d to %d", version, r.Latest())
+	for _, m := range r.migrations[version:] {
+		start := time.Now()
+		logger.Infof("[Migration] migrating data to version %d: %s", m.Version, m.Description)
+		err := m.Migrate(ctx, db)
+		if err != nil {
+			return errors.Wrapf(err, "migration to version %d failed", m.Version)
+		}
+		err = r.setVersion(db, m.Version)
+		if err != nil {
+			return err
+		}
+		logger.Infof("[Migration] data is migrated to version %d in %v", m.Version, time.Since(start))
+	}
+	return nil
+}
+
+func (r *Registry) setVersion(db store.DB, version uint32) error {
+	err := db.Update(func(txn store.Transaction) error {
+		return store.SetSchemaVersion(txn, version)
+	})
+	return errors.Wrap(err, "failed to store data version")
+}
+
+// rewriteBatch is a number of values rewritten in one transaction by Rewrite.
+const rewriteBatch = 1000
+
+type key struct {
+	scope store.Scope
+	id    []byte
+}
+
+func (k key) Scope() store.Scope {
+	return k.scope
+}
+
+func (k key) ID() []byte {
+	return k.id
+}
+
+// Rewrite replaces values of the scope with values returned by fn. If fn returns nil, the value is kept as is.
+// Values are rewritten by batches and progress is logged after every batch. It returns a number of rewritten values.
+func Rewrite(
+	ctx context.Context, db store.DB, scope store.Scope, fn func(id, value []byte) ([]byte, error),
+) (int, error) {
+	logger := inslogger.FromContext(ctx)
+
+	total := 0
+	from := []byte{}
+	for {
+		var read, written int
+		err := db.Update(func(txn store.Transaction) error {
+			var ids, values [][]byte
+			it := txn.NewRangeIterator(scope, from, nil)
+			for len(ids) < rewriteBatch && it.Next() {
+				value, err := it.Value()
+				if err != nil {
+					it.Close()
+					return err
+				}
+				ids = append(ids, append([]byte{}, it.ID()...))
+				values = append(values, append([]byte{}, value...))
+			}
+			it.Close()
+
+			read, written = len(ids), 0
+			for i, id := range ids {
+				value, err := fn(id, values[i])
+				if err != nil {
+					return errors.Wrapf(err, "failed to convert value %x", id)
+				}
+				if value == nil {
+					continue
+				}
+				err = txn.Set(key{scope: scope, id: id}, value)
+				if err != nil {
+					return err
+				}
+				written++
+			}
+			if read > 0 {
+				// The next batch starts right after the last read ID.
+				from = append(append([]byte{}, ids[read-1]...), 0)
+			}
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += written
+		if read < rewriteBatch {
+			return total, nil
+		}
+		logger.Infof("[Migration] %d values of scope %d are rewritten", total, scope)
+	}
+}
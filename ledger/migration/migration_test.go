@@ -0,0 +1,183 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package migration
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+func TestNewRegistry(t *testing.T) {
+	noop := func(context.Context, store.DB) error { return nil }
+
+	r, err := NewRegistry(Migration{Version: 1, Migrate: noop}, Migration{Version: 2, Migrate: noop})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), r.Latest())
+
+	_, err = NewRegistry(Migration{Version: 1, Migrate: noop}, Migration{Version: 3, Migrate: noop})
+	assert.Error(t, err, "versions should be consecutive")
+	_, err = NewRegistry(Migration{Version: 1})
+	assert.Error(t, err, "migration func is required")
+
+	assert.NotZero(t, Default.Latest())
+}
+
+func TestRegistry_Migrate(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	var applied []uint32
+	migration := func(version uint32) Migration {
+		return Migration{
+			Version: version,
+			Migrate: func(context.Context, store.DB) error {
+				applied = append(applied, version)
+				return nil
+			},
+		}
+	}
+	r, err := NewRegistry(migration(1), migration(2), migration(3))
+	require.NoError(t, err)
+
+	setVersion := func(db store.DB, version uint32) {
+		err := db.Update(func(txn store.Transaction) error {
+			return store.SetSchemaVersion(txn, version)
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("new storage gets latest version", func(t *testing.T) {
+		applied = nil
+		db := store.NewMemoryMockDB()
+
+		require.NoError(t, r.Migrate(ctx, db))
+		assert.Empty(t, applied)
+		version, err := store.SchemaVersion(db)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(3), version)
+	})
+
+	t.Run("storage without version is migrated from scratch", func(t *testing.T) {
+		applied = nil
+		db := store.NewMemoryMockDB()
+		require.NoError(t, db.Set(key{scope: store.ScopeRecord, id: []byte{1}}, []byte{1}))
+
+		require.NoError(t, r.Migrate(ctx, db))
+		assert.Equal(t, []uint32{1, 2, 3}, applied)
+		version, err := store.SchemaVersion(db)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(3), version)
+	})
+
+	t.Run("storage is migrated from stored version", func(t *testing.T) {
+		applied = nil
+		db := store.NewMemoryMockDB()
+		setVersion(db, 1)
+
+		require.NoError(t, r.Migrate(ctx, db))
+		assert.Equal(t, []uint32{2, 3}, applied)
+
+		applied = nil
+		require.NoError(t, r.Migrate(ctx, db))
+		assert.Empty(t, applied, "migrated storage is not migrated again")
+	})
+
+	t.Run("newer version is refused", func(t *testing.T) {
+		applied = nil
+		db := store.NewMemoryMockDB()
+		setVersion(db, 4)
+
+		err := r.Migrate(ctx, db)
+		assert.Equal(t, ErrNewerVersion, errors.Cause(err))
+		assert.Equal(t, ErrNewerVersion, errors.Cause(r.Check(db)))
+		assert.Equal(t, ErrNewerVersion, errors.Cause(r.CheckMigrated(db)))
+		assert.Empty(t, applied)
+	})
+
+	t.Run("tools require migrated storage", func(t *testing.T) {
+		db := store.NewMemoryMockDB()
+		assert.NoError(t, r.CheckMigrated(db), "empty storage")
+
+		require.NoError(t, db.Set(key{scope: store.ScopeRecord, id: []byte{1}}, []byte{1}))
+		assert.Equal(t, ErrOlderVersion, errors.Cause(r.CheckMigrated(db)), "storage without version")
+		assert.NoError(t, r.Check(db))
+
+		setVersion(db, 2)
+		assert.Equal(t, ErrOlderVersion, errors.Cause(r.CheckMigrated(db)))
+
+		setVersion(db, 3)
+		assert.NoError(t, r.CheckMigrated(db))
+	})
+
+	t.Run("failed migration keeps previous version", func(t *testing.T) {
+		failing, err := NewRegistry(migration(1), Migration{
+			Version: 2,
+			Migrate: func(context.Context, store.DB) error {
+				return errors.New("test error")
+			},
+		})
+		require.NoError(t, err)
+		db := store.NewMemoryMockDB()
+		require.NoError(t, db.Set(key{scope: store.ScopeRecord, id: []byte{1}}, []byte{1}))
+
+		assert.Error(t, failing.Migrate(ctx, db))
+		version, err := store.SchemaVersion(db)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), version)
+	})
+}
+
+func TestRewrite(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+
+	count := rewriteBatch*2 + 10
+	for i := 0; i < count; i++ {
+		id := make([]byte, 4)
+		binary.BigEndian.PutUint32(id, uint32(i))
+		require.NoError(t, db.Set(key{scope: store.ScopeRecord, id: id}, []byte{0}))
+	}
+	// Values of other scopes are not touched.
+	require.NoError(t, db.Set(key{scope: store.ScopeBlob, id: []byte{0}}, []byte{0}))
+
+	rewritten, err := Rewrite(ctx, db, store.ScopeRecord, func(id, value []byte) ([]byte, error) {
+		if binary.BigEndian.Uint32(id)%2 == 0 {
+			return nil, nil
+		}
+		return []byte{1}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, count/2, rewritten)
+
+	for i := 0; i < count; i++ {
+		id := make([]byte, 4)
+		binary.BigEndian.PutUint32(id, uint32(i))
+		value, err := db.Get(key{scope: store.ScopeRecord, id: id})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{byte(i % 2)}, value)
+	}
+	value, err := db.Get(key{scope: store.ScopeBlob, id: []byte{0}})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0}, value)
+}
@@ -0,0 +1,57 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package migration
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+// Default holds migrations of ledger data. New migrations are appended to the end.
+var Default = mustRegistry(
+	Migration{
+		Version:     1,
+		Description: "index drops by pulse, share blob values, rehash drops with Merkle roots",
+		Migrate:     steps(indexDrops, shareBlobs, rehashDrops),
+	},
+	Migration{
+		Version:     2,
+		Description: "index children by prototype",
+		Migrate:     indexChildren,
+	},
+)
+
+// steps combines migration funcs of one version, they are run in order.
+func steps(fns ...func(context.Context, store.DB) error) func(context.Context, store.DB) error {
+	return func(ctx context.Context, db store.DB) error {
+		for _, fn := range fns {
+			if err := fn(ctx, db); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func mustRegistry(migrations ...Migration) *Registry {
+	r, err := NewRegistry(migrations...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,172 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package object
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/pkg/errors"
+)
+
+// ChildIndexModifier provides methods for adding children to an index of children by prototype.
+type ChildIndexModifier interface {
+	// Set adds a child to the index of parent. Child is identified by ID of its child record and number in the list of
+	// children of the pulse, the latest child of the pulse has number 0. If prototype is nil, only the position of the
+	// child is stored, so fetching can be continued from the child.
+	Set(
+		ctx context.Context,
+		parent insolar.ID,
+		childID insolar.ID,
+		number uint32,
+		prototype *insolar.Reference,
+		child insolar.Reference,
+	) error
+}
+
+// ChildIndexAccessor provides methods for fetching children of a prototype without iteration over all children.
+type ChildIndexAccessor interface {
+	// ForPrototype returns up to limit children of parent with provided prototype in the order of the list of children,
+	// i.e. from the latest to the earliest. Children are returned starting from the position of child record from,
+	// which can be a child of any prototype, or from the latest child if from is nil. ID of the child record to
+	// continue from is returned if there are more children.
+	ForPrototype(
+		ctx context.Context, parent insolar.ID, prototype insolar.Reference, from *insolar.ID, limit int,
+	) ([]insolar.Reference, *insolar.ID, error)
+}
+
+// ChildIndexDB is a db-based index of children by prototype.
+type ChildIndexDB struct {
+	db store.DB
+}
+
+type childIndexKey struct {
+	parent    insolar.ID
+	prototype insolar.Reference
+	position  []byte
+}
+
+func (k childIndexKey) Scope() store.Scope {
+	return store.ScopeChildIndex
+}
+
+func (k childIndexKey) ID() []byte {
+	return append(childIndexPrefix(k.parent, k.prototype), k.position...)
+}
+
+type childPositionKey insolar.ID
+
+func (k childPositionKey) Scope() store.Scope {
+	return store.ScopeChildPosition
+}
+
+func (k childPositionKey) ID() []byte {
+	id := insolar.ID(k)
+	return id.Bytes()
+}
+
+func childIndexPrefix(parent insolar.ID, prototype insolar.Reference) []byte {
+	return append(append([]byte{}, parent.Bytes()...), prototype.Bytes()...)
+}
+
+// childPosition returns a part of the key that orders children from the latest pulse to the earliest and by number
+// inside of the pulse.
+func childPosition(pn insolar.PulseNumber, number uint32) []byte {
+	pos := make([]byte, 8)
+	binary.BigEndian.PutUint32(pos, math.MaxUint32-uint32(pn))
+	binary.BigEndian.PutUint32(pos[4:], number)
+	return pos
+}
+
+// NewChildIndexDB creates a new instance of ChildIndexDB.
+func NewChildIndexDB(db store.DB) *ChildIndexDB {
+	return &ChildIndexDB{db: db}
+}
+
+// Set adds a child to the index of parent. Child is identified by ID of its child record and number in the list of
+// children of the pulse, the latest child of the pulse has number 0. If prototype is nil, only the position of the
+// child is stored, so fetching can be continued from the child.
+func (i *ChildIndexDB) Set(
+	ctx context.Context,
+	parent insolar.ID,
+	childID insolar.ID,
+	number uint32,
+	prototype *insolar.Reference,
+	child insolar.Reference,
+) error {
+	position := childPosition(childID.Pulse(), number)
+	return i.db.Update(func(txn store.Transaction) error {
+		err := txn.Set(childPositionKey(childID), position)
+		if err != nil {
+			return err
+		}
+		if prototype == nil {
+			return nil
+		}
+		key := childIndexKey{parent: parent, prototype: *prototype, position: position}
+		return txn.Set(key, append(childID.Bytes(), child.Bytes()...))
+	})
+}
+
+// ForPrototype returns up to limit children of parent with provided prototype in the order of the list of children,
+// i.e. from the latest to the earliest. Children are returned starting from the position of child record from,
+// which can be a child of any prototype, or from the latest child if from is nil. ID of the child record to
+// continue from is returned if there are more children.
+func (i *ChildIndexDB) ForPrototype(
+	ctx context.Context, parent insolar.ID, prototype insolar.Reference, from *insolar.ID, limit int,
+) ([]insolar.Reference, *insolar.ID, error) {
+	prefix := childIndexPrefix(parent, prototype)
+	start := prefix
+	if from != nil {
+		position, err := i.db.Get(childPositionKey(*from))
+		if err == store.ErrNotFound {
+			// Position is unknown, so all children of the pulse are returned.
+			position = childPosition(from.Pulse(), 0)
+		} else if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to fetch position of child")
+		}
+		start = append(append([]byte{}, prefix...), position...)
+	}
+
+	it := i.db.NewRangeIterator(store.ScopeChildIndex, start, nil)
+	defer it.Close()
+
+	var refs []insolar.Reference
+	for it.Next() {
+		if !bytes.HasPrefix(it.ID(), prefix) {
+			break
+		}
+		value, err := it.Value()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to fetch child")
+		}
+		if len(value) != insolar.RecordIDSize+insolar.RecordRefSize {
+			return nil, nil, errors.New("invalid child in index")
+		}
+		if len(refs) >= limit {
+			next := insolar.ID{}
+			copy(next[:], value[:insolar.RecordIDSize])
+			return refs, &next, nil
+		}
+		refs = append(refs, insolar.Reference{}.FromSlice(value[insolar.RecordIDSize:]))
+	}
+	return refs, nil, nil
+}
@@ -0,0 +1,113 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package object
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+func TestChildIndexDB_ForPrototype(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	index := NewChildIndexDB(store.NewMemoryMockDB())
+
+	parent := gen.ID()
+	protoA, protoB := gen.Reference(), gen.Reference()
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber)
+	newID := func(pn insolar.PulseNumber) insolar.ID {
+		id := gen.ID()
+		return *insolar.NewID(pn, id.Hash())
+	}
+
+	type child struct {
+		id    insolar.ID
+		proto *insolar.Reference
+		ref   insolar.Reference
+	}
+	// Children in the order of the list, from the latest to the earliest.
+	var children []child
+	for _, c := range []struct {
+		pn    insolar.PulseNumber
+		proto *insolar.Reference
+	}{
+		{pn + 10, &protoA},
+		{pn + 10, &protoB},
+		{pn + 10, &protoA},
+		{pn, nil},
+		{pn, &protoA},
+	} {
+		children = append(children, child{id: newID(c.pn), proto: c.proto, ref: gen.Reference()})
+	}
+	numbers := []uint32{0, 1, 2, 0, 1}
+	for i, c := range children {
+		err := index.Set(ctx, parent, c.id, numbers[i], c.proto, c.ref)
+		require.NoError(t, err)
+	}
+
+	t.Run("returns children of prototype from the latest", func(t *testing.T) {
+		refs, next, err := index.ForPrototype(ctx, parent, protoA, nil, 10)
+		require.NoError(t, err)
+		assert.Nil(t, next)
+		assert.Equal(t, []insolar.Reference{children[0].ref, children[2].ref, children[4].ref}, refs)
+
+		refs, next, err = index.ForPrototype(ctx, parent, protoB, nil, 10)
+		require.NoError(t, err)
+		assert.Nil(t, next)
+		assert.Equal(t, []insolar.Reference{children[1].ref}, refs)
+
+		refs, _, err = index.ForPrototype(ctx, gen.ID(), protoA, nil, 10)
+		require.NoError(t, err)
+		assert.Empty(t, refs)
+	})
+
+	t.Run("paginates", func(t *testing.T) {
+		refs, next, err := index.ForPrototype(ctx, parent, protoA, nil, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []insolar.Reference{children[0].ref, children[2].ref}, refs)
+		require.NotNil(t, next)
+		assert.Equal(t, children[4].id, *next)
+
+		refs, next, err = index.ForPrototype(ctx, parent, protoA, next, 2)
+		require.NoError(t, err)
+		assert.Nil(t, next)
+		assert.Equal(t, []insolar.Reference{children[4].ref}, refs)
+	})
+
+	t.Run("continues from child of another prototype", func(t *testing.T) {
+		refs, _, err := index.ForPrototype(ctx, parent, protoA, &children[1].id, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []insolar.Reference{children[2].ref, children[4].ref}, refs)
+
+		refs, _, err = index.ForPrototype(ctx, parent, protoA, &children[3].id, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []insolar.Reference{children[4].ref}, refs)
+	})
+
+	t.Run("continues from the pulse of unknown child", func(t *testing.T) {
+		unknown := newID(pn + 5)
+		refs, _, err := index.ForPrototype(ctx, parent, protoA, &unknown, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []insolar.Reference{children[4].ref}, refs)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 )
 
@@ -350,20 +351,22 @@ func (i *IndexDB) Set(ctx context.Context, pn insolar.PulseNumber, objID insolar
 		lifeline.Delegates = []LifelineDelegate{}
 	}
 
-	buc, err := i.getBucket(pn, objID)
-	if err == ErrIndexBucketNotFound {
-		buc = &IndexBucket{}
-	} else if err != nil {
-		return err
-	}
+	err := i.db.Update(func(txn store.Transaction) error {
+		buc, err := getBucket(txn, pn, objID)
+		if err == ErrIndexBucketNotFound {
+			buc = &IndexBucket{ObjID: objID}
+		} else if err != nil {
+			return err
+		}
 
-	buc.Lifeline = lifeline
-	err = i.setBucket(pn, objID, buc)
-	if err != nil {
-		return err
-	}
+		buc.Lifeline = lifeline
+		err = setBucket(txn, pn, objID, buc)
+		if err != nil {
+			return err
+		}
 
-	err = i.setLastKnownPN(pn, objID)
+		return i.setLastKnownPN(txn, pn, objID)
+	})
 	if err != nil {
 		return err
 	}
@@ -382,7 +385,13 @@ func (i *IndexDB) SetBucket(ctx context.Context, pn insolar.PulseNumber, bucket
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
-	err := i.setBucket(pn, bucket.ObjID, &bucket)
+	err := i.db.Update(func(txn store.Transaction) error {
+		err := setBucket(txn, pn, bucket.ObjID, &bucket)
+		if err != nil {
+			return err
+		}
+		return i.setLastKnownPN(txn, pn, bucket.ObjID)
+	})
 	if err != nil {
 		return err
 	}
@@ -392,31 +401,222 @@ func (i *IndexDB) SetBucket(ctx context.Context, pn insolar.PulseNumber, bucket
 	)
 
 	inslogger.FromContext(ctx).Debugf("[SetBucket] bucket for obj - %v was set successfully", bucket.ObjID.DebugString())
-	return i.setLastKnownPN(pn, bucket.ObjID)
+	return nil
 }
 
 // ForID returns a lifeline from a bucket with provided PN and ObjID
 func (i *IndexDB) ForID(ctx context.Context, pn insolar.PulseNumber, objID insolar.ID) (Lifeline, error) {
-	var buck *IndexBucket
-	buck, err := i.getBucket(pn, objID)
+	buck, err := i.BucketForID(ctx, pn, objID)
+	if err != nil {
+		return Lifeline{}, err
+	}
+	return buck.Lifeline, nil
+}
+
+// BucketForID returns a bucket with provided PN and ObjID. If there is no bucket for the pulse, the bucket of the last
+// known pulse of the object is returned.
+func (i *IndexDB) BucketForID(ctx context.Context, pn insolar.PulseNumber, objID insolar.ID) (IndexBucket, error) {
+	buck, err := getBucket(i.db, pn, objID)
 	if err == ErrIndexBucketNotFound {
-		lastPN, err := i.getLastKnownPN(objID)
+		lastPN, err := i.getLastKnownPN(i.db, objID)
 		if err != nil {
-			return Lifeline{}, ErrLifelineNotFound
+			return IndexBucket{}, ErrLifelineNotFound
 		}
 
-		buck, err = i.getBucket(lastPN, objID)
+		buck, err = getBucket(i.db, lastPN, objID)
 		if err != nil {
-			return Lifeline{}, err
+			return IndexBucket{}, err
 		}
 	} else if err != nil {
-		return Lifeline{}, err
+		return IndexBucket{}, err
+	}
+
+	return *buck, nil
+}
+
+// LastKnownForID returns a bucket of the last known pulse of the object.
+func (i *IndexDB) LastKnownForID(ctx context.Context, objID insolar.ID) (IndexBucket, error) {
+	lastPN, err := i.getLastKnownPN(i.db, objID)
+	if err != nil {
+		return IndexBucket{}, ErrLifelineNotFound
+	}
+	buck, err := getBucket(i.db, lastPN, objID)
+	if err != nil {
+		return IndexBucket{}, err
+	}
+	return *buck, nil
+}
+
+// ObjectIDs returns IDs of all objects with stored buckets.
+func (i *IndexDB) ObjectIDs(ctx context.Context) []insolar.ID {
+	var ids []insolar.ID
+	it := i.db.NewIterator(store.ScopeLastKnownIndexPN, nil)
+	defer it.Close()
+	for it.Next() {
+		var id insolar.ID
+		copy(id[:], it.ID())
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// ForPNAndJet returns a collection of buckets for a provided pn and jetID
+func (i *IndexDB) ForPNAndJet(ctx context.Context, pn insolar.PulseNumber, jetID insolar.JetID) []IndexBucket {
+	return bucketsForPNAndJet(ctx, i.db, pn, jetID)
+}
+
+// ForPN returns a collection of buckets of all jets for a provided pn
+func (i *IndexDB) ForPN(ctx context.Context, pn insolar.PulseNumber) []IndexBucket {
+	return bucketsForPN(ctx, i.db, pn, func(IndexBucket) bool { return true })
+}
+
+// DiskIndex is a db-based storage, that stores a collection of IndexBuckets and behaves like InMemoryIndex. Unlike
+// IndexDB it doesn't fall back to the last known pulse of an object, so it can replace InMemoryIndex on light nodes.
+type DiskIndex struct {
+	lock sync.Mutex
+	db   store.DB
+}
+
+// NewDiskIndex creates a new instance of DiskIndex
+func NewDiskIndex(db store.DB) *DiskIndex {
+	return &DiskIndex{db: db}
+}
+
+// Set sets a lifeline to a bucket with provided pulseNumber and ID
+func (i *DiskIndex) Set(ctx context.Context, pn insolar.PulseNumber, objID insolar.ID, lifeline Lifeline) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	err := i.db.Update(func(txn store.Transaction) error {
+		buc, err := getBucket(txn, pn, objID)
+		if err == ErrIndexBucketNotFound {
+			buc = &IndexBucket{ObjID: objID}
+		} else if err != nil {
+			return err
+		}
+
+		buc.Lifeline = lifeline
+		buc.LifelineLastUsed = pn
+		return setBucket(txn, pn, objID, buc)
+	})
+	if err != nil {
+		return err
 	}
 
+	stats.Record(ctx,
+		statIndexDBAddedCount.M(1),
+	)
+
+	inslogger.FromContext(ctx).Debugf("[Set] lifeline for obj - %v was set successfully", objID.DebugString())
+	return nil
+}
+
+// SetBucket adds a bucket with provided pulseNumber and ID
+func (i *DiskIndex) SetBucket(ctx context.Context, pn insolar.PulseNumber, bucket IndexBucket) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	err := i.db.Update(func(txn store.Transaction) error {
+		return setBucket(txn, pn, bucket.ObjID, &bucket)
+	})
+	if err != nil {
+		return err
+	}
+
+	stats.Record(ctx,
+		statIndexDBAddedCount.M(1),
+	)
+
+	return nil
+}
+
+// ForID returns a lifeline from a bucket with provided PN and ObjID
+func (i *DiskIndex) ForID(ctx context.Context, pn insolar.PulseNumber, objID insolar.ID) (Lifeline, error) {
+	buck, err := getBucket(i.db, pn, objID)
+	if err == ErrIndexBucketNotFound {
+		return Lifeline{}, ErrLifelineNotFound
+	}
+	if err != nil {
+		return Lifeline{}, err
+	}
 	return buck.Lifeline, nil
 }
 
-func (i *IndexDB) setBucket(pn insolar.PulseNumber, objID insolar.ID, bucket *IndexBucket) error {
+// ForPNAndJet returns a collection of buckets for a provided pn and jetID
+func (i *DiskIndex) ForPNAndJet(ctx context.Context, pn insolar.PulseNumber, jetID insolar.JetID) []IndexBucket {
+	return bucketsForPNAndJet(ctx, i.db, pn, jetID)
+}
+
+// SetLifelineUsage updates a last usage fields of a bucket for a provided pulseNumber and an object id
+func (i *DiskIndex) SetLifelineUsage(ctx context.Context, pn insolar.PulseNumber, objID insolar.ID) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	return i.db.Update(func(txn store.Transaction) error {
+		buc, err := getBucket(txn, pn, objID)
+		if err == ErrIndexBucketNotFound {
+			return ErrLifelineNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		buc.LifelineLastUsed = pn
+		return setBucket(txn, pn, objID, buc)
+	})
+}
+
+// DeleteForPN deletes all buckets for a provided pulse number
+func (i *DiskIndex) DeleteForPN(ctx context.Context, pn insolar.PulseNumber) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	count, err := store.DeletePrefix(i.db, store.ScopeIndex, pn.Bytes())
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrapf(err, "failed to delete index buckets for pulse %v", pn))
+	}
+	stats.Record(ctx,
+		statIndexInMemoryRemovedCount.M(int64(count)),
+	)
+}
+
+func bucketsForPNAndJet(
+	ctx context.Context, r store.Reader, pn insolar.PulseNumber, jetID insolar.JetID,
+) []IndexBucket {
+	return bucketsForPN(ctx, r, pn, func(bucket IndexBucket) bool {
+		return bucket.Lifeline.JetID == jetID
+	})
+}
+
+func bucketsForPN(
+	ctx context.Context, r store.Reader, pn insolar.PulseNumber, filter func(IndexBucket) bool,
+) []IndexBucket {
+	it := r.NewIterator(store.ScopeIndex, pn.Bytes())
+	defer it.Close()
+
+	res := []IndexBucket{}
+	for it.Next() {
+		buff, err := it.Value()
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to read index bucket"))
+			continue
+		}
+		bucket := IndexBucket{}
+		err = bucket.Unmarshal(buff)
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to unmarshal index bucket"))
+			continue
+		}
+		if !filter(bucket) {
+			continue
+		}
+		res = append(res, bucket)
+	}
+
+	return res
+}
+
+func setBucket(txn store.Transaction, pn insolar.PulseNumber, objID insolar.ID, bucket *IndexBucket) error {
 	key := indexKey{pn: pn, objID: objID}
 
 	buff, err := bucket.Marshal()
@@ -424,11 +624,11 @@ func (i *IndexDB) setBucket(pn insolar.PulseNumber, objID insolar.ID, bucket *In
 		return err
 	}
 
-	return i.db.Set(key, buff)
+	return txn.Set(key, buff)
 }
 
-func (i *IndexDB) getBucket(pn insolar.PulseNumber, objID insolar.ID) (*IndexBucket, error) {
-	buff, err := i.db.Get(indexKey{pn: pn, objID: objID})
+func getBucket(r store.Reader, pn insolar.PulseNumber, objID insolar.ID) (*IndexBucket, error) {
+	buff, err := r.Get(indexKey{pn: pn, objID: objID})
 	if err == store.ErrNotFound {
 		return nil, ErrIndexBucketNotFound
 
@@ -441,13 +641,13 @@ func (i *IndexDB) getBucket(pn insolar.PulseNumber, objID insolar.ID) (*IndexBuc
 	return &bucket, err
 }
 
-func (i *IndexDB) setLastKnownPN(pn insolar.PulseNumber, objID insolar.ID) error {
+func (i *IndexDB) setLastKnownPN(txn store.Transaction, pn insolar.PulseNumber, objID insolar.ID) error {
 	key := lastKnownIndexPNKey{objID: objID}
-	return i.db.Set(key, pn.Bytes())
+	return txn.Set(key, pn.Bytes())
 }
 
-func (i *IndexDB) getLastKnownPN(objID insolar.ID) (insolar.PulseNumber, error) {
-	buff, err := i.db.Get(lastKnownIndexPNKey{objID: objID})
+func (i *IndexDB) getLastKnownPN(r store.Reader, objID insolar.ID) (insolar.PulseNumber, error) {
+	buff, err := r.Get(lastKnownIndexPNKey{objID: objID})
 	if err != nil {
 		return insolar.FirstPulseNumber, err
 	}
@@ -122,4 +122,144 @@ func TestIndex_Components(t *testing.T) {
 			assert.NoError(t, dbErr)
 		}
 	})
+
+	t.Run("returns buckets for pulse and jet", func(t *testing.T) {
+		t.Parallel()
+
+		indexMemory := object.NewInMemoryIndex()
+		indexDB := object.NewIndexDB(store.NewMemoryMockDB())
+
+		jetID := gen.JetID()
+		for n, i := range indices {
+			bucket := object.IndexBucket{ObjID: i.id, Lifeline: i.idx}
+			if n%2 == 0 {
+				bucket.Lifeline.JetID = jetID
+			}
+			memErr := indexMemory.SetBucket(ctx, pn, bucket)
+			dbErr := indexDB.SetBucket(ctx, pn, bucket)
+			require.NoError(t, memErr)
+			require.NoError(t, dbErr)
+
+			bucket.Lifeline.JetID = jetID
+			memErr = indexMemory.SetBucket(ctx, pn+1, bucket)
+			dbErr = indexDB.SetBucket(ctx, pn+1, bucket)
+			require.NoError(t, memErr)
+			require.NoError(t, dbErr)
+		}
+
+		objects := func(buckets []object.IndexBucket) []insolar.ID {
+			var res []insolar.ID
+			for _, b := range buckets {
+				assert.Equal(t, jetID, b.Lifeline.JetID)
+				res = append(res, b.ObjID)
+			}
+			return res
+		}
+		memObjects := objects(indexMemory.ForPNAndJet(ctx, pn, jetID))
+		dbObjects := objects(indexDB.ForPNAndJet(ctx, pn, jetID))
+		assert.Len(t, dbObjects, (len(indices)+1)/2)
+		assert.ElementsMatch(t, memObjects, dbObjects)
+	})
+}
+
+func TestIndex_DiskComponents(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	type index interface {
+		object.LifelineIndex
+		object.IndexBucketModifier
+		object.IndexBucketAccessor
+		object.LifelineStateModifier
+		object.IndexCleaner
+	}
+	newIndexes := func() map[string]index {
+		return map[string]index{
+			"memory": object.NewInMemoryIndex(),
+			"disk":   object.NewDiskIndex(store.NewMemoryMockDB()),
+		}
+	}
+
+	jetID := gen.JetID()
+	objects := make([]insolar.ID, 10)
+	for n := range objects {
+		objects[n] = gen.ID()
+	}
+	lifeline := func(n int) object.Lifeline {
+		ls := gen.ID()
+		lifeline := object.Lifeline{LatestState: &ls, JetID: gen.JetID(), Delegates: []object.LifelineDelegate{}}
+		if n%2 == 0 {
+			lifeline.JetID = jetID
+		}
+		return lifeline
+	}
+	pn := gen.PulseNumber()
+
+	t.Run("returns lifelines only for their pulse", func(t *testing.T) {
+		t.Parallel()
+
+		indexes := newIndexes()
+		lifelines := map[insolar.ID]object.Lifeline{}
+		for n, id := range objects {
+			lifelines[id] = lifeline(n)
+			for _, idx := range indexes {
+				require.NoError(t, idx.Set(ctx, pn, id, lifelines[id]))
+			}
+		}
+
+		for name, idx := range indexes {
+			for _, id := range objects {
+				res, err := idx.ForID(ctx, pn, id)
+				require.NoError(t, err, name)
+				assert.Equal(t, lifelines[id].LatestState, res.LatestState, name)
+
+				_, err = idx.ForID(ctx, pn+1, id)
+				assert.Equal(t, object.ErrLifelineNotFound, err, name)
+			}
+		}
+	})
+
+	t.Run("updates lifeline usage", func(t *testing.T) {
+		t.Parallel()
+
+		indexes := newIndexes()
+		for n, id := range objects {
+			for _, idx := range indexes {
+				require.NoError(t, idx.SetBucket(ctx, pn, object.IndexBucket{ObjID: id, Lifeline: lifeline(n)}))
+			}
+		}
+
+		for name, idx := range indexes {
+			for _, id := range objects {
+				require.NoError(t, idx.SetLifelineUsage(ctx, pn, id), name)
+				assert.Equal(t, object.ErrLifelineNotFound, idx.SetLifelineUsage(ctx, pn+1, id), name)
+			}
+			for _, b := range idx.ForPNAndJet(ctx, pn, jetID) {
+				assert.Equal(t, pn, b.LifelineLastUsed, name)
+			}
+		}
+	})
+
+	t.Run("deletes buckets for pulse", func(t *testing.T) {
+		t.Parallel()
+
+		indexes := newIndexes()
+		for n, id := range objects {
+			for _, idx := range indexes {
+				require.NoError(t, idx.Set(ctx, pn, id, lifeline(n)))
+				require.NoError(t, idx.Set(ctx, pn+1, id, lifeline(n)))
+			}
+		}
+
+		for name, idx := range indexes {
+			idx.DeleteForPN(ctx, pn)
+			assert.Empty(t, idx.ForPNAndJet(ctx, pn, jetID), name)
+			assert.Len(t, idx.ForPNAndJet(ctx, pn+1, jetID), len(objects)/2, name)
+			for _, id := range objects {
+				_, err := idx.ForID(ctx, pn, id)
+				assert.Equal(t, object.ErrLifelineNotFound, err, name)
+			}
+		}
+	})
 }
@@ -142,6 +142,23 @@ func TestIndexStorage_ForID(t *testing.T) {
 
 		assert.Equal(t, ErrLifelineNotFound, err)
 	})
+
+	t.Run("returns bucket of last known pulse", func(t *testing.T) {
+		t.Parallel()
+
+		storage := NewIndexDB(store.NewMemoryMockDB())
+		pn := gen.PulseNumber()
+		reqID := gen.ID()
+
+		err := storage.SetBucket(ctx, pn, IndexBucket{ObjID: id, Lifeline: idx, Requests: []insolar.ID{reqID}})
+		require.NoError(t, err)
+
+		res, err := storage.BucketForID(ctx, pn+1, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, res.ObjID)
+		assert.Equal(t, []insolar.ID{reqID}, res.Requests)
+		assert.Equal(t, idx.LatestState, res.Lifeline.LatestState)
+	})
 }
 
 func TestInMemoryIndex_ForPNAndJet(t *testing.T) {
@@ -469,3 +486,45 @@ func TestDBIndex_SetBucket(t *testing.T) {
 		assert.Equal(t, idxBuf, resBuf)
 	})
 }
+
+func TestDBIndex_ForPN(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	index := NewIndexDB(store.NewMemoryMockDB())
+
+	pn := gen.PulseNumber()
+	firstID := gen.ID()
+	secondID := gen.ID()
+	firstState := gen.ID()
+	secondState := gen.ID()
+
+	err := index.Set(ctx, pn, firstID, Lifeline{LatestState: &firstState, JetID: gen.JetID()})
+	require.NoError(t, err)
+	err = index.Set(ctx, pn, secondID, Lifeline{LatestState: &secondState, JetID: gen.JetID()})
+	require.NoError(t, err)
+	err = index.Set(ctx, pn+1, firstID, Lifeline{LatestState: &secondState, JetID: gen.JetID()})
+	require.NoError(t, err)
+
+	t.Run("returns buckets of all jets", func(t *testing.T) {
+		res := index.ForPN(ctx, pn)
+		require.Len(t, res, 2)
+		states := map[insolar.ID]insolar.ID{}
+		for _, b := range res {
+			states[b.ObjID] = *b.Lifeline.LatestState
+		}
+		assert.Equal(t, map[insolar.ID]insolar.ID{firstID: firstState, secondID: secondState}, states)
+
+		assert.Len(t, index.ForPN(ctx, pn+1), 1)
+		assert.Empty(t, index.ForPN(ctx, pn+2))
+	})
+
+	t.Run("returns bucket of last known pulse", func(t *testing.T) {
+		res, err := index.LastKnownForID(ctx, firstID)
+		require.NoError(t, err)
+		assert.Equal(t, secondState, *res.Lifeline.LatestState)
+
+		_, err = index.LastKnownForID(ctx, gen.ID())
+		assert.Equal(t, ErrLifelineNotFound, err)
+	})
+}
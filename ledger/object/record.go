@@ -20,10 +20,12 @@ import (
 	"context"
 	"sync"
 
+	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/internal/ledger/store"
 )
 
@@ -156,10 +158,12 @@ func (m *RecordMemory) DeleteForPN(ctx context.Context, pulse insolar.PulseNumbe
 	}
 }
 
-// RecordDB is a DB storage implementation. It saves records to disk and does not allow removal.
+// RecordDB is a DB storage implementation. It saves records to disk and allows removal only by pulse.
 type RecordDB struct {
 	lock sync.RWMutex
 	db   store.DB
+	// jetIndex is set for hot data, records of a jet are looked up with it instead of scanning all records of a pulse.
+	jetIndex *store.JetIndexDB
 }
 
 type recordKey insolar.ID
@@ -178,12 +182,25 @@ func NewRecordDB(db store.DB) *RecordDB {
 	return &RecordDB{db: db}
 }
 
+// NewRecordDBWithJetIndex creates new DB storage instance, that indexes records by jet in the same db.
+// It's used for hot data of light nodes, that is queried by jet.
+func NewRecordDBWithJetIndex(db store.DB) *RecordDB {
+	return &RecordDB{db: db, jetIndex: store.NewJetIndexDB(db)}
+}
+
 // Set saves new record-value in storage.
 func (r *RecordDB) Set(ctx context.Context, id insolar.ID, rec record.Material) error {
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
-	return r.set(id, rec)
+	err := r.set(id, rec)
+	if err != nil {
+		return err
+	}
+	if r.jetIndex != nil {
+		r.jetIndex.Add(id, rec.JetID)
+	}
+	return nil
 }
 
 // ForID returns record for provided id.
@@ -194,20 +211,124 @@ func (r *RecordDB) ForID(ctx context.Context, id insolar.ID) (record.Material, e
 	return r.get(id)
 }
 
-func (r *RecordDB) set(id insolar.ID, rec record.Material) error {
-	key := recordKey(id)
+// ForPulse returns []MaterialRecord for a provided jetID and a pulse number.
+func (r *RecordDB) ForPulse(
+	ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber,
+) []record.Material {
+	if r.jetIndex != nil {
+		return r.forPulseIndexed(ctx, jetID, pn)
+	}
 
-	_, err := r.db.Get(key)
-	if err == nil {
-		return ErrOverride
+	it := r.db.NewIterator(store.ScopeRecord, pn.Bytes())
+	defer it.Close()
+
+	var res []record.Material
+	for it.Next() {
+		buff, err := it.Value()
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to read record"))
+			continue
+		}
+		rec := record.Material{}
+		err = rec.Unmarshal(buff)
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to unmarshal record"))
+			continue
+		}
+		if rec.JetID != jetID {
+			continue
+		}
+		res = append(res, rec)
+	}
+
+	return res
+}
+
+// DeleteForPN method removes records from a storage for a pulse.
+func (r *RecordDB) DeleteForPN(ctx context.Context, pulse insolar.PulseNumber) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var jets map[insolar.ID]insolar.JetID
+	if r.jetIndex != nil {
+		jets = r.jetsForPulse(ctx, pulse)
 	}
 
+	_, err := store.DeletePrefix(r.db, store.ScopeRecord, pulse.Bytes())
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrapf(err, "failed to delete records for pulse %v", pulse))
+		return
+	}
+	for id, jetID := range jets {
+		r.jetIndex.Delete(id, jetID)
+	}
+}
+
+// forPulseIndexed returns records of a jet and a pulse found by jet index.
+func (r *RecordDB) forPulseIndexed(
+	ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber,
+) []record.Material {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	var res []record.Material
+	for id := range r.jetIndex.For(jetID) {
+		if id.Pulse() != pn {
+			continue
+		}
+		rec, err := r.get(id)
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrapf(err, "failed to read record %v", id.DebugString()))
+			continue
+		}
+		res = append(res, rec)
+	}
+	return res
+}
+
+// jetsForPulse returns jets of records of a pulse.
+func (r *RecordDB) jetsForPulse(ctx context.Context, pn insolar.PulseNumber) map[insolar.ID]insolar.JetID {
+	it := r.db.NewIterator(store.ScopeRecord, pn.Bytes())
+	defer it.Close()
+
+	jets := map[insolar.ID]insolar.JetID{}
+	for it.Next() {
+		buff, err := it.Value()
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to read record"))
+			continue
+		}
+		rec := record.Material{}
+		err = rec.Unmarshal(buff)
+		if err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to unmarshal record"))
+			continue
+		}
+		var id insolar.ID
+		copy(id[:], it.ID())
+		jets[id] = rec.JetID
+	}
+	return jets
+}
+
+func (r *RecordDB) set(id insolar.ID, rec record.Material) error {
+	key := recordKey(id)
+
 	data, err := rec.Marshal()
 	if err != nil {
 		return err
 	}
 
-	return r.db.Set(key, data)
+	return r.db.Update(func(txn store.Transaction) error {
+		_, err := txn.Get(key)
+		if err == nil {
+			return ErrOverride
+		}
+		if err != store.ErrNotFound {
+			return err
+		}
+		return txn.Set(key, data)
+	})
 }
 
 func (r *RecordDB) get(id insolar.ID) (record.Material, error) {
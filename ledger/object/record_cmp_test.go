@@ -108,6 +108,98 @@ func TestRecord_Components(t *testing.T) {
 			assert.Equal(t, object.ErrOverride, dbErr)
 		}
 	})
+
+	t.Run("returns records for pulse and jet", func(t *testing.T) {
+		t.Parallel()
+
+		memStorage := object.NewRecordMemory()
+		dbStorage := object.NewRecordDB(store.NewMemoryMockDB())
+
+		jetID := gen.JetID()
+		pn := gen.PulseNumber()
+		for i, r := range records {
+			id := *insolar.NewID(pn, r.id.Hash())
+			if i%2 == 0 {
+				id = *insolar.NewID(pn+1, r.id.Hash())
+			}
+			rec := r.rec
+			if i%3 != 0 {
+				rec.JetID = jetID
+			}
+
+			memErr := memStorage.Set(ctx, id, rec)
+			dbErr := dbStorage.Set(ctx, id, rec)
+			require.NoError(t, memErr)
+			require.NoError(t, dbErr)
+		}
+
+		memRecords := memStorage.ForPulse(ctx, jetID, pn)
+		dbRecords := dbStorage.ForPulse(ctx, jetID, pn)
+		require.NotEmpty(t, dbRecords)
+		assert.ElementsMatch(t, memRecords, dbRecords)
+	})
+
+	t.Run("returns records for pulse and jet with jet index", func(t *testing.T) {
+		t.Parallel()
+
+		memStorage := object.NewRecordMemory()
+		dbStorage := object.NewRecordDBWithJetIndex(store.NewMemoryMockDB())
+
+		jetID := gen.JetID()
+		pn := gen.PulseNumber()
+		for i, r := range records {
+			id := *insolar.NewID(pn+insolar.PulseNumber(i%2), r.id.Hash())
+			rec := r.rec
+			if i%3 != 0 {
+				rec.JetID = jetID
+			}
+
+			require.NoError(t, memStorage.Set(ctx, id, rec))
+			require.NoError(t, dbStorage.Set(ctx, id, rec))
+		}
+
+		dbRecords := dbStorage.ForPulse(ctx, jetID, pn)
+		require.NotEmpty(t, dbRecords)
+		assert.ElementsMatch(t, memStorage.ForPulse(ctx, jetID, pn), dbRecords)
+
+		memStorage.DeleteForPN(ctx, pn)
+		dbStorage.DeleteForPN(ctx, pn)
+
+		assert.Empty(t, dbStorage.ForPulse(ctx, jetID, pn))
+		assert.ElementsMatch(t, memStorage.ForPulse(ctx, jetID, pn+1), dbStorage.ForPulse(ctx, jetID, pn+1))
+	})
+
+	t.Run("deletes records for pulse", func(t *testing.T) {
+		t.Parallel()
+
+		memStorage := object.NewRecordMemory()
+		dbStorage := object.NewRecordDB(store.NewMemoryMockDB())
+
+		pn := gen.PulseNumber()
+		var ids []insolar.ID
+		for i, r := range records {
+			id := *insolar.NewID(pn+insolar.PulseNumber(i%2), r.id.Hash())
+			require.NoError(t, memStorage.Set(ctx, id, r.rec))
+			require.NoError(t, dbStorage.Set(ctx, id, r.rec))
+			ids = append(ids, id)
+		}
+
+		memStorage.DeleteForPN(ctx, pn)
+		dbStorage.DeleteForPN(ctx, pn)
+
+		for _, id := range ids {
+			memRec, memErr := memStorage.ForID(ctx, id)
+			dbRec, dbErr := dbStorage.ForID(ctx, id)
+			if id.Pulse() == pn {
+				assert.Equal(t, object.ErrNotFound, memErr)
+				assert.Equal(t, object.ErrNotFound, dbErr)
+				continue
+			}
+			require.NoError(t, memErr)
+			require.NoError(t, dbErr)
+			assert.Equal(t, memRec, dbRec)
+		}
+	})
 }
 
 // getVirtualRecord generates random Virtual record
@@ -63,8 +63,11 @@ type Client interface {
 
 	// GetChildren returns children iterator.
 	//
-	// During iteration children refs will be fetched from remote source (parent object).
-	GetChildren(ctx context.Context, parent insolar.Reference, pulse *insolar.PulseNumber) (RefIterator, error)
+	// During iteration children refs will be fetched from remote source (parent object). If prototype is provided,
+	// only children of the prototype are returned.
+	GetChildren(
+		ctx context.Context, parent insolar.Reference, prototype *insolar.Reference, pulse *insolar.PulseNumber,
+	) (RefIterator, error)
 
 	// DeclareType creates new type record in storage.
 	//
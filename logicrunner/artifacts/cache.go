@@ -0,0 +1,156 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package artifacts
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+const (
+	descriptorCacheLimit = 1000
+)
+
+type cacheEntry struct {
+	ref    insolar.Reference
+	code   CodeDescriptor
+	object ObjectDescriptor
+	// pulse is a pulse the entry was fetched in.
+	pulse insolar.PulseNumber
+}
+
+// descriptorCache is a size-bounded read-through cache of descriptors fetched by client.
+//
+// Code records never change, so code descriptors are kept until evicted. Object states, prototypes included, are
+// changed by their executors, which can be other nodes in the next pulse, so they are valid only in the pulse they
+// were fetched in and are dropped when this client changes them.
+type descriptorCache struct {
+	lock    sync.Mutex
+	limit   int
+	entries map[insolar.Reference]*list.Element
+	lru     *list.List // of *cacheEntry, the most recently used at front
+}
+
+// newDescriptorCache creates cache that keeps up to limit descriptors. Zero limit disables caching.
+func newDescriptorCache(limit int) *descriptorCache {
+	return &descriptorCache{
+		limit:   limit,
+		entries: map[insolar.Reference]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+// code returns cached code descriptor or nil.
+func (c *descriptorCache) code(ctx context.Context, ref insolar.Reference) CodeDescriptor {
+	entry := c.get(ctx, "GetCode", ref, func(e *cacheEntry) bool {
+		return e.code != nil
+	})
+	if entry == nil {
+		return nil
+	}
+	return entry.code
+}
+
+// object returns cached object descriptor or nil. Object states fetched in previous pulses are dropped.
+func (c *descriptorCache) object(ctx context.Context, head insolar.Reference, pulse insolar.PulseNumber) ObjectDescriptor {
+	entry := c.get(ctx, "GetObject", head, func(e *cacheEntry) bool {
+		return e.object != nil && e.pulse == pulse
+	})
+	if entry == nil {
+		return nil
+	}
+	return entry.object
+}
+
+// setCode puts code descriptor to cache.
+func (c *descriptorCache) setCode(ctx context.Context, desc CodeDescriptor) {
+	c.set(ctx, &cacheEntry{ref: *desc.Ref(), code: desc})
+}
+
+// setObject puts object descriptor fetched in provided pulse to cache.
+func (c *descriptorCache) setObject(
+	ctx context.Context, head insolar.Reference, desc ObjectDescriptor, pulse insolar.PulseNumber,
+) {
+	c.set(ctx, &cacheEntry{ref: head, object: desc, pulse: pulse})
+}
+
+// invalidate removes descriptor from cache.
+func (c *descriptorCache) invalidate(ref insolar.Reference) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if elem, ok := c.entries[ref]; ok {
+		c.lru.Remove(elem)
+		delete(c.entries, ref)
+	}
+}
+
+func (c *descriptorCache) get(
+	ctx context.Context, method string, ref insolar.Reference, valid func(*cacheEntry) bool,
+) *cacheEntry {
+	if c.limit <= 0 {
+		return nil
+	}
+	ctx = insmetrics.InsertTag(ctx, tagMethod, method)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elem, ok := c.entries[ref]
+	if !ok {
+		stats.Record(ctx, statCacheMisses.M(1))
+		return nil
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !valid(entry) {
+		c.lru.Remove(elem)
+		delete(c.entries, ref)
+		stats.Record(ctx, statCacheMisses.M(1))
+		return nil
+	}
+
+	c.lru.MoveToFront(elem)
+	stats.Record(ctx, statCacheHits.M(1))
+	return entry
+}
+
+func (c *descriptorCache) set(ctx context.Context, entry *cacheEntry) {
+	if c.limit <= 0 {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if elem, ok := c.entries[entry.ref]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[entry.ref] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.limit {
+		evicted := c.lru.Remove(c.lru.Back()).(*cacheEntry)
+		delete(c.entries, evicted.ref)
+		stats.Record(ctx, statCacheEvictions.M(1))
+	}
+}
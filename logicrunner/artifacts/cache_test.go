@@ -0,0 +1,74 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+package artifacts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+func TestDescriptorCache_Code(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	cache := newDescriptorCache(2)
+
+	first := &codeDescriptor{ref: gen.Reference(), code: []byte{1}}
+	second := &codeDescriptor{ref: gen.Reference(), code: []byte{2}}
+	third := &codeDescriptor{ref: gen.Reference(), code: []byte{3}}
+
+	cache.setCode(ctx, first)
+	cache.setCode(ctx, second)
+	assert.Equal(t, first, cache.code(ctx, first.ref))
+
+	// Second is the least recently used, so it is evicted.
+	cache.setCode(ctx, third)
+	assert.Nil(t, cache.code(ctx, second.ref))
+	assert.Equal(t, first, cache.code(ctx, first.ref))
+	assert.Equal(t, third, cache.code(ctx, third.ref))
+}
+
+func TestDescriptorCache_Object(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	cache := newDescriptorCache(10)
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber)
+
+	obj := &objectDescriptor{head: gen.Reference()}
+	proto := &objectDescriptor{head: gen.Reference(), isPrototype: true}
+	cache.setObject(ctx, obj.head, obj, pn)
+	cache.setObject(ctx, proto.head, proto, pn)
+
+	assert.Equal(t, obj, cache.object(ctx, obj.head, pn))
+	assert.Equal(t, proto, cache.object(ctx, proto.head, pn))
+
+	cache.invalidate(obj.head)
+	assert.Nil(t, cache.object(ctx, obj.head, pn))
+
+	// Object states are valid in the pulse they were fetched in only, prototypes too.
+	assert.Nil(t, cache.object(ctx, proto.head, pn+1))
+}
+
+func TestDescriptorCache_Disabled(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	cache := newDescriptorCache(0)
+
+	desc := &codeDescriptor{ref: gen.Reference()}
+	cache.setCode(ctx, desc)
+	assert.Nil(t, cache.code(ctx, desc.ref))
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/insolar/insolar/insolar/jet"
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/instrumentation/instracer"
 	"github.com/insolar/insolar/ledger/object"
 )
@@ -48,7 +49,7 @@ type client struct {
 	JetCoordinator jet.Coordinator                    `inject:""`
 
 	getChildrenChunkSize int
-	senders              *messagebus.Senders
+	cache                *descriptorCache
 }
 
 // State returns hash state for artifact manager.
@@ -61,7 +62,7 @@ func (m *client) State() ([]byte, error) {
 func NewClient() *client { // nolint
 	return &client{
 		getChildrenChunkSize: getChildrenChunkSize,
-		senders:              messagebus.NewSenders(),
+		cache:                newDescriptorCache(descriptorCacheLimit),
 	}
 }
 
@@ -111,7 +112,7 @@ func (m *client) RegisterRequest(
 
 // GetCode returns code from code record by provided reference according to provided machine preference.
 //
-// This method is used by VM to fetch code for execution.
+// This method is used by VM to fetch code for execution. Code records never change, so they are cached.
 func (m *client) GetCode(
 	ctx context.Context, code insolar.Reference,
 ) (CodeDescriptor, error) {
@@ -126,10 +127,13 @@ func (m *client) GetCode(
 		instrumenter.end()
 	}()
 
+	if desc := m.cache.code(ctx, code); desc != nil {
+		return desc, nil
+	}
+
 	sender := messagebus.BuildSender(
 		m.DefaultBus.Send,
 		messagebus.RetryIncorrectPulse(m.PulseAccessor),
-		m.senders.CachedSender(m.PCS),
 		messagebus.FollowRedirectSender(m.DefaultBus),
 		messagebus.RetryJetSender(m.JetStorage),
 	)
@@ -147,6 +151,7 @@ func (m *client) GetCode(
 			machineType: rep.MachineType,
 			code:        rep.Code,
 		}
+		m.cache.setCode(ctx, &desc)
 		return &desc, nil
 	case *reply.Error:
 		return nil, rep.Error()
@@ -158,7 +163,8 @@ func (m *client) GetCode(
 // GetObject returns descriptor for provided state.
 //
 // If provided state is nil, the latest state will be returned (with deactivation check). Returned descriptor will
-// provide methods for fetching all related data.
+// provide methods for fetching all related data. States are cached in the current pulse only, by the executor of
+// the object. Prototypes are cached by all nodes.
 func (m *client) GetObject(
 	ctx context.Context,
 	head insolar.Reference,
@@ -180,6 +186,14 @@ func (m *client) GetObject(
 		instrumenter.end()
 	}()
 
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if desc = m.cache.object(ctx, head, currentPN); desc != nil {
+		return desc, nil
+	}
+
 	getObjectMsg := &message.GetObject{
 		Head:     head,
 	}
@@ -207,6 +221,9 @@ func (m *client) GetObject(
 			memory:       r.Memory,
 			parent:       r.Parent,
 		}
+		if m.cacheable(ctx, head, desc, currentPN) {
+			m.cache.setObject(ctx, head, desc, currentPN)
+		}
 		return desc, err
 	case *reply.Error:
 		return nil, r.Error()
@@ -215,6 +232,24 @@ func (m *client) GetObject(
 	}
 }
 
+// cacheable returns true if object state can be cached in the pulse. States are changed by the executor of the object,
+// so other nodes cache prototypes only, which rarely change.
+func (m *client) cacheable(
+	ctx context.Context, head insolar.Reference, desc ObjectDescriptor, pn insolar.PulseNumber,
+) bool {
+	if desc.IsPrototype() {
+		return true
+	}
+	executor, err := m.JetCoordinator.IsAuthorized(
+		ctx, insolar.DynamicRoleVirtualExecutor, *head.Record(), pn, m.JetCoordinator.Me(),
+	)
+	if err != nil {
+		inslogger.FromContext(ctx).Warn(errors.Wrap(err, "failed to calculate executor of object"))
+		return false
+	}
+	return executor
+}
+
 // GetPendingRequest returns an unclosed pending request
 // It takes an id from current LME
 // Then goes either to a light node or heavy node
@@ -371,9 +406,10 @@ func (m *client) GetDelegate(
 
 // GetChildren returns children iterator.
 //
-// During iteration children refs will be fetched from remote source (parent object).
+// During iteration children refs will be fetched from remote source (parent object). If prototype is provided,
+// only children of the prototype are returned.
 func (m *client) GetChildren(
-	ctx context.Context, parent insolar.Reference, pulse *insolar.PulseNumber,
+	ctx context.Context, parent insolar.Reference, prototype *insolar.Reference, pulse *insolar.PulseNumber,
 ) (RefIterator, error) {
 	var err error
 
@@ -393,7 +429,7 @@ func (m *client) GetChildren(
 		messagebus.FollowRedirectSender(m.DefaultBus),
 		messagebus.RetryJetSender(m.JetStorage),
 	)
-	iter, err := NewChildIterator(ctx, sender, parent, pulse, m.getChildrenChunkSize)
+	iter, err := NewChildIterator(ctx, sender, parent, prototype, pulse, m.getChildrenChunkSize)
 	return iter, err
 }
 
@@ -480,6 +516,12 @@ func (m *client) DeployCode(
 		return nil, err
 	}
 
+	m.cache.setCode(ctx, &codeDescriptor{
+		ref:         *codeRef,
+		machineType: machineType,
+		code:        code,
+	})
+
 	return id, nil
 }
 
@@ -561,6 +603,7 @@ func (m *client) DeactivateObject(
 		*obj.HeadRef(),
 		nil,
 	)
+	m.cache.invalidate(*obj.HeadRef())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to deactivate object")
 	}
@@ -714,6 +757,8 @@ func (m *client) activateObject(
 	asDelegate bool,
 	memory []byte,
 ) (ObjectDescriptor, error) {
+	// Child pointer of the parent must be the latest one, it can be changed by other nodes.
+	m.cache.invalidate(parent)
 	parentDesc, err := m.GetObject(ctx, parent)
 	if err != nil {
 		return nil, err
@@ -747,7 +792,7 @@ func (m *client) activateObject(
 	var (
 		asType *insolar.Reference
 	)
-	child := record.Child{Ref: obj}
+	child := record.Child{Ref: obj, Prototype: &prototype}
 	if parentDesc.ChildPointer() != nil {
 		child.PrevChild = *parentDesc.ChildPointer()
 	}
@@ -763,6 +808,7 @@ func (m *client) activateObject(
 		obj,
 		asType,
 	)
+	m.cache.invalidate(parent)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to register as child while activating")
 	}
@@ -816,6 +862,7 @@ func (m *client) updateObject(
 		*obj.HeadRef(),
 		memory,
 	)
+	m.cache.invalidate(*obj.HeadRef())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to update object")
 	}
@@ -858,6 +905,8 @@ func (m *client) setRecord(
 		return &rep.ID, nil
 	case *reply.Error:
 		return nil, rep.Error()
+	case *reply.ObjectOverloaded:
+		return nil, rep.Error()
 	default:
 		return nil, fmt.Errorf("setRecord: unexpected reply: %#v", rep)
 	}
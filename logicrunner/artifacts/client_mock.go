@@ -46,7 +46,7 @@ type ClientMock struct {
 	DeployCodePreCounter uint64
 	DeployCodeMock       mClientMockDeployCode
 
-	GetChildrenFunc       func(p context.Context, p1 insolar.Reference, p2 *insolar.PulseNumber) (r RefIterator, r1 error)
+	GetChildrenFunc       func(p context.Context, p1 insolar.Reference, p2 *insolar.Reference, p3 *insolar.PulseNumber) (r RefIterator, r1 error)
 	GetChildrenCounter    uint64
 	GetChildrenPreCounter uint64
 	GetChildrenMock       mClientMockGetChildren
@@ -921,7 +921,8 @@ type ClientMockGetChildrenExpectation struct {
 type ClientMockGetChildrenInput struct {
 	p  context.Context
 	p1 insolar.Reference
-	p2 *insolar.PulseNumber
+	p2 *insolar.Reference
+	p3 *insolar.PulseNumber
 }
 
 type ClientMockGetChildrenResult struct {
@@ -930,14 +931,14 @@ type ClientMockGetChildrenResult struct {
 }
 
 //Expect specifies that invocation of Client.GetChildren is expected from 1 to Infinity times
-func (m *mClientMockGetChildren) Expect(p context.Context, p1 insolar.Reference, p2 *insolar.PulseNumber) *mClientMockGetChildren {
+func (m *mClientMockGetChildren) Expect(p context.Context, p1 insolar.Reference, p2 *insolar.Reference, p3 *insolar.PulseNumber) *mClientMockGetChildren {
 	m.mock.GetChildrenFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
 		m.mainExpectation = &ClientMockGetChildrenExpectation{}
 	}
-	m.mainExpectation.input = &ClientMockGetChildrenInput{p, p1, p2}
+	m.mainExpectation.input = &ClientMockGetChildrenInput{p, p1, p2, p3}
 	return m
 }
 
@@ -954,12 +955,12 @@ func (m *mClientMockGetChildren) Return(r RefIterator, r1 error) *ClientMock {
 }
 
 //ExpectOnce specifies that invocation of Client.GetChildren is expected once
-func (m *mClientMockGetChildren) ExpectOnce(p context.Context, p1 insolar.Reference, p2 *insolar.PulseNumber) *ClientMockGetChildrenExpectation {
+func (m *mClientMockGetChildren) ExpectOnce(p context.Context, p1 insolar.Reference, p2 *insolar.Reference, p3 *insolar.PulseNumber) *ClientMockGetChildrenExpectation {
 	m.mock.GetChildrenFunc = nil
 	m.mainExpectation = nil
 
 	expectation := &ClientMockGetChildrenExpectation{}
-	expectation.input = &ClientMockGetChildrenInput{p, p1, p2}
+	expectation.input = &ClientMockGetChildrenInput{p, p1, p2, p3}
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
@@ -969,7 +970,7 @@ func (e *ClientMockGetChildrenExpectation) Return(r RefIterator, r1 error) {
 }
 
 //Set uses given function f as a mock of Client.GetChildren method
-func (m *mClientMockGetChildren) Set(f func(p context.Context, p1 insolar.Reference, p2 *insolar.PulseNumber) (r RefIterator, r1 error)) *ClientMock {
+func (m *mClientMockGetChildren) Set(f func(p context.Context, p1 insolar.Reference, p2 *insolar.Reference, p3 *insolar.PulseNumber) (r RefIterator, r1 error)) *ClientMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
@@ -978,18 +979,18 @@ func (m *mClientMockGetChildren) Set(f func(p context.Context, p1 insolar.Refere
 }
 
 //GetChildren implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
-func (m *ClientMock) GetChildren(p context.Context, p1 insolar.Reference, p2 *insolar.PulseNumber) (r RefIterator, r1 error) {
+func (m *ClientMock) GetChildren(p context.Context, p1 insolar.Reference, p2 *insolar.Reference, p3 *insolar.PulseNumber) (r RefIterator, r1 error) {
 	counter := atomic.AddUint64(&m.GetChildrenPreCounter, 1)
 	defer atomic.AddUint64(&m.GetChildrenCounter, 1)
 
 	if len(m.GetChildrenMock.expectationSeries) > 0 {
 		if counter > uint64(len(m.GetChildrenMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to ClientMock.GetChildren. %v %v %v", p, p1, p2)
+			m.t.Fatalf("Unexpected call to ClientMock.GetChildren. %v %v %v %v", p, p1, p2, p3)
 			return
 		}
 
 		input := m.GetChildrenMock.expectationSeries[counter-1].input
-		testify_assert.Equal(m.t, *input, ClientMockGetChildrenInput{p, p1, p2}, "Client.GetChildren got unexpected parameters")
+		testify_assert.Equal(m.t, *input, ClientMockGetChildrenInput{p, p1, p2, p3}, "Client.GetChildren got unexpected parameters")
 
 		result := m.GetChildrenMock.expectationSeries[counter-1].result
 		if result == nil {
@@ -1007,7 +1008,7 @@ func (m *ClientMock) GetChildren(p context.Context, p1 insolar.Reference, p2 *in
 
 		input := m.GetChildrenMock.mainExpectation.input
 		if input != nil {
-			testify_assert.Equal(m.t, *input, ClientMockGetChildrenInput{p, p1, p2}, "Client.GetChildren got unexpected parameters")
+			testify_assert.Equal(m.t, *input, ClientMockGetChildrenInput{p, p1, p2, p3}, "Client.GetChildren got unexpected parameters")
 		}
 
 		result := m.GetChildrenMock.mainExpectation.result
@@ -1022,11 +1023,11 @@ func (m *ClientMock) GetChildren(p context.Context, p1 insolar.Reference, p2 *in
 	}
 
 	if m.GetChildrenFunc == nil {
-		m.t.Fatalf("Unexpected call to ClientMock.GetChildren. %v %v %v", p, p1, p2)
+		m.t.Fatalf("Unexpected call to ClientMock.GetChildren. %v %v %v %v", p, p1, p2, p3)
 		return
 	}
 
-	return m.GetChildrenFunc(p, p1, p2)
+	return m.GetChildrenFunc(p, p1, p2, p3)
 }
 
 //GetChildrenMinimockCounter returns a count of ClientMock.GetChildrenFunc invocations
@@ -20,8 +20,10 @@ import (
 	"context"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/gojuno/minimock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -30,7 +32,6 @@ import (
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/internal/ledger/store"
-	"github.com/insolar/insolar/messagebus"
 
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/insolar"
@@ -154,7 +155,7 @@ func (s *amSuite) TestLedgerArtifactManager_GetCodeWithCache() {
 		PulseAccessor:  pa,
 		JetCoordinator: jc,
 		PCS:            s.scheme,
-		senders:        messagebus.NewSenders(),
+		cache:          newDescriptorCache(descriptorCacheLimit),
 	}
 
 	desc, err := am.GetCode(s.ctx, codeRef)
@@ -174,6 +175,101 @@ func (s *amSuite) TestLedgerArtifactManager_GetCodeWithCache() {
 
 }
 
+func (s *amSuite) TestLedgerArtifactManager_GetObjectWithCache() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	proto := gen.Reference()
+	code := gen.Reference()
+	state := gen.ID()
+	fetched := 0
+	mb := testutils.NewMessageBusMock(mc)
+	mb.SendFunc = func(p context.Context, msg insolar.Message, o *insolar.MessageSendOptions) (insolar.Reply, error) {
+		switch msg.(type) {
+		case *message.GetObject:
+			fetched++
+			return &reply.Object{Head: proto, State: state, IsPrototype: true, ChildPointer: &state}, nil
+		case *message.UpdateObject:
+			return &reply.Object{Head: proto, State: gen.ID()}, nil
+		}
+		s.T().Fatalf("unexpected message %T", msg)
+		return nil, nil
+	}
+
+	pa := pulse.NewAccessorMock(mc)
+	pa.LatestMock.Return(*insolar.GenesisPulse, nil)
+
+	am := NewClient()
+	am.DefaultBus = mb
+	am.PulseAccessor = pa
+	am.JetStorage = s.jetStorage
+	am.PCS = s.scheme
+
+	desc, err := am.GetObject(s.ctx, proto)
+	require.NoError(s.T(), err)
+	_, err = am.GetObject(s.ctx, proto)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, fetched, "prototype is served from cache")
+
+	_, err = am.UpdatePrototype(s.ctx, gen.Reference(), gen.Reference(), desc, nil, &code)
+	require.NoError(s.T(), err)
+	_, err = am.GetObject(s.ctx, proto)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, fetched, "prototype is fetched again after update")
+}
+
+func (s *amSuite) TestLedgerArtifactManager_GetObjectCachedByExecutor() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	obj := gen.Reference()
+	fetched := 0
+	mb := testutils.NewMessageBusMock(mc)
+	mb.SendFunc = func(p context.Context, msg insolar.Message, o *insolar.MessageSendOptions) (insolar.Reply, error) {
+		fetched++
+		return &reply.Object{Head: obj, State: gen.ID()}, nil
+	}
+
+	current := *insolar.GenesisPulse
+	pa := pulse.NewAccessorMock(mc)
+	pa.LatestFunc = func(ctx context.Context) (insolar.Pulse, error) {
+		return current, nil
+	}
+	executor := false
+	jc := jet.NewCoordinatorMock(mc)
+	jc.MeMock.Return(gen.Reference())
+	jc.IsAuthorizedFunc = func(
+		ctx context.Context, role insolar.DynamicRole, id insolar.ID, pn insolar.PulseNumber, node insolar.Reference,
+	) (bool, error) {
+		return executor, nil
+	}
+
+	am := NewClient()
+	am.DefaultBus = mb
+	am.PulseAccessor = pa
+	am.JetCoordinator = jc
+	am.JetStorage = s.jetStorage
+	am.PCS = s.scheme
+
+	for i := 0; i < 2; i++ {
+		_, err := am.GetObject(s.ctx, obj)
+		require.NoError(s.T(), err)
+	}
+	assert.Equal(s.T(), 2, fetched, "state is changed by other node")
+
+	executor = true
+	for i := 0; i < 2; i++ {
+		_, err := am.GetObject(s.ctx, obj)
+		require.NoError(s.T(), err)
+	}
+	assert.Equal(s.T(), 3, fetched, "executor serves state from cache")
+
+	current.PulseNumber += 10
+	_, err := am.GetObject(s.ctx, obj)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 4, fetched, "state is fetched again in the next pulse")
+}
+
 func (s *amSuite) TestLedgerArtifactManager_GetChildren_FollowsRedirect() {
 	mc := minimock.NewController(s.T())
 	am := NewClient()
@@ -202,8 +298,35 @@ func (s *amSuite) TestLedgerArtifactManager_GetChildren_FollowsRedirect() {
 	pa.LatestMock.Return(*insolar.GenesisPulse, nil)
 	am.PulseAccessor = pa
 
-	_, err := am.GetChildren(s.ctx, *objRef, nil)
+	_, err := am.GetChildren(s.ctx, *objRef, nil, nil)
+	require.NoError(s.T(), err)
+}
+
+func (s *amSuite) TestLedgerArtifactManager_GetChildren_SkipsEmptyChunks() {
+	prototype := gen.Reference()
+	child := gen.Reference()
+	next := gen.ID()
+
+	var requested []*insolar.ID
+	sender := func(c context.Context, m insolar.Message, o *insolar.MessageSendOptions) (insolar.Reply, error) {
+		msg := m.(*message.GetChildren)
+		assert.Equal(s.T(), &prototype, msg.Prototype)
+		requested = append(requested, msg.FromChild)
+		if msg.FromChild == nil {
+			// Executor has no children of the prototype.
+			return &reply.Children{NextFrom: &next}, nil
+		}
+		return &reply.Children{Refs: []insolar.Reference{child}}, nil
+	}
+
+	iter, err := NewChildIterator(s.ctx, sender, gen.Reference(), &prototype, nil, 10)
 	require.NoError(s.T(), err)
+	require.True(s.T(), iter.HasNext())
+	ref, err := iter.Next()
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), child, *ref)
+	assert.False(s.T(), iter.HasNext())
+	assert.Equal(s.T(), []*insolar.ID{nil, &next}, requested)
 }
 
 func (s *amSuite) TestLedgerArtifactManager_RegisterRequest_JetMiss() {
@@ -259,6 +382,32 @@ func (s *amSuite) TestLedgerArtifactManager_RegisterRequest_JetMiss() {
 	})
 }
 
+func (s *amSuite) TestLedgerArtifactManager_RegisterRequest_ObjectOverloaded() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	am := NewClient()
+	am.PCS = platformpolicy.NewPlatformCryptographyScheme()
+	pa := pulse.NewAccessorMock(mc)
+	pa.LatestMock.Return(insolar.Pulse{PulseNumber: insolar.FirstPulseNumber}, nil)
+	am.PulseAccessor = pa
+	am.JetStorage = s.jetStorage
+
+	ref := gen.Reference()
+	mb := testutils.NewMessageBusMock(mc)
+	mb.SendMock.Return(&reply.ObjectOverloaded{Object: ref, RetryAfter: time.Second}, nil)
+	am.DefaultBus = mb
+
+	_, err := am.RegisterRequest(s.ctx, record.Request{Object: &ref})
+	require.Error(s.T(), err)
+	overloaded, ok := errors.Cause(err).(*insolar.ObjectOverloadedError)
+	require.True(s.T(), ok, "typed overloaded error expected")
+	assert.Equal(s.T(), ref, overloaded.Object)
+	retryAfter, ok := insolar.RetryAfter(err)
+	require.True(s.T(), ok)
+	assert.Equal(s.T(), time.Second, retryAfter)
+}
+
 func (s *amSuite) TestLedgerArtifactManager_GetRequest_Success() {
 	// Arrange
 	mc := minimock.NewController(s.T())
@@ -132,10 +132,14 @@ func (d *objectDescriptor) Parent() *insolar.Reference {
 // 8. AE (redirect to H) -> R
 // 9. R (get children 6 ...) -> H
 // 10. H (children 6 ... 15 EOF) -> R
+//
+// If prototype is provided, children are filtered by executors, so chunks contain only children of the prototype.
+// Chunk can be empty when an executor has no matching children, the next chunk is fetched then.
 type ChildIterator struct {
 	ctx         context.Context
 	senderChain messagebus.Sender
 	parent      insolar.Reference
+	prototype   *insolar.Reference
 	chunkSize   int
 	fromPulse   *insolar.PulseNumber
 	fromChild   *insolar.ID
@@ -149,6 +153,7 @@ func NewChildIterator(
 	ctx context.Context,
 	senderChain messagebus.Sender,
 	parent insolar.Reference,
+	prototype *insolar.Reference,
 	fromPulse *insolar.PulseNumber,
 	chunkSize int,
 ) (*ChildIterator, error) {
@@ -156,6 +161,7 @@ func NewChildIterator(
 		ctx:         ctx,
 		senderChain: senderChain,
 		parent:      parent,
+		prototype:   prototype,
 		fromPulse:   fromPulse,
 		chunkSize:   chunkSize,
 		canFetch:    true,
@@ -204,26 +210,34 @@ func (i *ChildIterator) fetch() error {
 		return errors.New("failed to fetch a children chunk")
 	}
 
-	genericReply, err := i.senderChain(i.ctx, &message.GetChildren{
-		Parent:    i.parent,
-		FromPulse: i.fromPulse,
-		FromChild: i.fromChild,
-		Amount:    i.chunkSize,
-	}, nil)
-	if err != nil {
-		return err
-	}
-	rep, ok := genericReply.(*reply.Children)
-	if !ok {
-		return fmt.Errorf("unexpected reply: %#v", genericReply)
-	}
+	// Skip empty chunks, so HasNext is true only if there are children left.
+	for i.canFetch {
+		genericReply, err := i.senderChain(i.ctx, &message.GetChildren{
+			Parent:    i.parent,
+			Prototype: i.prototype,
+			FromPulse: i.fromPulse,
+			FromChild: i.fromChild,
+			Amount:    i.chunkSize,
+		}, nil)
+		if err != nil {
+			return err
+		}
+		rep, ok := genericReply.(*reply.Children)
+		if !ok {
+			return fmt.Errorf("unexpected reply: %#v", genericReply)
+		}
 
-	if rep.NextFrom == nil || rep.NextFrom.IsEmpty() {
-		i.canFetch = false
+		if rep.NextFrom == nil || rep.NextFrom.IsEmpty() {
+			i.canFetch = false
+		}
+		i.buff = rep.Refs
+		i.buffIndex = 0
+		i.fromChild = rep.NextFrom
+
+		if len(i.buff) > 0 {
+			break
+		}
 	}
-	i.buff = rep.Refs
-	i.buffIndex = 0
-	i.fromChild = rep.NextFrom
 
 	return nil
 }
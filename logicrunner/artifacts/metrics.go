@@ -34,6 +34,10 @@ var (
 	statLatency = stats.Int64("artifactmanager/latency", "The latency in milliseconds per AM call", stats.UnitMilliseconds)
 
 	statRedirects = stats.Int64("artifactmanager/redirects", "The number redirects happens on AM", stats.UnitDimensionless)
+
+	statCacheHits      = stats.Int64("artifactmanager/cache/hits", "The number of descriptors served from AM cache", stats.UnitDimensionless)
+	statCacheMisses    = stats.Int64("artifactmanager/cache/misses", "The number of descriptors missed in AM cache", stats.UnitDimensionless)
+	statCacheEvictions = stats.Int64("artifactmanager/cache/evictions", "The number of descriptors evicted from AM cache", stats.UnitDimensionless)
 )
 
 func init() {
@@ -60,6 +64,27 @@ func init() {
 			Measure:     statRedirects,
 			Aggregation: view.Count(),
 		},
+
+		&view.View{
+			Name:        statCacheHits.Name(),
+			Description: statCacheHits.Description(),
+			Measure:     statCacheHits,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagMethod},
+		},
+		&view.View{
+			Name:        statCacheMisses.Name(),
+			Description: statCacheMisses.Description(),
+			Measure:     statCacheMisses,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagMethod},
+		},
+		&view.View{
+			Name:        statCacheEvictions.Name(),
+			Description: statCacheEvictions.Description(),
+			Measure:     statCacheEvictions,
+			Aggregation: view.Count(),
+		},
 	)
 	if err != nil {
 		panic(err)
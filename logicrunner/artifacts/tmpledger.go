@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	"github.com/gojuno/minimock"
+	"github.com/pkg/errors"
+
 	"github.com/insolar/insolar/ledger/genesis"
 	"github.com/insolar/insolar/ledger/light/hot"
 	"github.com/insolar/insolar/ledger/object"
@@ -29,6 +31,7 @@ import (
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/node"
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/instrumentation/inslogger"
@@ -96,6 +99,24 @@ func NewTestLedger(
 func TmpLedger(t *testing.T, dir string, c insolar.Components) (*TMPLedger, *artifactmanager.MessageHandler, *object.InMemoryIndex) {
 	log.Warn("TmpLedger is deprecated. Use mocks.")
 
+	l := NewInMemoryLedger(t, c)
+	return l.TMPLedger, l.Handler, l.Index
+}
+
+// InMemoryLedger is a light material node living in memory. It serves artifact manager
+// requests through the provided message bus and can switch pulses without a network.
+type InMemoryLedger struct {
+	*TMPLedger
+
+	Handler       *artifactmanager.MessageHandler
+	Index         *object.InMemoryIndex
+	PulseAccessor pulse.Accessor
+	Bus           insolar.MessageBus
+}
+
+// NewInMemoryLedger creates ledger on top of memory storages and registers light node
+// handlers on the message bus from components (test message bus is created if none).
+func NewInMemoryLedger(t *testing.T, c insolar.Components) *InMemoryLedger {
 	pcs := platformpolicy.NewPlatformCryptographyScheme()
 	mc := minimock.NewController(t)
 	ps := pulse.NewStorageMem()
@@ -236,7 +257,9 @@ func TmpLedger(t *testing.T, dir string, c insolar.Components) (*TMPLedger, *art
 
 	pendingMock.GetRequestsForObjectMock.Return(nil)
 	pendingMock.AddPendingRequestMock.Return()
+	pendingMock.AddPendingRequestLimitedMock.Return(0, nil)
 	pendingMock.RemovePendingRequestMock.Return()
+	pendingMock.CountMock.Return(0)
 
 	provideMock := recentstorage.NewProviderMock(t)
 	provideMock.GetPendingStorageMock.Return(pendingMock)
@@ -252,5 +275,58 @@ func TmpLedger(t *testing.T, dir string, c insolar.Components) (*TMPLedger, *art
 	// Create ledger.
 	l := NewTestLedger(am, pm, jc)
 
-	return l, handler, index
+	return &InMemoryLedger{
+		TMPLedger:     l,
+		Handler:       handler,
+		Index:         index,
+		PulseAccessor: ps,
+		Bus:           c.MessageBus,
+	}
+}
+
+// NextPulse sets the pulse following the latest one and passes hot data of the previous
+// pulse to the light node handler, like the light node does after pulse change.
+func (l *InMemoryLedger) NextPulse(ctx context.Context) (insolar.Pulse, error) {
+	current, err := l.PulseAccessor.Latest(ctx)
+	if err != nil {
+		return insolar.Pulse{}, errors.Wrap(err, "failed to get latest pulse")
+	}
+
+	newPulse := insolar.Pulse{
+		PulseNumber:     current.PulseNumber + 1,
+		PrevPulseNumber: current.PulseNumber,
+		NextPulseNumber: current.PulseNumber + 2,
+		Entropy:         insolar.Entropy{},
+	}
+	err = l.PulseManager.Set(ctx, newPulse, true)
+	if err != nil {
+		return insolar.Pulse{}, errors.Wrap(err, "failed to set pulse")
+	}
+	l.Handler.OnPulse(ctx, newPulse)
+
+	rootJetID := *insolar.NewJetID(0, nil)
+	var hotIndexes []message.HotIndex
+	for _, meta := range l.Index.ForPNAndJet(ctx, current.PulseNumber, rootJetID) {
+		encoded, err := meta.Lifeline.Marshal()
+		if err != nil {
+			return insolar.Pulse{}, errors.Wrap(err, "failed to marshal lifeline")
+		}
+		hotIndexes = append(hotIndexes, message.HotIndex{
+			LastUsed: meta.LifelineLastUsed,
+			ObjID:    meta.ObjID,
+			Index:    encoded,
+		})
+	}
+
+	_, err = l.Bus.Send(ctx, &message.HotData{
+		Jet:         *insolar.NewReference(insolar.DomainID, insolar.ID(rootJetID)),
+		Drop:        drop.Drop{Pulse: current.PulseNumber, JetID: rootJetID},
+		HotIndexes:  hotIndexes,
+		PulseNumber: newPulse.PulseNumber,
+	}, nil)
+	if err != nil {
+		return insolar.Pulse{}, errors.Wrap(err, "failed to send hot data")
+	}
+
+	return newPulse, nil
 }
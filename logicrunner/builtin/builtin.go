@@ -19,17 +19,20 @@ package builtin
 
 import (
 	"context"
-	"reflect"
+	"sync"
 
 	"github.com/insolar/insolar/instrumentation/instracer"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
 
 	"github.com/pkg/errors"
-	"github.com/ugorji/go/codec"
+	"github.com/tylerb/gls"
 
 	"github.com/insolar/insolar/insolar"
 )
 
+// ContractMethods maps wrapper names (INSMETHOD_*, INSCONSTRUCTOR_*) to wrapper functions
+// generated for a contract.
 type ContractMethods map[string]interface{}
 
 // BuiltIn is a contract runner engine
@@ -38,96 +41,130 @@ type BuiltIn struct {
 	EB          insolar.MessageBus
 	Registry    map[string]ContractMethods
 	RefRegistry map[insolar.Reference]string
+
+	registryLock sync.RWMutex
+	// helper serves proxies of contracts called by this instance.
+	helper *ProxyHelper
 }
 
+// installProxyHelper makes proxies of builtin contracts find the helper of the instance that runs the call.
+var installProxyHelper sync.Once
+
 // NewBuiltIn is an constructor
-func NewBuiltIn(eb insolar.MessageBus, am artifacts.Client) *BuiltIn {
+func NewBuiltIn(eb insolar.MessageBus, am artifacts.Client, upstream Upstream) *BuiltIn {
 	bi := BuiltIn{
-		AM: am,
-		EB: eb,
+		AM:     am,
+		EB:     eb,
+		helper: NewProxyHelper(upstream),
 	}
 
 	bi.Registry = InitializeContractMethods()
 	bi.RefRegistry = InitializeContractRefs()
 
+	installProxyHelper.Do(func() {
+		proxyctx.Current = &callProxyHelper{}
+	})
+
 	return &bi
 }
 
-func (bi *BuiltIn) CallConstructor(ctx context.Context, callCtx *insolar.LogicCallContext, code insolar.Reference, name string, args insolar.Arguments) (objectState []byte, err error) {
-	panic("implement me")
-}
+// Register adds contract wrappers to the registry under provided name. Code records
+// of builtin machine type must contain this name as a code.
+func (bi *BuiltIn) Register(name string, methods ContractMethods) {
+	bi.registryLock.Lock()
+	defer bi.registryLock.Unlock()
 
-func (bi *BuiltIn) Stop() error {
-	return nil
+	bi.Registry[name] = methods
 }
 
-// CallMethod runs a method on contract
-func (bi *BuiltIn) CallMethod(ctx context.Context, callCtx *insolar.LogicCallContext, codeRef insolar.Reference, data []byte, method string, args insolar.Arguments) (newObjectState []byte, methodResults insolar.Arguments, err error) {
-	am := bi.AM
-	ctx, span := instracer.StartSpan(ctx, "buildin.CallMethod")
-	defer span.End()
-
-	codeDescriptor, err := am.GetCode(ctx, codeRef)
-
+func (bi *BuiltIn) wrapper(ctx context.Context, codeRef insolar.Reference, name string) (interface{}, error) {
+	codeDescriptor, err := bi.AM.GetCode(ctx, codeRef)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "Can't find code")
+		return nil, errors.Wrap(err, "Can't find code")
 	}
 	code, err := codeDescriptor.Code()
+	if err != nil {
+		return nil, errors.Wrap(err, "Can't get code")
+	}
+
+	bi.registryLock.RLock()
+	defer bi.registryLock.RUnlock()
+
 	c, ok := bi.Registry[string(code)]
 	if !ok {
-		return nil, nil, errors.New("Wrong reference for builtin contract")
+		return nil, errors.New("Wrong reference for builtin contract")
 	}
+	symbol, ok := c[name]
+	if !ok {
+		return nil, errors.Errorf("Can't find wrapper for %s", name)
+	}
+	return symbol, nil
+}
 
-	zv := reflect.New(reflect.TypeOf(c).Elem()).Interface()
-	ch := new(codec.CborHandle)
+// CallConstructor runs a constructor of contract
+func (bi *BuiltIn) CallConstructor(ctx context.Context, callCtx *insolar.LogicCallContext, codeRef insolar.Reference, name string, args insolar.Arguments) (objectState []byte, err error) {
+	ctx, span := instracer.StartSpan(ctx, "builtin.CallConstructor")
+	defer span.End()
 
-	err = codec.NewDecoderBytes(data, ch).Decode(zv)
+	symbol, err := bi.wrapper(ctx, codeRef, "INSCONSTRUCTOR_"+name)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "couldn't decode data into %T", zv)
+		return nil, err
 	}
-
-	m := reflect.ValueOf(zv).MethodByName(method)
-	if !m.IsValid() {
-		return nil, nil, errors.New("no method " + method + " in the contract")
+	f, ok := symbol.(func(data []byte) ([]byte, error))
+	if !ok {
+		return nil, errors.New("Wrapper with wrong signature")
 	}
 
-	inLen := m.Type().NumIn()
-
-	mask := make([]interface{}, inLen)
-	for i := 0; i < inLen; i++ {
-		argType := m.Type().In(i)
-		mask[i] = reflect.Zero(argType).Interface()
-	}
+	defer bi.withCallContext(callCtx)()
 
-	err = codec.NewDecoderBytes(args, ch).Decode(&mask)
+	objectState, err = f(args)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "couldn't unmarshal CBOR for arguments of the method")
+		return nil, errors.Wrapf(err, "Can't call constructor %s", name)
 	}
 
-	in := make([]reflect.Value, inLen)
-	for i := 0; i < inLen; i++ {
-		in[i] = reflect.ValueOf(mask[i])
-	}
+	return objectState, nil
+}
 
-	resValues := m.Call(in)
+func (bi *BuiltIn) Stop() error {
+	return nil
+}
 
-	err = codec.NewEncoderBytes(&newObjectState, ch).Encode(zv)
+// CallMethod runs a method on contract
+func (bi *BuiltIn) CallMethod(ctx context.Context, callCtx *insolar.LogicCallContext, codeRef insolar.Reference, data []byte, method string, args insolar.Arguments) (newObjectState []byte, methodResults insolar.Arguments, err error) {
+	ctx, span := instracer.StartSpan(ctx, "builtin.CallMethod")
+	defer span.End()
+
+	symbol, err := bi.wrapper(ctx, codeRef, "INSMETHOD_"+method)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "couldn't marshal new object data into cbor")
+		return nil, nil, err
 	}
-
-	res := make([]interface{}, len(resValues))
-	for i, v := range resValues {
-		res[i] = v.Interface()
+	f, ok := symbol.(func(object []byte, data []byte) ([]byte, []byte, error))
+	if !ok {
+		return nil, nil, errors.New("Wrapper with wrong signature")
 	}
 
-	var resSerialized []byte
-	err = codec.NewEncoderBytes(&resSerialized, ch).Encode(res)
+	defer bi.withCallContext(callCtx)()
+
+	newObjectState, methodResults, err = f(data, args)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "couldn't marshal returned values into cbor")
+		return nil, nil, errors.Wrap(err, "Method call returned error")
 	}
 
-	methodResults = resSerialized
-
 	return newObjectState, methodResults, nil
 }
+
+// withCallContext makes call context and proxy helper of the instance available for foundation and proxies, returns
+// function that restores previous context.
+func (bi *BuiltIn) withCallContext(callCtx *insolar.LogicCallContext) func() {
+	prev, prevHelper := gls.Get("callCtx"), gls.Get("proxyHelper")
+	gls.Set("callCtx", callCtx)
+	gls.Set("proxyHelper", bi.helper)
+	return func() {
+		if prev != nil {
+			gls.Set("callCtx", prev)
+			gls.Set("proxyHelper", prevHelper)
+			return
+		}
+		gls.Cleanup()
+	}
+}
@@ -0,0 +1,269 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package builtin
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/tylerb/gls"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+)
+
+// Upstream is a set of logic runner services available to contracts. It's the same
+// interface insgorund reaches through RPC, builtin contracts call it in-process.
+type Upstream interface {
+	RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) error
+	SaveAsChild(req rpctypes.UpSaveAsChildReq, rep *rpctypes.UpSaveAsChildResp) error
+	SaveAsDelegate(req rpctypes.UpSaveAsDelegateReq, rep *rpctypes.UpSaveAsDelegateResp) error
+	GetObjChildrenIterator(req rpctypes.UpGetObjChildrenIteratorReq, rep *rpctypes.UpGetObjChildrenIteratorResp) error
+	GetDelegate(req rpctypes.UpGetDelegateReq, rep *rpctypes.UpGetDelegateResp) error
+	DeactivateObject(req rpctypes.UpDeactivateObjectReq, rep *rpctypes.UpDeactivateObjectResp) error
+}
+
+// ProxyHelper implements proxyctx.ProxyHelper for builtin contracts.
+type ProxyHelper struct {
+	upstream Upstream
+}
+
+// NewProxyHelper creates ProxyHelper on top of provided upstream.
+func NewProxyHelper(upstream Upstream) *ProxyHelper {
+	return &ProxyHelper{upstream: upstream}
+}
+
+func (h *ProxyHelper) getUpBaseReq() rpctypes.UpBaseReq {
+	callCtx, ok := gls.Get("callCtx").(*insolar.LogicCallContext)
+	if !ok {
+		panic("Wrong or unexistent call context, you probably started a goroutine")
+	}
+
+	return rpctypes.UpBaseReq{
+		Mode:            callCtx.Mode,
+		Callee:          *callCtx.Callee,
+		CalleePrototype: *callCtx.Prototype,
+		Request:         *callCtx.Request,
+	}
+}
+
+func (h *ProxyHelper) checkUpstream() error {
+	if h.upstream == nil {
+		return errors.New("builtin contracts have no upstream")
+	}
+	return nil
+}
+
+// RouteCall ...
+func (h *ProxyHelper) RouteCall(ref insolar.Reference, wait bool, immutable bool, method string, args []byte, proxyPrototype insolar.Reference) ([]byte, error) {
+	if err := h.checkUpstream(); err != nil {
+		return nil, err
+	}
+
+	req := rpctypes.UpRouteReq{
+		UpBaseReq: h.getUpBaseReq(),
+		Wait:      wait,
+		Immutable: immutable,
+		Object:    ref,
+		Method:    method,
+		Arguments: args,
+		Prototype: proxyPrototype,
+	}
+	res := rpctypes.UpRouteResp{}
+	err := h.upstream.RouteCall(req, &res)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ RouteCall ] on calling main API")
+	}
+
+	return []byte(res.Result), nil
+}
+
+// SaveAsChild ...
+func (h *ProxyHelper) SaveAsChild(parentRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error) {
+	if err := h.checkUpstream(); err != nil {
+		return insolar.Reference{}, err
+	}
+
+	req := rpctypes.UpSaveAsChildReq{
+		UpBaseReq:       h.getUpBaseReq(),
+		Parent:          parentRef,
+		Prototype:       classRef,
+		ConstructorName: constructorName,
+		ArgsSerialized:  argsSerialized,
+	}
+	res := rpctypes.UpSaveAsChildResp{}
+	err := h.upstream.SaveAsChild(req, &res)
+	if err != nil {
+		return insolar.Reference{}, errors.Wrap(err, "[ SaveAsChild ] on calling main API")
+	}
+
+	return *res.Reference, nil
+}
+
+// GetObjChildrenIterator ...
+func (h *ProxyHelper) GetObjChildrenIterator(head insolar.Reference, prototype insolar.Reference, iteratorID string) (*proxyctx.ChildrenTypedIterator, error) {
+	if err := h.checkUpstream(); err != nil {
+		return &proxyctx.ChildrenTypedIterator{}, err
+	}
+
+	req := rpctypes.UpGetObjChildrenIteratorReq{
+		UpBaseReq:  h.getUpBaseReq(),
+		IteratorID: iteratorID,
+		Object:     head,
+		Prototype:  prototype,
+	}
+	res := rpctypes.UpGetObjChildrenIteratorResp{}
+	err := h.upstream.GetObjChildrenIterator(req, &res)
+	if err != nil {
+		return &proxyctx.ChildrenTypedIterator{}, errors.Wrap(err, "[ GetObjChildrenIterator ] on calling main API")
+	}
+
+	return &proxyctx.ChildrenTypedIterator{
+		Parent:         head,
+		ChildPrototype: prototype,
+		IteratorID:     res.Iterator.ID,
+		Buff:           res.Iterator.Buff,
+		CanFetch:       res.Iterator.CanFetch,
+	}, nil
+}
+
+// SaveAsDelegate ...
+func (h *ProxyHelper) SaveAsDelegate(intoRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error) {
+	if err := h.checkUpstream(); err != nil {
+		return insolar.Reference{}, err
+	}
+
+	req := rpctypes.UpSaveAsDelegateReq{
+		UpBaseReq:       h.getUpBaseReq(),
+		Into:            intoRef,
+		Prototype:       classRef,
+		ConstructorName: constructorName,
+		ArgsSerialized:  argsSerialized,
+	}
+	res := rpctypes.UpSaveAsDelegateResp{}
+	err := h.upstream.SaveAsDelegate(req, &res)
+	if err != nil {
+		return insolar.Reference{}, errors.Wrap(err, "[ SaveAsDelegate ] on calling main API")
+	}
+
+	return *res.Reference, nil
+}
+
+// GetDelegate ...
+func (h *ProxyHelper) GetDelegate(object, ofType insolar.Reference) (insolar.Reference, error) {
+	if err := h.checkUpstream(); err != nil {
+		return insolar.Reference{}, err
+	}
+
+	req := rpctypes.UpGetDelegateReq{
+		UpBaseReq: h.getUpBaseReq(),
+		Object:    object,
+		OfType:    ofType,
+	}
+	res := rpctypes.UpGetDelegateResp{}
+	err := h.upstream.GetDelegate(req, &res)
+	if err != nil {
+		return insolar.Reference{}, errors.Wrap(err, "[ GetDelegate ] on calling main API")
+	}
+
+	return res.Object, nil
+}
+
+// DeactivateObject ...
+func (h *ProxyHelper) DeactivateObject(object insolar.Reference) error {
+	if err := h.checkUpstream(); err != nil {
+		return err
+	}
+
+	req := rpctypes.UpDeactivateObjectReq{
+		UpBaseReq: h.getUpBaseReq(),
+	}
+	res := rpctypes.UpDeactivateObjectResp{}
+	err := h.upstream.DeactivateObject(req, &res)
+	if err != nil {
+		return errors.Wrap(err, "[ DeactivateObject ] on calling main API")
+	}
+
+	return nil
+}
+
+// Serialize - CBOR serializer wrapper: `what` -> `to`
+func (h *ProxyHelper) Serialize(what interface{}, to *[]byte) error {
+	ch := new(codec.CborHandle)
+	return codec.NewEncoderBytes(to, ch).Encode(what)
+}
+
+// Deserialize - CBOR de-serializer wrapper: `from` -> `into`
+func (h *ProxyHelper) Deserialize(from []byte, into interface{}) error {
+	ch := new(codec.CborHandle)
+	return codec.NewDecoderBytes(from, ch).Decode(into)
+}
+
+// MakeErrorSerializable converts errors satisfying error interface to foundation.Error
+func (h *ProxyHelper) MakeErrorSerializable(e error) error {
+	if e == nil || e == (*foundation.Error)(nil) || reflect.ValueOf(e).IsNil() {
+		return nil
+	}
+	return &foundation.Error{S: e.Error()}
+}
+
+// callProxyHelper is set to proxyctx.Current once. It passes calls of proxies to the helper of BuiltIn, that runs
+// the current call, so several BuiltIn instances with different upstreams can work in one process.
+type callProxyHelper struct {
+	ProxyHelper
+}
+
+func (*callProxyHelper) current() *ProxyHelper {
+	h, ok := gls.Get("proxyHelper").(*ProxyHelper)
+	if !ok {
+		panic("Wrong or unexistent proxy helper, you probably started a goroutine")
+	}
+	return h
+}
+
+// RouteCall ...
+func (c *callProxyHelper) RouteCall(ref insolar.Reference, wait bool, immutable bool, method string, args []byte, proxyPrototype insolar.Reference) ([]byte, error) {
+	return c.current().RouteCall(ref, wait, immutable, method, args, proxyPrototype)
+}
+
+// SaveAsChild ...
+func (c *callProxyHelper) SaveAsChild(parentRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error) {
+	return c.current().SaveAsChild(parentRef, classRef, constructorName, argsSerialized)
+}
+
+// GetObjChildrenIterator ...
+func (c *callProxyHelper) GetObjChildrenIterator(head insolar.Reference, prototype insolar.Reference, iteratorID string) (*proxyctx.ChildrenTypedIterator, error) {
+	return c.current().GetObjChildrenIterator(head, prototype, iteratorID)
+}
+
+// SaveAsDelegate ...
+func (c *callProxyHelper) SaveAsDelegate(intoRef, classRef insolar.Reference, constructorName string, argsSerialized []byte) (insolar.Reference, error) {
+	return c.current().SaveAsDelegate(intoRef, classRef, constructorName, argsSerialized)
+}
+
+// GetDelegate ...
+func (c *callProxyHelper) GetDelegate(object, ofType insolar.Reference) (insolar.Reference, error) {
+	return c.current().GetDelegate(object, ofType)
+}
+
+// DeactivateObject ...
+func (c *callProxyHelper) DeactivateObject(object insolar.Reference) error {
+	return c.current().DeactivateObject(object)
+}
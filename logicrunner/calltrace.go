@@ -0,0 +1,198 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// callTraces keeps records of contract calls of the latest traces
+type callTraces struct {
+	sync.Mutex
+
+	limit  int
+	traces map[string][]insolar.CallRecord
+	// trace IDs in order of the first call, the oldest trace is dropped when limit is reached
+	order []string
+}
+
+func newCallTraces(limit int) *callTraces {
+	return &callTraces{
+		limit:  limit,
+		traces: make(map[string][]insolar.CallRecord),
+	}
+}
+
+func (ct *callTraces) add(rec insolar.CallRecord) {
+	if ct.limit <= 0 || rec.TraceID == "" {
+		return
+	}
+
+	ct.Lock()
+	defer ct.Unlock()
+
+	if _, ok := ct.traces[rec.TraceID]; !ok {
+		if len(ct.order) >= ct.limit {
+			delete(ct.traces, ct.order[0])
+			ct.order = ct.order[1:]
+		}
+		ct.order = append(ct.order, rec.TraceID)
+	}
+	ct.traces[rec.TraceID] = append(ct.traces[rec.TraceID], rec)
+}
+
+func (ct *callTraces) get(traceID string) []insolar.CallRecord {
+	ct.Lock()
+	defer ct.Unlock()
+
+	res := make([]insolar.CallRecord, len(ct.traces[traceID]))
+	copy(res, ct.traces[traceID])
+	return res
+}
+
+// recordCall saves record of executed call and adds its attributes to the span of execution
+func (lr *LogicRunner) recordCall(
+	ctx context.Context, span *trace.Span, es *ExecutionState, msg *message.CallMethod,
+	start time.Time, re insolar.Reply, err error,
+) {
+	lc := es.Current.LogicContext
+	rec := insolar.CallRecord{
+		TraceID:  inslogger.TraceID(ctx),
+		Parent:   msg.ParentRequest,
+		Caller:   msg.Caller,
+		Method:   msg.Method,
+		Pulse:    lc.Pulse.PulseNumber,
+		Node:     lr.NodeNetwork.GetOrigin().ID(),
+		Start:    start,
+		Duration: time.Since(start),
+		Outcome:  insolar.CallOutcomeSuccess,
+	}
+	if es.Current.Request != nil {
+		rec.Request = *es.Current.Request
+	}
+	if lc.Callee != nil {
+		rec.Callee = *lc.Callee
+	}
+	if lc.Prototype != nil {
+		rec.Prototype = *lc.Prototype
+	} else if msg.Prototype != nil {
+		rec.Prototype = *msg.Prototype
+	}
+
+	if err != nil {
+		rec.Outcome = insolar.CallOutcomeSystemError
+		rec.Error = err.Error()
+	} else if cm, ok := re.(*reply.CallMethod); ok {
+		if contractErr := contractError(cm.Result); contractErr != "" {
+			rec.Outcome = insolar.CallOutcomeContractError
+			rec.Error = contractErr
+		}
+	}
+
+	lr.callTraces.add(rec)
+
+	span.AddAttributes(
+		trace.StringAttribute("callee", rec.Callee.String()),
+		trace.StringAttribute("caller", rec.Caller.String()),
+		trace.StringAttribute("prototype", rec.Prototype.String()),
+		trace.StringAttribute("method", rec.Method),
+		trace.Int64Attribute("pulse", int64(rec.Pulse)),
+		trace.StringAttribute("outcome", rec.Outcome),
+	)
+	if rec.Error != "" {
+		span.SetStatus(trace.Status{Code: trace.StatusCodeUnknown, Message: rec.Error})
+	}
+}
+
+// contractError returns error returned by contract method, methods return error as the last result
+func contractError(result []byte) string {
+	var results []interface{}
+	if err := insolar.Deserialize(result, &results); err != nil || len(results) == 0 {
+		return ""
+	}
+	e, ok := results[len(results)-1].(map[interface{}]interface{})
+	if !ok {
+		return ""
+	}
+	s, _ := e["S"].(string)
+	return s
+}
+
+// CallTree returns records of contract calls made in scope of trace by all working virtual nodes.
+// Nodes that fail to reply are skipped, so records may be incomplete.
+func (lr *LogicRunner) CallTree(ctx context.Context, traceID string) ([]insolar.CallRecord, error) {
+	if traceID == "" {
+		return nil, errors.New("trace ID is empty")
+	}
+
+	res := lr.callTraces.get(traceID)
+	me := lr.NodeNetwork.GetOrigin().ID()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for _, node := range lr.NodeNetwork.GetWorkingNodesByRole(insolar.DynamicRoleVirtualExecutor) {
+		if node.Equal(me) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(node insolar.Reference) {
+			defer wg.Done()
+
+			rep, err := lr.MessageBus.Send(
+				ctx,
+				&message.GetCallTree{TraceID: traceID, Node: node},
+				&insolar.MessageSendOptions{Receiver: &node},
+			)
+			if err != nil {
+				inslogger.FromContext(ctx).Warnf("failed to get call tree from node %s: %v", node, err)
+				return
+			}
+			calls, ok := rep.(*reply.CallTree)
+			if !ok {
+				inslogger.FromContext(ctx).Warnf("unexpected reply %T to GetCallTree from node %s", rep, node)
+				return
+			}
+
+			mu.Lock()
+			res = append(res, calls.Calls...)
+			mu.Unlock()
+		}(node)
+	}
+	wg.Wait()
+
+	return res, nil
+}
+
+// HandleGetCallTreeMessage returns records of contract calls made by the node in scope of trace.
+func (lr *LogicRunner) HandleGetCallTreeMessage(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg, ok := parcel.Message().(*message.GetCallTree)
+	if !ok {
+		return nil, errors.New("HandleGetCallTreeMessage() accepts only message.GetCallTree")
+	}
+	return &reply.CallTree{Calls: lr.callTraces.get(msg.TraceID)}, nil
+}
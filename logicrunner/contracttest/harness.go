@@ -0,0 +1,316 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package contracttest hosts LogicRunner on top of in-memory ledger, so contracts can be
+// tested end to end in ordinary go tests without network and insgorund.
+//
+// Contracts are executed by the builtin executor, so a contract is deployed from its source
+// compiled into the test binary together with wrappers generated by `insgocc wrapper`:
+//
+//	h := contracttest.New(t)
+//	defer h.Stop()
+//
+//	proto := h.DeployPrototype("helloworld", helloworld.Initialize())
+//	member := h.NewMember()
+//	obj, err := h.CallConstructor(member, h.Root(), proto, "New")
+//	res, err := h.CallMethod(member, obj, proto, "Greet", "Bob")
+//
+//	h.NextPulse()
+//
+//	var state helloworld.HelloWorld
+//	err = h.ObjectState(obj, &state)
+package contracttest
+
+import (
+	"context"
+	"crypto"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/contractrequester"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/delegationtoken"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/light/recentstorage"
+	"github.com/insolar/insolar/logicrunner"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/builtin"
+	"github.com/insolar/insolar/messagebus"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+	"github.com/insolar/insolar/testutils/nodekeeper"
+	"github.com/insolar/insolar/testutils/testmessagebus"
+)
+
+// Harness is LogicRunner with the builtin executor working on top of in-memory ledger.
+type Harness struct {
+	t   *testing.T
+	ctx context.Context
+	cm  *component.Manager
+
+	lastTraceID string
+
+	LogicRunner       *logicrunner.LogicRunner
+	ArtifactManager   artifacts.Client
+	ContractRequester *contractrequester.ContractRequester
+	BuiltIn           *builtin.BuiltIn
+	Ledger            *artifacts.InMemoryLedger
+}
+
+// New creates and starts harness. Call Stop when done.
+func New(t *testing.T) *Harness {
+	ctx := inslogger.TestContext(t)
+
+	lr, err := logicrunner.NewLogicRunner(&configuration.LogicRunner{
+		BuiltIn:        &configuration.BuiltIn{},
+		CallTraceLimit: 100,
+	})
+	require.NoError(t, err)
+
+	cryptoMock := testutils.NewCryptographyServiceMock(t)
+	cryptoMock.SignFunc = func(p []byte) (*insolar.Signature, error) {
+		signature := insolar.SignatureFromBytes(nil)
+		return &signature, nil
+	}
+	cryptoMock.GetPublicKeyFunc = func() (crypto.PublicKey, error) {
+		return nil, nil
+	}
+
+	mb := testmessagebus.NewTestMessageBus(t)
+	nk := nodekeeper.GetTestNodekeeper(cryptoMock)
+	nw := testutils.GetTestNetwork(t)
+
+	l := artifacts.NewInMemoryLedger(t, insolar.Components{
+		LogicRunner: lr,
+		NodeNetwork: nk,
+		MessageBus:  mb,
+		Network:     nw,
+	})
+
+	cr, err := contractrequester.New()
+	require.NoError(t, err)
+
+	cm := &component.Manager{}
+	cm.Register(platformpolicy.NewPlatformCryptographyScheme())
+	cm.Register(l.GetArtifactManager(), l.GetPulseManager(), l.GetJetCoordinator())
+	cm.Inject(
+		l.PulseAccessor,
+		nk,
+		recentstorage.NewProviderMock(t),
+		l.TMPLedger,
+		lr,
+		nw,
+		mb,
+		cr,
+		delegationtoken.NewDelegationTokenFactory(),
+		messagebus.NewParcelFactory(),
+		testutils.NewTerminationHandlerMock(t),
+		cryptoMock,
+	)
+	require.NoError(t, cm.Init(ctx))
+	require.NoError(t, cm.Start(ctx))
+
+	executor, err := lr.GetExecutor(insolar.MachineTypeBuiltin)
+	require.NoError(t, err)
+
+	h := &Harness{
+		t:   t,
+		ctx: ctx,
+		cm:  cm,
+
+		LogicRunner:       lr,
+		ArtifactManager:   l.GetArtifactManager(),
+		ContractRequester: cr,
+		BuiltIn:           executor.(*builtin.BuiltIn),
+		Ledger:            l,
+	}
+
+	h.NextPulse()
+
+	return h
+}
+
+// Stop stops all components of harness.
+func (h *Harness) Stop() {
+	err := h.cm.Stop(h.ctx)
+	require.NoError(h.t, err)
+}
+
+// Root returns reference of the object that can be used as a parent for new objects.
+func (h *Harness) Root() insolar.Reference {
+	return insolar.GenesisRecord.Ref()
+}
+
+// NewMember returns a new reference that can be used as a caller.
+func (h *Harness) NewMember() insolar.Reference {
+	return testutils.RandomRef()
+}
+
+// CurrentPulse returns the latest pulse.
+func (h *Harness) CurrentPulse() insolar.Pulse {
+	p, err := h.Ledger.PulseAccessor.Latest(h.ctx)
+	require.NoError(h.t, err)
+	return p
+}
+
+// NextPulse switches harness to the next pulse.
+func (h *Harness) NextPulse() insolar.Pulse {
+	p, err := h.Ledger.NextPulse(h.ctx)
+	require.NoError(h.t, err)
+	return p
+}
+
+// DeployPrototype registers contract wrappers in the builtin executor and deploys code
+// and prototype for them. Returns the prototype reference.
+func (h *Harness) DeployPrototype(name string, methods builtin.ContractMethods) insolar.Reference {
+	h.BuiltIn.Register(name, methods)
+
+	am := h.ArtifactManager
+	domain := h.Root()
+
+	codeReq, err := am.RegisterRequest(h.ctx, record.Request{CallType: record.CTGenesis, Method: name + "_code"})
+	require.NoError(h.t, err)
+	codeID, err := am.DeployCode(
+		h.ctx, domain, *insolar.NewReference(insolar.DomainID, *codeReq), []byte(name), insolar.MachineTypeBuiltin,
+	)
+	require.NoError(h.t, err)
+	codeRef := insolar.NewReference(insolar.DomainID, *codeID)
+
+	protoReq, err := am.RegisterRequest(h.ctx, record.Request{CallType: record.CTGenesis, Method: name})
+	require.NoError(h.t, err)
+	protoRef := insolar.NewReference(insolar.DomainID, *protoReq)
+	_, err = am.ActivatePrototype(h.ctx, domain, *protoRef, h.Root(), *codeRef, nil)
+	require.NoError(h.t, err)
+
+	return *protoRef
+}
+
+// CallConstructor creates a child of parent by calling a constructor of prototype
+// on behalf of caller. Returns reference of the new object.
+func (h *Harness) CallConstructor(
+	caller, parent, prototype insolar.Reference, name string, args ...interface{},
+) (insolar.Reference, error) {
+	arguments, err := insolar.Serialize(args)
+	if err != nil {
+		return insolar.Reference{}, errors.Wrap(err, "failed to serialize arguments")
+	}
+
+	msg := &message.CallMethod{
+		Request: record.Request{
+			Caller:    caller,
+			CallType:  record.CTSaveAsChild,
+			Base:      &parent,
+			Prototype: &prototype,
+			Method:    name,
+			Arguments: arguments,
+		},
+	}
+
+	ref, err := h.ContractRequester.CallConstructor(h.callContext(), msg)
+	if err != nil {
+		return insolar.Reference{}, err
+	}
+	return *ref, nil
+}
+
+// CallMethod calls a method of object on behalf of caller and returns deserialized results.
+func (h *Harness) CallMethod(
+	caller, object, prototype insolar.Reference, method string, args ...interface{},
+) ([]interface{}, error) {
+	arguments, err := insolar.Serialize(args)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize arguments")
+	}
+
+	msg := &message.CallMethod{
+		Request: record.Request{
+			Caller:    caller,
+			Object:    &object,
+			Prototype: &prototype,
+			Method:    method,
+			Arguments: arguments,
+		},
+	}
+
+	res, err := h.ContractRequester.CallMethod(h.callContext(), msg)
+	if err != nil {
+		return nil, err
+	}
+	rep, ok := res.(*reply.CallMethod)
+	if !ok {
+		return nil, errors.Errorf("unexpected reply type %T", res)
+	}
+
+	var results []interface{}
+	err = insolar.Deserialize(rep.Result, &results)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to deserialize results")
+	}
+	return results, nil
+}
+
+// Simulate calls a method of object in simulation mode, object's state isn't changed.
+func (h *Harness) Simulate(
+	caller, object, prototype insolar.Reference, method string, args ...interface{},
+) (*reply.SimulateCall, error) {
+	arguments, err := insolar.Serialize(args)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize arguments")
+	}
+
+	msg := &message.SimulateCall{
+		Request: record.Request{
+			Caller:    caller,
+			Object:    &object,
+			Prototype: &prototype,
+			Method:    method,
+			Arguments: arguments,
+		},
+	}
+
+	res, err := h.ContractRequester.Simulate(h.callContext(), msg)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*reply.SimulateCall), nil
+}
+
+// ObjectState deserializes the latest state of object into provided value.
+func (h *Harness) ObjectState(object insolar.Reference, into interface{}) error {
+	desc, err := h.ArtifactManager.GetObject(h.ctx, object)
+	if err != nil {
+		return errors.Wrap(err, "failed to get object")
+	}
+	return insolar.Deserialize(desc.Memory(), into)
+}
+
+// CallTree returns records of contract calls made in scope of the latest call of harness.
+func (h *Harness) CallTree() ([]insolar.CallRecord, error) {
+	return h.LogicRunner.CallTree(h.ctx, h.lastTraceID)
+}
+
+func (h *Harness) callContext() context.Context {
+	h.lastTraceID = utils.RandTraceID()
+	return inslogger.ContextWithTrace(h.ctx, h.lastTraceID)
+}
@@ -0,0 +1,132 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package contracttest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/builtin/contract/helloworld"
+)
+
+func TestHarness_Helloworld(t *testing.T) {
+	h := New(t)
+	defer h.Stop()
+
+	proto := h.DeployPrototype("helloworld", helloworld.Initialize())
+	alice := h.NewMember()
+	bob := h.NewMember()
+
+	obj, err := h.CallConstructor(alice, h.Root(), proto, "New")
+	require.NoError(t, err)
+
+	res, err := h.CallMethod(alice, obj, proto, "Greet", "Alice")
+	require.NoError(t, err)
+	assert.Equal(t, "Hello Alice' world", res[0])
+	assert.Nil(t, res[1])
+
+	before := h.CurrentPulse()
+	after := h.NextPulse()
+	assert.Equal(t, before.PulseNumber+1, after.PulseNumber)
+
+	res, err = h.CallMethod(bob, obj, proto, "Greet", "Bob")
+	require.NoError(t, err)
+	assert.Equal(t, "Hello Bob' world", res[0])
+
+	var state helloworld.HelloWorld
+	err = h.ObjectState(obj, &state)
+	require.NoError(t, err)
+	assert.Equal(t, 2, state.Greeted)
+}
+
+func TestHarness_UnknownMethod(t *testing.T) {
+	h := New(t)
+	defer h.Stop()
+
+	proto := h.DeployPrototype("helloworld", helloworld.Initialize())
+	member := h.NewMember()
+
+	obj, err := h.CallConstructor(member, h.Root(), proto, "New")
+	require.NoError(t, err)
+
+	_, err = h.CallMethod(member, obj, proto, "NoSuchMethod")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "INSMETHOD_NoSuchMethod")
+}
+
+func TestHarness_Simulate(t *testing.T) {
+	h := New(t)
+	defer h.Stop()
+
+	proto := h.DeployPrototype("helloworld", helloworld.Initialize())
+	member := h.NewMember()
+
+	obj, err := h.CallConstructor(member, h.Root(), proto, "New")
+	require.NoError(t, err)
+
+	sim, err := h.Simulate(member, obj, proto, "Greet", "Alice")
+	require.NoError(t, err)
+
+	var res []interface{}
+	require.NoError(t, insolar.Deserialize(sim.Result, &res))
+	assert.Equal(t, "Hello Alice' world", res[0])
+	assert.False(t, sim.Deactivated)
+	assert.Empty(t, sim.Calls)
+
+	var before, after helloworld.HelloWorld
+	require.NoError(t, insolar.Deserialize(sim.Memory, &before))
+	require.NoError(t, insolar.Deserialize(sim.NewMemory, &after))
+	assert.Equal(t, 0, before.Greeted)
+	assert.Equal(t, 1, after.Greeted)
+
+	var state helloworld.HelloWorld
+	require.NoError(t, h.ObjectState(obj, &state))
+	assert.Equal(t, 0, state.Greeted)
+
+	_, err = h.CallMethod(member, obj, proto, "Greet", "Bob")
+	require.NoError(t, err)
+	require.NoError(t, h.ObjectState(obj, &state))
+	assert.Equal(t, 1, state.Greeted)
+}
+
+func TestHarness_CallTree(t *testing.T) {
+	h := New(t)
+	defer h.Stop()
+
+	proto := h.DeployPrototype("helloworld", helloworld.Initialize())
+	member := h.NewMember()
+
+	obj, err := h.CallConstructor(member, h.Root(), proto, "New")
+	require.NoError(t, err)
+
+	_, err = h.CallMethod(member, obj, proto, "Greet", "Alice")
+	require.NoError(t, err)
+
+	calls, err := h.CallTree()
+	require.NoError(t, err)
+	require.Len(t, calls, 1)
+	assert.Equal(t, member, calls[0].Caller)
+	assert.Equal(t, obj, calls[0].Callee)
+	assert.Equal(t, proto, calls[0].Prototype)
+	assert.Equal(t, "Greet", calls[0].Method)
+	assert.Equal(t, h.CurrentPulse().PulseNumber, calls[0].Pulse)
+	assert.Equal(t, insolar.CallOutcomeSuccess, calls[0].Outcome)
+	assert.Empty(t, calls[0].Error)
+}
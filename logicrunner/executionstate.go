@@ -22,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
 )
 
 type ExecutionState struct {
@@ -33,6 +34,9 @@ type ExecutionState struct {
 	deactivate bool
 	nonce      uint64
 
+	// outgoing calls made by the object in simulation mode
+	simulatedCalls []reply.SimulatedCall
+
 	Current               *CurrentExecution
 	Queue                 []ExecutionQueueElement
 	QueueProcessorActive  bool
@@ -17,6 +17,7 @@
 package ginsider
 
 import (
+	"container/list"
 	"context"
 	"fmt"
 	"io/ioutil"
@@ -45,6 +46,10 @@ import (
 type pluginRec struct {
 	sync.Mutex
 	plugin *plugin.Plugin
+
+	// pinned records are added with AddPlugin and never evicted
+	pinned bool
+	elem   *list.Element
 }
 
 // GoInsider is an RPC interface to run code of plugins
@@ -57,7 +62,13 @@ type GoInsider struct {
 	UpstreamClient *rpc.Client
 
 	plugins      map[insolar.Reference]*pluginRec
+	pluginsLRU   *list.List // of insolar.Reference, the most recently used at front
 	pluginsMutex sync.Mutex
+
+	// PluginsLimit is a number of plugins obtained from ledger that are kept in cache, 0 is unlimited.
+	// Go runtime can't unload a plugin, so eviction drops cached code and reference only,
+	// memory is returned when insgorund is restarted.
+	PluginsLimit int
 }
 
 // NewGoInsider creates a new GoInsider instance validating arguments
@@ -65,6 +76,7 @@ func NewGoInsider(path, network, address string) *GoInsider {
 	//TODO: check that path exist, it's a directory and writable
 	res := GoInsider{dir: path, upstreamProtocol: network, upstreamAddress: address}
 	res.plugins = make(map[insolar.Reference]*pluginRec)
+	res.pluginsLRU = list.New()
 	proxyctx.Current = &res
 	return &res
 }
@@ -251,8 +263,10 @@ func (gi *GoInsider) Plugin(ctx context.Context, ref insolar.Reference) (*plugin
 	defer rec.Unlock()
 
 	if rec.plugin != nil {
+		metrics.InsgorundPluginCacheHits.Inc()
 		return rec.plugin, nil
 	}
+	metrics.InsgorundPluginCacheMisses.Inc()
 
 	path, err := gi.ObtainCode(ctx, ref)
 	if err != nil {
@@ -269,19 +283,43 @@ func (gi *GoInsider) Plugin(ctx context.Context, ref insolar.Reference) (*plugin
 	return p, nil
 }
 
-// getPluginRec return existed gi.plugins[ref] or create a new one
-// also set gi.plugins[ref].Lock()
+// getPluginRec return existed gi.plugins[ref] or create a new one,
+// marks it as the most recently used and evicts the least recently used ones over the limit
 func (gi *GoInsider) getPluginRec(ref insolar.Reference) *pluginRec {
 	gi.pluginsMutex.Lock()
 	defer gi.pluginsMutex.Unlock()
 
-	if gi.plugins[ref] == nil {
-		gi.plugins[ref] = &pluginRec{}
-	}
 	res := gi.plugins[ref]
+	if res == nil {
+		res = &pluginRec{}
+		res.elem = gi.pluginsLRU.PushFront(ref)
+		gi.plugins[ref] = res
+		metrics.InsgorundPluginCacheSize.Set(float64(len(gi.plugins)))
+	} else if !res.pinned {
+		gi.pluginsLRU.MoveToFront(res.elem)
+	}
+
+	gi.evictPlugins()
 	return res
 }
 
+// evictPlugins removes the least recently used plugins over PluginsLimit, pluginsMutex must be held
+func (gi *GoInsider) evictPlugins() {
+	for gi.PluginsLimit > 0 && gi.pluginsLRU.Len() > gi.PluginsLimit {
+		ref := gi.pluginsLRU.Remove(gi.pluginsLRU.Back()).(insolar.Reference)
+		delete(gi.plugins, ref)
+
+		// cached code is removed too, so the directory doesn't grow, it's obtained again on the next call
+		err := os.Remove(filepath.Join(gi.dir, ref.String()))
+		if err != nil && !os.IsNotExist(err) {
+			log.Warnf("failed to remove code of evicted plugin %s: %s", ref, err)
+		}
+
+		metrics.InsgorundPluginCacheEvictions.Inc()
+		metrics.InsgorundPluginCacheSize.Set(float64(len(gi.plugins)))
+	}
+}
+
 // MakeUpBaseReq makes base of request from current CallContext
 func MakeUpBaseReq() rpctypes.UpBaseReq {
 	callCtx, ok := gls.Get("callCtx").(*insolar.LogicCallContext)
@@ -500,6 +538,13 @@ func (gi *GoInsider) AddPlugin(ref insolar.Reference, path string) error {
 		return errors.New("ref already in use")
 	}
 
+	gi.pluginsMutex.Lock()
+	if !rec.pinned {
+		rec.pinned = true
+		gi.pluginsLRU.Remove(rec.elem)
+	}
+	gi.pluginsMutex.Unlock()
+
 	p, err := plugin.Open(path)
 	if err != nil {
 		return errors.Wrap(err, "[ AddPlugin ] couldn't open plugin")
@@ -0,0 +1,64 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package ginsider
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestGoInsider_PluginsLimit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "contractcache-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	gi := NewGoInsider(dir, "unix", "")
+	gi.PluginsLimit = 2
+
+	first, second, third := testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef()
+	for _, ref := range []string{first.String(), second.String(), third.String()} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ref), []byte{1}, 0666))
+	}
+
+	pinned := testutils.RandomRef()
+	gi.getPluginRec(pinned).pinned = true
+	gi.pluginsLRU.Remove(gi.plugins[pinned].elem)
+
+	gi.getPluginRec(first)
+	gi.getPluginRec(second)
+	// first becomes the most recently used, so second is evicted
+	gi.getPluginRec(first)
+	gi.getPluginRec(third)
+
+	assert.Len(t, gi.plugins, 3)
+	assert.Contains(t, gi.plugins, pinned)
+	assert.Contains(t, gi.plugins, first)
+	assert.Contains(t, gi.plugins, third)
+	assert.NotContains(t, gi.plugins, second)
+
+	_, err = os.Stat(filepath.Join(dir, second.String()))
+	assert.True(t, os.IsNotExist(err), "code of evicted plugin must be removed")
+	_, err = os.Stat(filepath.Join(dir, first.String()))
+	assert.NoError(t, err)
+}
@@ -20,7 +20,6 @@ package goplugin
 import (
 	"context"
 	"net/rpc"
-	"sync"
 	"time"
 
 	"github.com/insolar/insolar/logicrunner/artifacts"
@@ -55,16 +54,27 @@ type GoPlugin struct {
 	MessageBus      insolar.MessageBus
 	ArtifactManager artifacts.Client
 
-	clientMutex sync.Mutex
-	client      *rpc.Client
+	pool *runnerPool
 }
 
 // NewGoPlugin returns a new started GoPlugin
 func NewGoPlugin(conf *configuration.LogicRunner, eb insolar.MessageBus, am artifacts.Client) (*GoPlugin, error) {
+	pool, err := newRunnerPool(conf)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't create pool of runners")
+	}
+
 	gp := GoPlugin{
 		Cfg:             conf,
 		MessageBus:      eb,
 		ArtifactManager: am,
+		pool:            pool,
+	}
+
+	err = pool.Start(context.Background())
+	if err != nil {
+		pool.Stop()
+		return nil, errors.Wrap(err, "couldn't start pool of runners")
 	}
 
 	return &gp, nil
@@ -72,51 +82,35 @@ func NewGoPlugin(conf *configuration.LogicRunner, eb insolar.MessageBus, am arti
 
 // Stop stops runner(s) and RPC service
 func (gp *GoPlugin) Stop() error {
+	gp.pool.Stop()
 	return nil
 }
 
 const timeout = time.Minute * 10
 
-// Downstream returns a connection to `ginsider`
+// Downstream returns a connection to the first `ginsider`
 func (gp *GoPlugin) Downstream(ctx context.Context) (*rpc.Client, error) {
-	gp.clientMutex.Lock()
-	defer gp.clientMutex.Unlock()
-
-	if gp.client != nil {
-		return gp.client, nil
-	}
-
-	client, err := rpc.Dial(gp.Cfg.GoPlugin.RunnerProtocol, gp.Cfg.GoPlugin.RunnerListen)
-	if err != nil {
-		return nil, errors.Wrapf(
-			err, "couldn't dial '%s' over %s",
-			gp.Cfg.GoPlugin.RunnerListen, gp.Cfg.GoPlugin.RunnerProtocol,
-		)
-	}
-
-	gp.client = client
-	return gp.client, nil
+	return gp.pool.workers[0].Downstream(ctx)
 }
 
+// CloseDownstream closes connections to all `ginsider`s
 func (gp *GoPlugin) CloseDownstream() {
-	gp.clientMutex.Lock()
-	defer gp.clientMutex.Unlock()
-
-	// this method can be called multiple times from callClientWithReconnect
-	if gp.client != nil {
-		gp.client.Close()
-		gp.client = nil
+	for _, w := range gp.pool.workers {
+		w.CloseDownstream()
 	}
 }
 
-func (gp *GoPlugin) callClientWithReconnect(ctx context.Context, method string, req interface{}, res interface{}) error {
+func (gp *GoPlugin) callClientWithReconnect(
+	ctx context.Context, code insolar.Reference, method string, req interface{}, res interface{},
+) error {
 	inslogger.FromContext(ctx).Debug("GoPlugin.callClientWithReconnect starts")
 	var err error
 	var client *rpc.Client
 
 	for {
-		inslogger.FromContext(ctx).Info("Connect to insgorund")
-		client, err = gp.Downstream(ctx)
+		w := gp.pool.Worker(code)
+		inslogger.FromContext(ctx).Infof("Connect to insgorund %d", w.id)
+		client, err = w.Downstream(ctx)
 		if err == nil {
 			call := <-client.Go(method, req, res, nil).Done
 			err = call.Error
@@ -125,7 +119,7 @@ func (gp *GoPlugin) callClientWithReconnect(ctx context.Context, method string,
 				break
 			} else {
 				inslogger.FromContext(ctx).Debug("Connection to insgorund is closed, need to reconnect")
-				gp.CloseDownstream()
+				w.CloseDownstream()
 				inslogger.FromContext(ctx).Debugf("Reconnecting...")
 			}
 		} else {
@@ -145,7 +139,7 @@ type CallMethodResult struct {
 func (gp *GoPlugin) CallMethodRPC(ctx context.Context, req rpctypes.DownCallMethodReq, res rpctypes.DownCallMethodResp, resultChan chan CallMethodResult) {
 	inslogger.FromContext(ctx).Debug("GoPlugin.CallMethodRPC starts ...")
 	method := "RPC.CallMethod"
-	callClientError := gp.callClientWithReconnect(ctx, method, req, &res)
+	callClientError := gp.callClientWithReconnect(ctx, req.Code, method, req, &res)
 	resultChan <- CallMethodResult{Response: res, Error: callClientError}
 }
 
@@ -197,7 +191,7 @@ type CallConstructorResult struct {
 
 func (gp *GoPlugin) CallConstructorRPC(ctx context.Context, req rpctypes.DownCallConstructorReq, res rpctypes.DownCallConstructorResp, resultChan chan CallConstructorResult) {
 	method := "RPC.CallConstructor"
-	callClientError := gp.callClientWithReconnect(ctx, method, req, &res)
+	callClientError := gp.callClientWithReconnect(ctx, req.Code, method, req, &res)
 	resultChan <- CallConstructorResult{Response: res, Error: callClientError}
 }
 
@@ -163,7 +163,7 @@ func (t *TestArtifactManager) State() ([]byte, error) {
 }
 
 // GetChildren implementation for tests
-func (t *TestArtifactManager) GetChildren(ctx context.Context, parent insolar.Reference, pulse *insolar.PulseNumber) (artifacts.RefIterator, error) {
+func (t *TestArtifactManager) GetChildren(ctx context.Context, parent insolar.Reference, prototype *insolar.Reference, pulse *insolar.PulseNumber) (artifacts.RefIterator, error) {
 	panic("implement me")
 }
 
@@ -26,6 +26,7 @@ import (
 
 var (
 	tagMethodName = insmetrics.MustTagKey("methodName")
+	tagWorker     = insmetrics.MustTagKey("worker")
 )
 
 var (
@@ -34,6 +35,16 @@ var (
 		"time spent on execution contract, measured in goplugin",
 		stats.UnitMilliseconds,
 	)
+	statGopluginRunnerRestarts = stats.Int64(
+		"goplugin/runner/restarts",
+		"number of insgorund restarts",
+		stats.UnitDimensionless,
+	)
+	statGopluginHealthCheckFailures = stats.Int64(
+		"goplugin/runner/healthcheck/failures",
+		"number of failed insgorund health checks",
+		stats.UnitDimensionless,
+	)
 )
 
 func init() {
@@ -43,6 +54,16 @@ func init() {
 			Aggregation: view.Distribution(0.001, 0.01, 0.1, 1, 10, 100, 1000, 5000, 10000, 20000),
 			TagKeys:     []tag.Key{tagMethodName},
 		},
+		&view.View{
+			Measure:     statGopluginRunnerRestarts,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagWorker},
+		},
+		&view.View{
+			Measure:     statGopluginHealthCheckFailures,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagWorker},
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -0,0 +1,396 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package goplugin
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+)
+
+const (
+	restartDelay    = time.Second
+	maxRestartDelay = time.Minute
+)
+
+// worker is a connection to one insgorund. If insgorund is started by the pool,
+// worker also owns the process and restarts it when the process exits.
+type worker struct {
+	id       int
+	protocol string
+	address  string
+
+	healthy bool
+	mutex   sync.Mutex
+
+	clientMutex sync.Mutex
+	client      *rpc.Client
+
+	cmd  *exec.Cmd
+	stop chan struct{}
+	done chan struct{}
+}
+
+// Downstream returns a connection to worker's insgorund
+func (w *worker) Downstream(ctx context.Context) (*rpc.Client, error) {
+	w.clientMutex.Lock()
+	defer w.clientMutex.Unlock()
+
+	if w.client != nil {
+		return w.client, nil
+	}
+
+	client, err := rpc.Dial(w.protocol, w.address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't dial '%s' over %s", w.address, w.protocol)
+	}
+
+	w.client = client
+	return w.client, nil
+}
+
+// CloseDownstream closes connection to worker's insgorund, next call dials again
+func (w *worker) CloseDownstream() {
+	w.clientMutex.Lock()
+	defer w.clientMutex.Unlock()
+
+	// this method can be called multiple times from callClientWithReconnect
+	if w.client != nil {
+		w.client.Close()
+		w.client = nil
+	}
+}
+
+func (w *worker) isHealthy() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.healthy
+}
+
+func (w *worker) setHealthy(healthy bool) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.healthy = healthy
+}
+
+// kill terminates insgorund process of the worker, so it's restarted by supervise
+func (w *worker) kill() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.cmd != nil && w.cmd.Process != nil {
+		_ = w.cmd.Process.Kill()
+	}
+}
+
+// runnerPool is a set of insgorund workers. Calls are routed to workers by code reference,
+// so every plugin is opened only by one of them.
+type runnerPool struct {
+	cfg     *configuration.LogicRunner
+	workers []*worker
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newRunnerPool(cfg *configuration.LogicRunner) (*runnerPool, error) {
+	gpCfg := cfg.GoPlugin
+
+	count := gpCfg.RunnerCount
+	if count < 1 {
+		count = 1
+	}
+
+	pool := &runnerPool{
+		cfg:  cfg,
+		stop: make(chan struct{}),
+	}
+	for i := 0; i < count; i++ {
+		address, err := workerAddress(gpCfg.RunnerProtocol, gpCfg.RunnerListen, i)
+		if err != nil {
+			return nil, err
+		}
+		pool.workers = append(pool.workers, &worker{
+			id:       i,
+			protocol: gpCfg.RunnerProtocol,
+			address:  address,
+			healthy:  true,
+		})
+	}
+
+	return pool, nil
+}
+
+// workerAddress returns address of n-th worker. Workers of tcp pool listen to sequential ports,
+// workers of unix pool listen to sockets with numeric suffix.
+func workerAddress(protocol, address string, n int) (string, error) {
+	if n == 0 {
+		return address, nil
+	}
+
+	if strings.HasPrefix(protocol, "unix") {
+		return fmt.Sprintf("%s.%d", address, n), nil
+	}
+
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", errors.Wrapf(err, "couldn't parse runner address '%s'", address)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return "", errors.Wrapf(err, "couldn't parse runner port '%s'", portStr)
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port+n)), nil
+}
+
+// Start starts insgorund processes if pool manages them and health checks
+func (p *runnerPool) Start(ctx context.Context) error {
+	if p.cfg.GoPlugin.RunnerPath != "" {
+		for _, w := range p.workers {
+			if err := p.startProcess(ctx, w); err != nil {
+				return err
+			}
+			w.stop = make(chan struct{})
+			w.done = make(chan struct{})
+			go p.supervise(ctx, w)
+		}
+	}
+
+	if p.cfg.GoPlugin.HealthCheckPeriod > 0 {
+		p.wg.Add(1)
+		go p.healthCheckLoop(ctx, time.Duration(p.cfg.GoPlugin.HealthCheckPeriod)*time.Millisecond)
+	}
+	return nil
+}
+
+// Stop stops health checks and insgorund processes started by pool
+func (p *runnerPool) Stop() {
+	close(p.stop)
+	p.wg.Wait()
+
+	for _, w := range p.workers {
+		w.CloseDownstream()
+		if w.stop == nil {
+			continue
+		}
+		close(w.stop)
+		w.kill()
+		<-w.done
+	}
+}
+
+// Worker returns worker that serves code. If the worker is unhealthy, the next healthy one is used.
+func (p *runnerPool) Worker(code insolar.Reference) *worker {
+	h := fnv.New32a()
+	_, _ = h.Write(code.Bytes())
+	n := int(h.Sum32() % uint32(len(p.workers)))
+
+	for i := 0; i < len(p.workers); i++ {
+		w := p.workers[(n+i)%len(p.workers)]
+		if w.isHealthy() {
+			return w
+		}
+	}
+	return p.workers[n]
+}
+
+func (p *runnerPool) startProcess(ctx context.Context, w *worker) error {
+	gpCfg := p.cfg.GoPlugin
+
+	args := []string{
+		"--listen", w.address,
+		"--proto", w.protocol,
+		"--rpc", p.cfg.RPCListen,
+		"--rpc-proto", p.cfg.RPCProtocol,
+		"--plugins-limit", strconv.Itoa(gpCfg.RunnerPluginsLimit),
+	}
+	if gpCfg.RunnerCodePath != "" {
+		dir := filepath.Join(gpCfg.RunnerCodePath, strconv.Itoa(w.id))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return errors.Wrap(err, "couldn't create code directory for runner")
+		}
+		args = append(args, "--directory", dir)
+	}
+	if gpCfg.HealthCheckCode != "" {
+		args = append(args, "--code", gpCfg.HealthCheckCode)
+	}
+
+	if strings.HasPrefix(w.protocol, "unix") {
+		// socket of crashed process is left behind
+		_ = os.Remove(w.address)
+	}
+
+	cmd := exec.Command(gpCfg.RunnerPath, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return errors.Wrapf(err, "couldn't start runner %d", w.id)
+	}
+	inslogger.FromContext(ctx).Infof("runner %d started, pid %d, listens %s", w.id, cmd.Process.Pid, w.address)
+
+	w.mutex.Lock()
+	w.cmd = cmd
+	w.mutex.Unlock()
+	return nil
+}
+
+// supervise waits for insgorund process of the worker and restarts it until the pool is stopped
+func (p *runnerPool) supervise(ctx context.Context, w *worker) {
+	defer close(w.done)
+
+	logger := inslogger.FromContext(ctx)
+	ctx = insmetrics.InsertTag(ctx, tagWorker, strconv.Itoa(w.id))
+	delay := restartDelay
+
+	for {
+		w.mutex.Lock()
+		cmd := w.cmd
+		w.mutex.Unlock()
+
+		if cmd != nil {
+			err := cmd.Wait()
+			w.CloseDownstream()
+
+			select {
+			case <-w.stop:
+				return
+			default:
+			}
+			logger.Warnf("runner %d exited: %v, restarting", w.id, err)
+		}
+
+		select {
+		case <-w.stop:
+			return
+		case <-time.After(delay):
+		}
+
+		stats.Record(ctx, statGopluginRunnerRestarts.M(1))
+		err := p.startProcess(ctx, w)
+		if err != nil {
+			logger.Error(err)
+			w.mutex.Lock()
+			w.cmd = nil
+			w.mutex.Unlock()
+
+			delay *= 2
+			if delay > maxRestartDelay {
+				delay = maxRestartDelay
+			}
+			continue
+		}
+		delay = restartDelay
+
+		select {
+		case <-w.stop:
+			// pool is stopped while the process was starting
+			w.kill()
+		default:
+		}
+	}
+}
+
+func (p *runnerPool) healthCheckLoop(ctx context.Context, period time.Duration) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		}
+
+		for _, w := range p.workers {
+			err := p.healthCheck(w, period)
+			if err == nil {
+				w.setHealthy(true)
+				continue
+			}
+
+			inslogger.FromContext(ctx).Warnf("runner %d failed health check: %v", w.id, err)
+			stats.Record(
+				insmetrics.InsertTag(ctx, tagWorker, strconv.Itoa(w.id)),
+				statGopluginHealthCheckFailures.M(1),
+			)
+			w.setHealthy(false)
+			w.CloseDownstream()
+			w.kill()
+		}
+	}
+}
+
+// healthCheck calls healthcheck contract in the worker if it's configured, otherwise
+// it only checks that the worker accepts connections
+func (p *runnerPool) healthCheck(w *worker, timeout time.Duration) error {
+	conn, err := net.DialTimeout(w.protocol, w.address, timeout)
+	if err != nil {
+		return err
+	}
+	client := rpc.NewClient(conn)
+	defer client.Close()
+
+	code := p.cfg.GoPlugin.HealthCheckCode
+	if code == "" {
+		return nil
+	}
+
+	ref, err := insolar.NewReferenceFromBase58(strings.Split(code, ":")[0])
+	if err != nil {
+		return errors.Wrap(err, "couldn't parse healthcheck contract ref")
+	}
+
+	empty, err := insolar.Serialize([]interface{}{})
+	if err != nil {
+		return err
+	}
+	req := rpctypes.DownCallMethodReq{
+		Context:   &insolar.LogicCallContext{Caller: &insolar.Reference{}},
+		Code:      *ref,
+		Data:      empty,
+		Method:    "Check",
+		Arguments: empty,
+	}
+
+	call := client.Go("RPC.CallMethod", req, &rpctypes.DownCallMethodResp{}, nil)
+	select {
+	case <-call.Done:
+		return call.Error
+	case <-time.After(timeout):
+		return errors.New("healthcheck timeout")
+	}
+}
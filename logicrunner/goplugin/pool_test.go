@@ -0,0 +1,104 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package goplugin
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestWorkerAddress(t *testing.T) {
+	address, err := workerAddress("tcp", "127.0.0.1:7777", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:7777", address)
+
+	address, err = workerAddress("tcp", "127.0.0.1:7777", 2)
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:7779", address)
+
+	address, err = workerAddress("unix", "/tmp/insgorund.sock", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/insgorund.sock.1", address)
+
+	_, err = workerAddress("tcp", "localhost", 1)
+	require.Error(t, err)
+}
+
+func TestRunnerPool_Worker(t *testing.T) {
+	pool, err := newRunnerPool(&configuration.LogicRunner{
+		GoPlugin: &configuration.GoPlugin{
+			RunnerListen:   "127.0.0.1:7777",
+			RunnerProtocol: "tcp",
+			RunnerCount:    3,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, pool.workers, 3)
+
+	code := testutils.RandomRef()
+	w := pool.Worker(code)
+	assert.Equal(t, w, pool.Worker(code), "code must be routed to the same worker")
+
+	w.setHealthy(false)
+	other := pool.Worker(code)
+	assert.NotEqual(t, w, other, "unhealthy worker must be skipped")
+
+	w.setHealthy(true)
+	assert.Equal(t, w, pool.Worker(code))
+}
+
+func TestRunnerPool_RestartsCrashedRunner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "runnerpool-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// fake insgorund that records its start and crashes
+	starts := filepath.Join(dir, "starts")
+	runner := filepath.Join(dir, "insgorund")
+	err = ioutil.WriteFile(runner, []byte("#!/bin/sh\necho started >> "+starts+"\nexit 1\n"), 0755)
+	require.NoError(t, err)
+
+	pool, err := newRunnerPool(&configuration.LogicRunner{
+		GoPlugin: &configuration.GoPlugin{
+			RunnerListen:   filepath.Join(dir, "insgorund.sock"),
+			RunnerProtocol: "unix",
+			RunnerPath:     runner,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, pool.Start(context.Background()))
+	defer pool.Stop()
+
+	for start := time.Now(); time.Since(start) < time.Minute; time.Sleep(100 * time.Millisecond) {
+		data, _ := ioutil.ReadFile(starts)
+		if strings.Count(string(data), "started") >= 2 {
+			return
+		}
+	}
+	t.Fatal("runner is not restarted")
+}
@@ -35,7 +35,11 @@ type initializeAbandonedRequestsNotificationExecutionState struct {
 	msg *message.AbandonedRequestsNotification
 }
 
-// Proceed initializes or sets LedgerHasMoreRequests to right value
+// Proceed initializes or sets LedgerHasMoreRequests to right value.
+// Requests are drained from ledger right away only if nothing is executed on the object and no executor is known
+// to work on it. An object without execution state isn't reported as pending by the previous executor, so its
+// requests are drained right away too. Otherwise they are fetched when the queue is processed,
+// or on the pulse change if the previous executor doesn't confirm its pending.
 func (p *initializeAbandonedRequestsNotificationExecutionState) Proceed(ctx context.Context) error {
 	ref := *p.msg.DefaultTarget()
 
@@ -46,17 +50,18 @@ func (p *initializeAbandonedRequestsNotificationExecutionState) Proceed(ctx cont
 		state.ExecutionState = &ExecutionState{
 			Ref:                   ref,
 			Queue:                 make([]ExecutionQueueElement, 0),
-			pending:               message.InPending,
+			pending:               message.NotPending,
 			PendingConfirmed:      false,
 			LedgerHasMoreRequests: true,
 		}
-	} else {
-		executionState := state.ExecutionState
-		executionState.Lock()
-		executionState.LedgerHasMoreRequests = true
-		executionState.Unlock()
-
 	}
+	executionState := state.ExecutionState
+	executionState.Lock()
+	executionState.LedgerHasMoreRequests = true
+	if executionState.Current == nil && executionState.pending == message.NotPending {
+		p.LR.startGetLedgerPendingRequest(ctx, executionState)
+	}
+	executionState.Unlock()
 	state.Unlock()
 
 	return nil
@@ -83,6 +83,10 @@ func (h *HandleCall) executeActual(
 	es.Unlock()
 
 	request, err := lr.RegisterRequest(ctx, parcel)
+	if overloaded, ok := errors.Cause(err).(*insolar.ObjectOverloadedError); ok {
+		// Errors are sent to the caller as text, typed reply keeps the suggested back-off.
+		return &reply.ObjectOverloaded{Object: overloaded.Object, RetryAfter: overloaded.RetryAfter}, nil
+	}
 	if err != nil {
 		return nil, os.WrapError(err, "[ Execute ] can't create request")
 	}
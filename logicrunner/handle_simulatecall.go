@@ -0,0 +1,160 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/flow/bus"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/instracer"
+)
+
+// HandleSimulateCall executes method in "simulation" mode. Request is not registered,
+// object is not updated and result is not saved, outgoing calls of the contract are
+// recorded instead of being sent, calls waiting for result are simulated too.
+type HandleSimulateCall struct {
+	dep *Dependencies
+
+	Message bus.Message
+}
+
+func (h *HandleSimulateCall) Present(ctx context.Context, f flow.Flow) error {
+	parcel := h.Message.Parcel
+	ctx = loggerWithTargetID(ctx, parcel)
+	inslogger.FromContext(ctx).Debug("HandleSimulateCall.Present starts ...")
+
+	msg, ok := parcel.Message().(*message.SimulateCall)
+	if !ok {
+		return errors.New("is not SimulateCall message")
+	}
+
+	ctx, span := instracer.StartSpan(ctx, "LogicRunner.Simulate")
+	span.AddAttributes(
+		trace.StringAttribute("msg.Method", msg.Method),
+	)
+	defer span.End()
+
+	r := bus.Reply{}
+	r.Reply, r.Err = h.simulate(ctx, msg, f)
+
+	h.Message.ReplyTo <- r
+	return nil
+}
+
+func (h *HandleSimulateCall) simulate(
+	ctx context.Context, msg *message.SimulateCall, f flow.Flow,
+) (insolar.Reply, error) {
+	lr := h.dep.lr
+
+	if msg.CallType != record.CTMethod || msg.Object == nil {
+		return nil, errors.New("[ simulate ] only method calls can be simulated")
+	}
+
+	procCheckRole := CheckOurRole{
+		msg:  msg,
+		role: insolar.DynamicRoleVirtualExecutor,
+		lr:   lr,
+	}
+	if err := f.Procedure(ctx, &procCheckRole, true); err != nil {
+		return nil, errors.Wrap(err, "[ simulate ] can't play role")
+	}
+
+	ref := *msg.Object
+	os := lr.UpsertObjectState(ref)
+
+	os.Lock()
+	if sim := os.Simulation; sim != nil && inslogger.TraceID(sim.Current.Context) == inslogger.TraceID(ctx) {
+		os.Unlock()
+		return nil, errors.New("[ simulate ] loop detected")
+	}
+	os.simulations++
+	os.Unlock()
+
+	os.simulationLock.Lock()
+	defer func() {
+		os.Lock()
+		os.Simulation = nil
+		os.simulations--
+		os.Unlock()
+		os.simulationLock.Unlock()
+	}()
+
+	objDesc, protoDesc, codeDesc, err := lr.getDescriptorsByObjectRef(ctx, ref)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get descriptors by object reference")
+	}
+	if msg.Prototype != nil && !msg.Prototype.Equal(*protoDesc.HeadRef()) {
+		return nil, errors.New("proxy call error: try to call method of prototype as method of another prototype")
+	}
+
+	es := &ExecutionState{Ref: ref}
+	es.Current = &CurrentExecution{
+		Context: ctx,
+		Request: &Ref{},
+		LogicContext: &insolar.LogicCallContext{
+			Mode:            "simulation",
+			Caller:          msg.GetCaller(),
+			Callee:          &ref,
+			Request:         &Ref{},
+			Prototype:       protoDesc.HeadRef(),
+			Code:            codeDesc.Ref(),
+			Parent:          objDesc.Parent(),
+			Immutable:       msg.Immutable,
+			Time:            time.Now(),
+			Pulse:           *lr.pulse(ctx),
+			TraceID:         inslogger.TraceID(ctx),
+			CallerPrototype: &msg.CallerPrototype,
+		},
+	}
+
+	os.Lock()
+	os.Simulation = es
+	os.Unlock()
+
+	executor, err := lr.GetExecutor(codeDesc.MachineType())
+	if err != nil {
+		return nil, errors.Wrap(err, "no executor registered")
+	}
+
+	newData, result, err := executor.CallMethod(
+		ctx, es.Current.LogicContext, *codeDesc.Ref(), objDesc.Memory(), msg.Method, msg.Arguments,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "executor error")
+	}
+
+	es.Lock()
+	defer es.Unlock()
+
+	return &reply.SimulateCall{
+		Result:      result,
+		Memory:      objDesc.Memory(),
+		NewMemory:   newData,
+		Deactivated: es.deactivate,
+		Calls:       es.simulatedCalls,
+	}, nil
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/insolar/insolar/insolar/jet"
 	"github.com/insolar/insolar/insolar/record"
 
+	"go.opencensus.io/stats"
 	"go.opencensus.io/trace"
 
 	"github.com/insolar/insolar/insolar/pulse"
@@ -68,6 +69,12 @@ type ObjectState struct {
 	ExecutionState *ExecutionState
 	Validation     *ExecutionState
 	Consensus      *Consensus
+
+	// Simulation is a state of running simulation, simulations of the object are
+	// serialized with simulationLock, simulations counts running and waiting ones
+	Simulation     *ExecutionState
+	simulationLock sync.Mutex
+	simulations    int
 }
 
 type CurrentExecution struct {
@@ -117,6 +124,8 @@ func (st *ObjectState) MustModeState(mode string) (res *ExecutionState) {
 		res = st.ExecutionState
 	case "validation":
 		res = st.Validation
+	case "simulation":
+		res = st.Simulation
 	default:
 		panic("'" + mode + "' is unknown object processing mode")
 	}
@@ -163,6 +172,8 @@ type LogicRunner struct {
 	state      map[Ref]*ObjectState // if object exists, we are validating or executing it right now
 	stateMutex sync.RWMutex
 
+	callTraces *callTraces
+
 	sock net.Listener
 
 	stopLock   sync.Mutex
@@ -183,8 +194,9 @@ func NewLogicRunner(cfg *configuration.LogicRunner) (*LogicRunner, error) {
 		return nil, errors.New("LogicRunner have nil configuration")
 	}
 	res := LogicRunner{
-		Cfg:   cfg,
-		state: make(map[Ref]*ObjectState),
+		Cfg:        cfg,
+		state:      make(map[Ref]*ObjectState),
+		callTraces: newCallTraces(cfg.CallTraceLimit),
 	}
 
 	err := initHandlers(&res)
@@ -258,7 +270,7 @@ func initHandlers(lr *LogicRunner) error {
 // Start starts logic runner component
 func (lr *LogicRunner) Start(ctx context.Context) error {
 	if lr.Cfg.BuiltIn != nil {
-		bi := builtin.NewBuiltIn(lr.MessageBus, lr.ArtifactManager)
+		bi := builtin.NewBuiltIn(lr.MessageBus, lr.ArtifactManager, &RPC{lr: lr})
 		if err := lr.RegisterExecutor(insolar.MachineTypeBuiltin, bi); err != nil {
 			return err
 		}
@@ -293,6 +305,8 @@ func (lr *LogicRunner) RegisterHandlers() {
 	lr.MessageBus.MustRegister(insolar.TypePendingFinished, lr.FlowDispatcher.WrapBusHandle)
 	lr.MessageBus.MustRegister(insolar.TypeStillExecuting, lr.FlowDispatcher.WrapBusHandle)
 	lr.MessageBus.MustRegister(insolar.TypeAbandonedRequestsNotification, lr.FlowDispatcher.WrapBusHandle)
+	lr.MessageBus.MustRegister(insolar.TypeSimulateCall, lr.FlowDispatcher.WrapBusHandle)
+	lr.MessageBus.MustRegister(insolar.TypeGetCallTree, lr.HandleGetCallTreeMessage)
 }
 
 // Stop stops logic runner component and its executors
@@ -449,6 +463,7 @@ func (lr *LogicRunner) executeOrValidate(
 
 	var re insolar.Reply
 	var err error
+	start := time.Now()
 	switch msg.CallType {
 	case record.CTMethod:
 		es.Current.LogicContext.Immutable = msg.Immutable
@@ -460,6 +475,7 @@ func (lr *LogicRunner) executeOrValidate(
 	default:
 		panic("Unknown e type")
 	}
+	lr.recordCall(ctx, span, es, msg, start, re, err)
 	errstr := ""
 	if err != nil {
 		inslogger.FromContext(ctx).Warn("contract execution error: ", err)
@@ -562,6 +578,8 @@ func (lr *LogicRunner) unsafeGetLedgerPendingRequest(ctx context.Context, es *Ex
 		return nil
 	}
 
+	stats.Record(ctx, statPendingRequestAge.M(int64(pulse-parcel.Pulse())))
+
 	request := msg.GetReference()
 	request.SetRecord(id)
 
@@ -862,7 +880,7 @@ func (lr *LogicRunner) OnPulse(ctx context.Context, pulse insolar.Pulse) error {
 			es.Unlock()
 		}
 
-		if state.ExecutionState == nil && state.Validation == nil && state.Consensus == nil {
+		if state.ExecutionState == nil && state.Validation == nil && state.Consensus == nil && state.simulations == 0 {
 			delete(lr.state, ref)
 		}
 
@@ -43,6 +43,8 @@ import (
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
 	"github.com/insolar/insolar/pulsar"
 	"github.com/insolar/insolar/pulsar/entropygenerator"
 
@@ -665,6 +667,8 @@ func (suite *LogicRunnerTestSuite) TestHandleAbandonedRequestsNotificationMessag
 	_, err := suite.lr.HandleAbandonedRequestsNotificationMessage(suite.ctx, parcel)
 	suite.Require().NoError(err)
 	suite.Equal(true, suite.lr.state[*msg.DefaultTarget()].ExecutionState.LedgerHasMoreRequests)
+	suite.Equal(message.NotPending, suite.lr.state[*msg.DefaultTarget()].ExecutionState.pending,
+		"no executor is known to work on a new object")
 	suite.lr.Stop(suite.ctx)
 
 	// LedgerHasMoreRequests false
@@ -800,6 +804,43 @@ func (suite *LogicRunnerTestSuite) TestStartStop() {
 	suite.Require().NoError(err)
 }
 
+func (suite *LogicRunnerTestSuite) TestCallMethodObjectOverloaded() {
+	objectRef := testutils.RandomRef()
+	protoRef := testutils.RandomRef()
+
+	suite.jc.MeMock.Return(testutils.RandomRef())
+	pulse := insolar.Pulse{PulseNumber: 100}
+	suite.ps.LatestFunc = func(p context.Context) (r insolar.Pulse, r1 error) {
+		return pulse, nil
+	}
+	suite.jc.IsAuthorizedFunc = func(
+		ctx context.Context, role insolar.DynamicRole, id insolar.ID, pn insolar.PulseNumber, obj insolar.Reference,
+	) (bool, error) {
+		return true, nil
+	}
+
+	overloaded := &insolar.ObjectOverloadedError{Object: objectRef, RetryAfter: time.Second}
+	suite.am.RegisterRequestMock.Return(nil, errors.Wrap(overloaded, "can't register request"))
+
+	msg := &message.CallMethod{
+		Request: record.Request{
+			Prototype: &protoRef,
+			Object:    &objectRef,
+			Method:    "some",
+		},
+	}
+	parcel := testutils.NewParcelMock(suite.T())
+	parcel.DefaultTargetMock.Return(&objectRef)
+	parcel.MessageMock.Return(msg)
+	parcel.TypeMock.Return(msg.Type())
+	parcel.PulseMock.Return(pulse.PulseNumber)
+	parcel.GetSenderMock.Return(testutils.RandomRef())
+
+	rep, err := suite.lr.FlowDispatcher.WrapBusHandle(suite.ctx, parcel)
+	suite.Require().NoError(err)
+	suite.Equal(&reply.ObjectOverloaded{Object: objectRef, RetryAfter: time.Second}, rep)
+}
+
 func (suite *LogicRunnerTestSuite) TestConcurrency() {
 	objectRef := testutils.RandomRef()
 	parentRef := testutils.RandomRef()
@@ -1185,6 +1226,92 @@ func (suite *LogicRunnerTestSuite) TestGracefulStop() {
 }
 */
 
+func (suite *LogicRunnerTestSuite) TestRouteCallPassesParentRequest() {
+	objectRef := testutils.RandomRef()
+	requestRef := testutils.RandomRef()
+
+	es := &ExecutionState{Ref: objectRef}
+	es.Current = &CurrentExecution{
+		Context:      suite.ctx,
+		LogicContext: &insolar.LogicCallContext{Mode: "execution"},
+	}
+	os := suite.lr.UpsertObjectState(objectRef)
+	os.ExecutionState = es
+
+	cr := testutils.NewContractRequesterMock(suite.mc)
+	cr.CallMethodFunc = func(ctx context.Context, msg insolar.Message) (insolar.Reply, error) {
+		cm, ok := msg.(*message.CallMethod)
+		suite.Require().True(ok)
+		suite.Equal(requestRef, cm.ParentRequest)
+		return &reply.CallMethod{Result: []byte{1}}, nil
+	}
+	suite.lr.ContractRequester = cr
+
+	rpc := &RPC{lr: suite.lr}
+	err := rpc.RouteCall(rpctypes.UpRouteReq{
+		UpBaseReq: rpctypes.UpBaseReq{Mode: "execution", Callee: objectRef, Request: requestRef},
+		Wait:      true, Object: testutils.RandomRef(), Method: "Get",
+	}, &rpctypes.UpRouteResp{})
+	suite.Require().NoError(err)
+}
+
+func (suite *LogicRunnerTestSuite) TestSimulationRecordsOutgoingCalls() {
+	objectRef := testutils.RandomRef()
+	protoRef := testutils.RandomRef()
+	calleeRef := testutils.RandomRef()
+
+	es := &ExecutionState{Ref: objectRef}
+	es.Current = &CurrentExecution{
+		Context:      suite.ctx,
+		LogicContext: &insolar.LogicCallContext{Mode: "simulation"},
+	}
+	os := suite.lr.UpsertObjectState(objectRef)
+	os.Simulation = es
+
+	nested := reply.SimulatedCall{CallType: record.CTMethod, Object: testutils.RandomRef(), Method: "Nested"}
+	cr := testutils.NewContractRequesterMock(suite.mc)
+	cr.SimulateFunc = func(ctx context.Context, msg insolar.Message) (insolar.Reply, error) {
+		sc, ok := msg.(*message.SimulateCall)
+		suite.Require().True(ok)
+		suite.Equal(calleeRef, *sc.Object)
+		suite.Equal("Get", sc.Method)
+		return &reply.SimulateCall{Result: []byte{1}, Calls: []reply.SimulatedCall{nested}}, nil
+	}
+	suite.lr.ContractRequester = cr
+
+	rpc := &RPC{lr: suite.lr}
+	base := rpctypes.UpBaseReq{Mode: "simulation", Callee: objectRef, CalleePrototype: protoRef}
+
+	routeRep := rpctypes.UpRouteResp{}
+	err := rpc.RouteCall(rpctypes.UpRouteReq{
+		UpBaseReq: base, Wait: true, Object: calleeRef, Prototype: protoRef, Method: "Get",
+	}, &routeRep)
+	suite.Require().NoError(err)
+	suite.Equal([]byte{1}, []byte(routeRep.Result))
+
+	err = rpc.RouteCall(rpctypes.UpRouteReq{
+		UpBaseReq: base, Wait: false, Object: calleeRef, Prototype: protoRef, Method: "Notify",
+	}, &rpctypes.UpRouteResp{})
+	suite.Require().NoError(err)
+
+	childRep := rpctypes.UpSaveAsChildResp{}
+	err = rpc.SaveAsChild(rpctypes.UpSaveAsChildReq{
+		UpBaseReq: base, Parent: objectRef, Prototype: protoRef, ConstructorName: "New",
+	}, &childRep)
+	suite.Require().NoError(err)
+	suite.Equal(insolar.Reference{}, *childRep.Reference)
+
+	suite.Require().Len(es.simulatedCalls, 3)
+	suite.Equal("Get", es.simulatedCalls[0].Method)
+	suite.True(es.simulatedCalls[0].Wait)
+	suite.Equal([]reply.SimulatedCall{nested}, es.simulatedCalls[0].Calls)
+	suite.Equal("Notify", es.simulatedCalls[1].Method)
+	suite.False(es.simulatedCalls[1].Wait)
+	suite.Nil(es.simulatedCalls[1].Result)
+	suite.Equal(record.CTSaveAsChild, es.simulatedCalls[2].CallType)
+	suite.Equal(objectRef, es.simulatedCalls[2].Object)
+}
+
 func TestLogicRunner(t *testing.T) {
 	t.Parallel()
 	suite.Run(t, new(LogicRunnerTestSuite))
@@ -1562,3 +1689,35 @@ func (s LRUnsafeGetLedgerPendingRequestTestSuite) TestUnsafeGetLedgerPendingRequ
 	s.Require().Equal(true, es.LedgerHasMoreRequests)
 	s.Require().Equal(parcel, es.LedgerQueueElement.parcel)
 }
+
+func TestCallTraces(t *testing.T) {
+	ct := newCallTraces(2)
+	ct.add(insolar.CallRecord{TraceID: "a", Method: "first"})
+	ct.add(insolar.CallRecord{TraceID: "b"})
+	ct.add(insolar.CallRecord{TraceID: "a", Method: "second"})
+	ct.add(insolar.CallRecord{})
+
+	calls := ct.get("a")
+	require.Len(t, calls, 2)
+	assert.Equal(t, "first", calls[0].Method)
+	assert.Equal(t, "second", calls[1].Method)
+
+	ct.add(insolar.CallRecord{TraceID: "c"})
+	assert.Empty(t, ct.get("a"))
+	assert.Len(t, ct.get("b"), 1)
+	assert.Len(t, ct.get("c"), 1)
+
+	disabled := newCallTraces(0)
+	disabled.add(insolar.CallRecord{TraceID: "a"})
+	assert.Empty(t, disabled.get("a"))
+}
+
+func TestContractError(t *testing.T) {
+	result, err := insolar.Serialize([]interface{}{nil, &foundation.Error{S: "insufficient balance"}})
+	require.NoError(t, err)
+	assert.Equal(t, "insufficient balance", contractError(result))
+
+	result, err = insolar.Serialize([]interface{}{"ok", nil})
+	require.NoError(t, err)
+	assert.Equal(t, "", contractError(result))
+}
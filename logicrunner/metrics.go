@@ -0,0 +1,44 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var (
+	statPendingRequestAge = stats.Int64(
+		"logicrunner/pending/request/age",
+		"age of request fetched from ledger for execution, in pulse numbers",
+		stats.UnitDimensionless,
+	)
+)
+
+func init() {
+	err := view.Register(
+		&view.View{
+			Name:        statPendingRequestAge.Name(),
+			Description: statPendingRequestAge.Description(),
+			Measure:     statPendingRequestAge,
+			Aggregation: view.Distribution(0, 10, 20, 30, 60, 120, 300, 600, 1800, 3600),
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
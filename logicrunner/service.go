@@ -112,12 +112,12 @@ func (gpr *RPC) RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) (e
 	defer recoverRPC(&err)
 
 	os := gpr.lr.MustObjectState(req.Callee)
+	es := os.MustModeState(req.Mode)
 
-	if os.ExecutionState.Current.LogicContext.Immutable {
+	if es.Current.LogicContext.Immutable {
 		return errors.New("Try to call route from immutable method")
 	}
 
-	es := os.MustModeState(req.Mode)
 	ctx := es.Current.Context
 
 	// TODO: delegation token
@@ -137,12 +137,17 @@ func (gpr *RPC) RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) (e
 			Method:    req.Method,
 			Arguments: req.Arguments,
 		},
+		ParentRequest: req.Request,
 	}
 
 	if !req.Wait {
 		msg.ReturnMode = record.ReturnNoWait
 	}
 
+	if req.Mode == "simulation" {
+		return gpr.simulateRouteCall(ctx, es, msg, req.Wait, rep)
+	}
+
 	res, err := gpr.lr.ContractRequester.CallMethod(ctx, msg)
 	if err != nil {
 		return err
@@ -177,6 +182,12 @@ func (gpr *RPC) SaveAsChild(req rpctypes.UpSaveAsChildReq, rep *rpctypes.UpSaveA
 			Method:    req.ConstructorName,
 			Arguments: req.ArgsSerialized,
 		},
+		ParentRequest: req.Request,
+	}
+
+	if req.Mode == "simulation" {
+		rep.Reference = simulateConstructorCall(es, msg)
+		return nil
 	}
 
 	ref, err := gpr.lr.ContractRequester.CallConstructor(ctx, msg)
@@ -208,6 +219,12 @@ func (gpr *RPC) SaveAsDelegate(req rpctypes.UpSaveAsDelegateReq, rep *rpctypes.U
 			Method:    req.ConstructorName,
 			Arguments: req.ArgsSerialized,
 		},
+		ParentRequest: req.Request,
+	}
+
+	if req.Mode == "simulation" {
+		rep.Reference = simulateConstructorCall(es, msg)
+		return nil
 	}
 
 	ref, err := gpr.lr.ContractRequester.CallConstructor(ctx, msg)
@@ -216,6 +233,55 @@ func (gpr *RPC) SaveAsDelegate(req rpctypes.UpSaveAsDelegateReq, rep *rpctypes.U
 	return err
 }
 
+// simulateRouteCall records outgoing call of simulated contract, calls waiting for result
+// are simulated on executor of callee
+func (gpr *RPC) simulateRouteCall(
+	ctx context.Context, es *ExecutionState, msg *message.CallMethod, wait bool, rep *rpctypes.UpRouteResp,
+) error {
+	call := reply.SimulatedCall{
+		CallType:  msg.CallType,
+		Object:    *msg.Object,
+		Prototype: *msg.Prototype,
+		Method:    msg.Method,
+		Arguments: msg.Arguments,
+		Wait:      wait,
+	}
+
+	if wait {
+		res, err := gpr.lr.ContractRequester.Simulate(ctx, &message.SimulateCall{Request: msg.Request})
+		if err != nil {
+			return err
+		}
+		sim, ok := res.(*reply.SimulateCall)
+		if !ok {
+			return errors.Errorf("unexpected reply type %T", res)
+		}
+		call.Result = sim.Result
+		call.Calls = sim.Calls
+		rep.Result = sim.Result
+	}
+
+	es.Lock()
+	es.simulatedCalls = append(es.simulatedCalls, call)
+	es.Unlock()
+	return nil
+}
+
+// simulateConstructorCall records constructor call of simulated contract, object isn't
+// created so empty reference is returned
+func simulateConstructorCall(es *ExecutionState, msg *message.CallMethod) *insolar.Reference {
+	es.Lock()
+	es.simulatedCalls = append(es.simulatedCalls, reply.SimulatedCall{
+		CallType:  msg.CallType,
+		Object:    *msg.Base,
+		Prototype: *msg.Prototype,
+		Method:    msg.Method,
+		Arguments: msg.Arguments,
+	})
+	es.Unlock()
+	return &insolar.Reference{}
+}
+
 var iteratorMap = make(map[string]artifacts.RefIterator)
 var iteratorMapLock = sync.RWMutex{}
 var iteratorBuffSize = 1000
@@ -241,7 +307,7 @@ func (gpr *RPC) GetObjChildrenIterator(
 	iteratorMapLock.RUnlock()
 
 	if !ok {
-		newIterator, err := am.GetChildren(ctx, req.Object, nil)
+		newIterator, err := am.GetChildren(ctx, req.Object, &req.Prototype, nil)
 		if err != nil {
 			return errors.Wrap(err, "[ GetObjChildrenIterator ] Can't get children")
 		}
@@ -119,7 +119,8 @@ func FollowRedirectSender(bus insolar.MessageBus) PreSender {
 				redirected := r.Redirected(msg)
 				inslogger.FromContext(ctx).Debugf("redirect reciever=%v", r.GetReceiver())
 
-				rep, err = bus.Send(ctx, redirected, &insolar.MessageSendOptions{
+				send := BuildSender(bus.Send, FailoverSender(r.GetReplicas()))
+				rep, err = send(ctx, redirected, &insolar.MessageSendOptions{
 					Token:    r.GetToken(),
 					Receiver: r.GetReceiver(),
 				})
@@ -137,6 +138,36 @@ func FollowRedirectSender(bus insolar.MessageBus) PreSender {
 	}
 }
 
+// FailoverSender is using for sending a message to replicas of the receiver one by one, if the receiver fails to reply
+func FailoverSender(replicas []insolar.Reference) PreSender {
+	return func(sender Sender) Sender {
+		return func(ctx context.Context, msg insolar.Message, options *insolar.MessageSendOptions) (insolar.Reply, error) {
+			rep, err := sender(ctx, msg, options)
+			if err == nil {
+				return rep, nil
+			}
+
+			receiver := options.Safe().Receiver
+			for _, replica := range replicas {
+				if receiver != nil && replica.Equal(*receiver) {
+					continue
+				}
+				inslogger.FromContext(ctx).Warnf("failed to send message, failover to replica=%v: %v", replica, err)
+
+				replica := replica
+				rep, err = sender(ctx, msg, &insolar.MessageSendOptions{
+					Token:    options.Safe().Token,
+					Receiver: &replica,
+				})
+				if err == nil {
+					return rep, nil
+				}
+			}
+			return nil, err
+		}
+	}
+}
+
 // RetryJetSender is using for refreshing jet-tree, if destination has no idea about a jet from message
 func RetryJetSender(jetModifier jet.Modifier) PreSender {
 	return func(sender Sender) Sender {
@@ -0,0 +1,101 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package messagebus
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestFailoverSender(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	primary, first, second := gen.Reference(), gen.Reference(), gen.Reference()
+
+	newSender := func(available map[insolar.Reference]bool, receivers *[]insolar.Reference) Sender {
+		return func(ctx context.Context, msg insolar.Message, options *insolar.MessageSendOptions) (insolar.Reply, error) {
+			*receivers = append(*receivers, *options.Receiver)
+			if !available[*options.Receiver] {
+				return nil, errors.New("node is unavailable")
+			}
+			return &reply.OK{}, nil
+		}
+	}
+
+	t.Run("receiver replies", func(t *testing.T) {
+		var receivers []insolar.Reference
+		sender := BuildSender(newSender(map[insolar.Reference]bool{primary: true}, &receivers), FailoverSender([]insolar.Reference{primary, first}))
+
+		rep, err := sender(ctx, &message.GetCode{}, &insolar.MessageSendOptions{Receiver: &primary})
+		require.NoError(t, err)
+		require.Equal(t, &reply.OK{}, rep)
+		require.Equal(t, []insolar.Reference{primary}, receivers)
+	})
+
+	t.Run("replica replies", func(t *testing.T) {
+		var receivers []insolar.Reference
+		sender := BuildSender(newSender(map[insolar.Reference]bool{second: true}, &receivers), FailoverSender([]insolar.Reference{primary, first, second}))
+
+		rep, err := sender(ctx, &message.GetCode{}, &insolar.MessageSendOptions{Receiver: &primary})
+		require.NoError(t, err)
+		require.Equal(t, &reply.OK{}, rep)
+		require.Equal(t, []insolar.Reference{primary, first, second}, receivers)
+	})
+
+	t.Run("nobody replies", func(t *testing.T) {
+		var receivers []insolar.Reference
+		sender := BuildSender(newSender(nil, &receivers), FailoverSender([]insolar.Reference{first}))
+
+		_, err := sender(ctx, &message.GetCode{}, &insolar.MessageSendOptions{Receiver: &primary})
+		require.Error(t, err)
+		require.Equal(t, []insolar.Reference{primary, first}, receivers)
+	})
+}
+
+func TestFollowRedirectSender_Failover(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	primary, replica := gen.Reference(), gen.Reference()
+
+	mb := testutils.NewMessageBusMock(t)
+	mb.SendFunc = func(ctx context.Context, msg insolar.Message, options *insolar.MessageSendOptions) (insolar.Reply, error) {
+		if options.Receiver.Equal(primary) {
+			return nil, errors.New("node is unavailable")
+		}
+		require.Equal(t, replica, *options.Receiver)
+		return &reply.Code{Code: []byte{1}}, nil
+	}
+	sender := BuildSender(
+		func(context.Context, insolar.Message, *insolar.MessageSendOptions) (insolar.Reply, error) {
+			return &reply.GetCodeRedirectReply{Receiver: &primary, Replicas: []insolar.Reference{replica}}, nil
+		},
+		FollowRedirectSender(mb),
+	)
+
+	rep, err := sender(ctx, &message.GetCode{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, &reply.Code{Code: []byte{1}}, rep)
+	require.Equal(t, uint64(2), mb.SendCounter)
+}
@@ -33,6 +33,30 @@ var InsgorundContractExecutionTime = prometheus.NewSummaryVec(prometheus.Summary
 	Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.95: 0.005, 0.99: 0.001},
 }, []string{"method"})
 
+var InsgorundPluginCacheSize = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name:      "plugin_cache_size",
+	Help:      "Number of plugins in cache",
+	Namespace: insgorundNamespace,
+})
+
+var InsgorundPluginCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+	Name:      "plugin_cache_hits_total",
+	Help:      "Total number of calls with plugin already opened",
+	Namespace: insgorundNamespace,
+})
+
+var InsgorundPluginCacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+	Name:      "plugin_cache_misses_total",
+	Help:      "Total number of calls that opened plugin",
+	Namespace: insgorundNamespace,
+})
+
+var InsgorundPluginCacheEvictions = prometheus.NewCounter(prometheus.CounterOpts{
+	Name:      "plugin_cache_evictions_total",
+	Help:      "Total number of plugins evicted from cache",
+	Namespace: insgorundNamespace,
+})
+
 func GetInsgorundRegistry() *prometheus.Registry {
 	registry := prometheus.NewRegistry()
 
@@ -40,6 +64,10 @@ func GetInsgorundRegistry() *prometheus.Registry {
 
 	registerer.MustRegister(InsgorundCallsTotal)
 	registerer.MustRegister(InsgorundContractExecutionTime)
+	registerer.MustRegister(InsgorundPluginCacheSize)
+	registerer.MustRegister(InsgorundPluginCacheHits)
+	registerer.MustRegister(InsgorundPluginCacheMisses)
+	registerer.MustRegister(InsgorundPluginCacheEvictions)
 	// default system collectors
 	registerer.MustRegister(prometheus.NewProcessCollector(
 		prometheus.ProcessCollectorOpts{Namespace: insgorundNamespace},
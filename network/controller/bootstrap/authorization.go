@@ -52,7 +52,6 @@ package bootstrap
 
 import (
 	"context"
-	"encoding/gob"
 	"fmt"
 	"time"
 
@@ -71,6 +70,7 @@ import (
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/consensus/packets"
 	"github.com/insolar/insolar/network/controller/common"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 	"github.com/insolar/insolar/platformpolicy"
 )
@@ -106,6 +106,8 @@ const (
 // AuthorizationRequest
 type AuthorizationRequest struct {
 	Certificate []byte
+	// ProtocolVersion is the newest packet protocol version supported by joiner.
+	ProtocolVersion uint32
 }
 
 // AuthorizationResponse
@@ -113,6 +115,8 @@ type AuthorizationResponse struct {
 	Code  OperationCode
 	Error string
 	Data  *AuthorizationData
+	// ProtocolVersion is the packet protocol version agreed by discovery node.
+	ProtocolVersion uint32
 }
 
 type AuthorizationData struct {
@@ -134,13 +138,6 @@ type RegistrationResponse struct {
 	Error   string
 }
 
-func init() {
-	gob.Register(&AuthorizationRequest{})
-	gob.Register(&AuthorizationResponse{})
-	gob.Register(&RegistrationRequest{})
-	gob.Register(&RegistrationResponse{})
-}
-
 // Authorize node on the discovery node (step 2 of the bootstrap process)
 func (ac *authorizationController) Authorize(ctx context.Context, discoveryNode *DiscoveryNode, cert insolar.AuthorizationCertificate) (*AuthorizationData, error) {
 	inslogger.FromContext(ctx).Infof("Authorizing on host: %s", discoveryNode.Host)
@@ -157,7 +154,8 @@ func (ac *authorizationController) Authorize(ctx context.Context, discoveryNode
 	}
 
 	request := ac.Network.NewRequestBuilder().Type(types.Authorize).Data(&AuthorizationRequest{
-		Certificate: serializedCert,
+		Certificate:     serializedCert,
+		ProtocolVersion: packet.ProtocolVersion,
 	}).Build()
 	future, err := ac.Network.SendRequestToHost(ctx, request, discoveryNode.Host)
 	if err != nil {
@@ -171,6 +169,11 @@ func (ac *authorizationController) Authorize(ctx context.Context, discoveryNode
 	if data.Code == OpRejected {
 		return nil, errors.New("Authorize rejected: " + data.Error)
 	}
+	if !packet.SupportsProtocol(data.ProtocolVersion) {
+		return nil, errors.Errorf("Discovery node agreed on unsupported protocol version %d", data.ProtocolVersion)
+	}
+	ac.Network.SetProtocol(discoveryNode.Host.NodeID, data.ProtocolVersion)
+	inslogger.FromContext(ctx).Infof("Agreed on protocol version %d with host: %s", data.ProtocolVersion, discoveryNode.Host)
 	return data.Data, nil
 }
 
@@ -293,27 +296,41 @@ func (ac *authorizationController) processRegisterRequest(ctx context.Context, r
 
 func (ac *authorizationController) processAuthorizeRequest(ctx context.Context, request network.Request) (network.Response, error) {
 	data := request.GetData().(*AuthorizationRequest)
+	version, err := packet.NegotiateProtocol(data.ProtocolVersion)
+	if err != nil {
+		return ac.rejectAuthorization(ctx, request, err), nil
+	}
 	cert, err := certificate.Deserialize(data.Certificate, platformpolicy.NewKeyProcessor())
 	if err != nil {
-		return ac.Network.BuildResponse(ctx, request, &AuthorizationResponse{Code: OpRejected, Error: err.Error()}), nil
+		return ac.rejectAuthorization(ctx, request, err), nil
 	}
 	valid, err := ac.Gatewayer.Gateway().Auther().ValidateCert(ctx, cert)
 	if !valid {
 		if err == nil {
 			err = errors.New("Certificate validation failed")
 		}
-		return ac.Network.BuildResponse(ctx, request, &AuthorizationResponse{Code: OpRejected, Error: err.Error()}), nil
+		return ac.rejectAuthorization(ctx, request, err), nil
 	}
 	session := ac.SessionManager.NewSession(request.GetSender(), cert, ac.options.HandshakeSessionTTL)
+	ac.Network.SetProtocol(request.GetSender(), version)
 	return ac.Network.BuildResponse(ctx, request, &AuthorizationResponse{
 		Code: OpConfirmed,
 		Data: &AuthorizationData{
 			SessionID:     session,
 			AssignShortID: GenerateShortID(ac.NodeKeeper, *cert.GetNodeRef()),
 		},
+		ProtocolVersion: version,
 	}), nil
 }
 
+func (ac *authorizationController) rejectAuthorization(ctx context.Context, request network.Request, err error) network.Response {
+	return ac.Network.BuildResponse(ctx, request, &AuthorizationResponse{
+		Code:            OpRejected,
+		Error:           err.Error(),
+		ProtocolVersion: packet.ProtocolVersion,
+	})
+}
+
 func (ac *authorizationController) Init(ctx context.Context) error {
 	ac.Network.RegisterRequestHandler(types.Register, ac.processRegisterRequest)
 	ac.Network.RegisterRequestHandler(types.Authorize, ac.processAuthorizeRequest)
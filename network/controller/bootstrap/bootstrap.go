@@ -52,7 +52,6 @@ package bootstrap
 
 import (
 	"context"
-	"encoding/gob"
 	"fmt"
 	"math"
 	"strings"
@@ -146,7 +145,7 @@ func (bc *bootstrapper) setRequest(ref insolar.Reference, req *GenesisRequest) {
 }
 
 type NodeBootstrapRequest struct {
-	// TODO: change to mandate cuz cert not registered for gob
+	// TODO: change to mandate
 	// Certificate   insolar.Certificate
 	JoinClaim     packets.NodeJoinClaim
 	LastNodePulse insolar.PulseNumber
@@ -230,15 +229,6 @@ const (
 	ReconnectRequired
 )
 
-func init() {
-	gob.Register(&NodeBootstrapRequest{})
-	gob.Register(&NodeBootstrapResponse{})
-	gob.Register(&StartSessionRequest{})
-	gob.Register(&StartSessionResponse{})
-	gob.Register(&GenesisRequest{})
-	gob.Register(&GenesisResponse{})
-}
-
 // Bootstrap on the discovery node (step 1 of the bootstrap process)
 func (bc *bootstrapper) Bootstrap(ctx context.Context) (*network.BootstrapResult, *DiscoveryNode, error) {
 	log.Info("Bootstrapping to discovery node")
@@ -0,0 +1,314 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package bootstrap
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network/consensus/packets"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
+	"github.com/insolar/insolar/network/hostnetwork/packet/wire"
+)
+
+func init() {
+	packet.RegisterPayload(packet.PayloadNodeBootstrapRequest, &NodeBootstrapRequest{})
+	packet.RegisterPayload(packet.PayloadNodeBootstrapResponse, &NodeBootstrapResponse{})
+	packet.RegisterPayload(packet.PayloadStartSessionRequest, &StartSessionRequest{})
+	packet.RegisterPayload(packet.PayloadStartSessionResponse, &StartSessionResponse{})
+	packet.RegisterPayload(packet.PayloadGenesisRequest, &GenesisRequest{})
+	packet.RegisterPayload(packet.PayloadGenesisResponse, &GenesisResponse{})
+	packet.RegisterPayload(packet.PayloadAuthorizationRequest, &AuthorizationRequest{})
+	packet.RegisterPayload(packet.PayloadAuthorizationResponse, &AuthorizationResponse{})
+	packet.RegisterPayload(packet.PayloadRegistrationRequest, &RegistrationRequest{})
+	packet.RegisterPayload(packet.PayloadRegistrationResponse, &RegistrationResponse{})
+}
+
+func marshalClaim(claim *packets.NodeJoinClaim) ([]byte, error) {
+	if claim == nil {
+		return nil, nil
+	}
+	data, err := claim.Serialize()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize join claim")
+	}
+	return data, nil
+}
+
+func unmarshalClaim(data []byte) (*packets.NodeJoinClaim, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	claim := &packets.NodeJoinClaim{}
+	if err := claim.Deserialize(bytes.NewReader(data)); err != nil {
+		return nil, errors.Wrap(err, "failed to deserialize join claim")
+	}
+	return claim, nil
+}
+
+func (r *NodeBootstrapRequest) Marshal() ([]byte, error) {
+	claim, err := marshalClaim(&r.JoinClaim)
+	if err != nil {
+		return nil, err
+	}
+	pb := wire.NodeBootstrapRequest{JoinClaim: claim, LastNodePulse: uint32(r.LastNodePulse)}
+	return pb.Marshal()
+}
+
+func (r *NodeBootstrapRequest) Unmarshal(data []byte) error {
+	pb := wire.NodeBootstrapRequest{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	claim, err := unmarshalClaim(pb.JoinClaim)
+	if err != nil {
+		return err
+	}
+	*r = NodeBootstrapRequest{LastNodePulse: insolar.PulseNumber(pb.LastNodePulse)}
+	if claim != nil {
+		r.JoinClaim = *claim
+	}
+	return nil
+}
+
+func (r *NodeBootstrapResponse) Marshal() ([]byte, error) {
+	pb := wire.NodeBootstrapResponse{
+		Code:             uint32(r.Code),
+		RejectReason:     r.RejectReason,
+		ETA:              int64(r.ETA),
+		AssignShortID:    uint32(r.AssignShortID),
+		UpdateSincePulse: uint32(r.UpdateSincePulse),
+		RedirectHost:     r.RedirectHost,
+		NetworkSize:      int64(r.NetworkSize),
+	}
+	return pb.Marshal()
+}
+
+func (r *NodeBootstrapResponse) Unmarshal(data []byte) error {
+	pb := wire.NodeBootstrapResponse{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	*r = NodeBootstrapResponse{
+		Code:             Code(pb.Code),
+		RejectReason:     pb.RejectReason,
+		ETA:              int(pb.ETA),
+		AssignShortID:    insolar.ShortNodeID(pb.AssignShortID),
+		UpdateSincePulse: insolar.PulseNumber(pb.UpdateSincePulse),
+		RedirectHost:     pb.RedirectHost,
+		NetworkSize:      int(pb.NetworkSize),
+	}
+	return nil
+}
+
+func (r *GenesisRequest) toWire() *wire.GenesisRequest {
+	pb := &wire.GenesisRequest{LastPulse: uint32(r.LastPulse)}
+	if r.Discovery != nil {
+		pb.Discovery = &wire.Node{
+			ID:      r.Discovery.ID,
+			SID:     uint32(r.Discovery.SID),
+			Role:    uint32(r.Discovery.Role),
+			PK:      r.Discovery.PK,
+			Address: r.Discovery.Address,
+			Version: r.Discovery.Version,
+		}
+	}
+	return pb
+}
+
+func (r *GenesisRequest) fromWire(pb *wire.GenesisRequest) {
+	*r = GenesisRequest{}
+	if pb == nil {
+		return
+	}
+	r.LastPulse = insolar.PulseNumber(pb.LastPulse)
+	if pb.Discovery != nil {
+		r.Discovery = &NodeStruct{
+			ID:      pb.Discovery.ID,
+			SID:     insolar.ShortNodeID(pb.Discovery.SID),
+			Role:    insolar.StaticRole(pb.Discovery.Role),
+			PK:      pb.Discovery.PK,
+			Address: pb.Discovery.Address,
+			Version: pb.Discovery.Version,
+		}
+	}
+}
+
+func (r *GenesisRequest) Marshal() ([]byte, error) {
+	return r.toWire().Marshal()
+}
+
+func (r *GenesisRequest) Unmarshal(data []byte) error {
+	pb := wire.GenesisRequest{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	r.fromWire(&pb)
+	return nil
+}
+
+func (r *GenesisResponse) Marshal() ([]byte, error) {
+	pb := wire.GenesisResponse{Response: r.Response.toWire(), Error: r.Error}
+	return pb.Marshal()
+}
+
+func (r *GenesisResponse) Unmarshal(data []byte) error {
+	pb := wire.GenesisResponse{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	*r = GenesisResponse{Error: pb.Error}
+	r.Response.fromWire(pb.Response)
+	return nil
+}
+
+func (r *StartSessionRequest) Marshal() ([]byte, error) {
+	pb := wire.StartSessionRequest{}
+	return pb.Marshal()
+}
+
+func (r *StartSessionRequest) Unmarshal(data []byte) error {
+	pb := wire.StartSessionRequest{}
+	return pb.Unmarshal(data)
+}
+
+func (r *StartSessionResponse) Marshal() ([]byte, error) {
+	pb := wire.StartSessionResponse{SessionID: uint64(r.SessionID)}
+	return pb.Marshal()
+}
+
+func (r *StartSessionResponse) Unmarshal(data []byte) error {
+	pb := wire.StartSessionResponse{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	*r = StartSessionResponse{SessionID: SessionID(pb.SessionID)}
+	return nil
+}
+
+func (r *AuthorizationRequest) Marshal() ([]byte, error) {
+	pb := wire.AuthorizationRequest{Certificate: r.Certificate, Protocol: r.ProtocolVersion}
+	return pb.Marshal()
+}
+
+func (r *AuthorizationRequest) Unmarshal(data []byte) error {
+	pb := wire.AuthorizationRequest{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	*r = AuthorizationRequest{Certificate: pb.Certificate, ProtocolVersion: pb.Protocol}
+	return nil
+}
+
+func (r *AuthorizationResponse) Marshal() ([]byte, error) {
+	pb := wire.AuthorizationResponse{Code: uint32(r.Code), Error: r.Error, Protocol: r.ProtocolVersion}
+	if r.Data != nil {
+		pb.Data = &wire.AuthorizationData{
+			SessionID:     uint64(r.Data.SessionID),
+			AssignShortID: uint32(r.Data.AssignShortID),
+		}
+	}
+	return pb.Marshal()
+}
+
+func (r *AuthorizationResponse) Unmarshal(data []byte) error {
+	pb := wire.AuthorizationResponse{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	*r = AuthorizationResponse{Code: OperationCode(pb.Code), Error: pb.Error, ProtocolVersion: pb.Protocol}
+	if pb.Data != nil {
+		r.Data = &AuthorizationData{
+			SessionID:     SessionID(pb.Data.SessionID),
+			AssignShortID: insolar.ShortNodeID(pb.Data.AssignShortID),
+		}
+	}
+	return nil
+}
+
+func (r *RegistrationRequest) Marshal() ([]byte, error) {
+	claim, err := marshalClaim(r.JoinClaim)
+	if err != nil {
+		return nil, err
+	}
+	pb := wire.RegistrationRequest{SessionID: uint64(r.SessionID), Version: r.Version, JoinClaim: claim}
+	return pb.Marshal()
+}
+
+func (r *RegistrationRequest) Unmarshal(data []byte) error {
+	pb := wire.RegistrationRequest{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	claim, err := unmarshalClaim(pb.JoinClaim)
+	if err != nil {
+		return err
+	}
+	*r = RegistrationRequest{SessionID: SessionID(pb.SessionID), Version: pb.Version, JoinClaim: claim}
+	return nil
+}
+
+func (r *RegistrationResponse) Marshal() ([]byte, error) {
+	pb := wire.RegistrationResponse{Code: uint32(r.Code), RetryIn: int64(r.RetryIn), Error: r.Error}
+	return pb.Marshal()
+}
+
+func (r *RegistrationResponse) Unmarshal(data []byte) error {
+	pb := wire.RegistrationResponse{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	*r = RegistrationResponse{Code: OperationCode(pb.Code), RetryIn: time.Duration(pb.RetryIn), Error: pb.Error}
+	return nil
+}
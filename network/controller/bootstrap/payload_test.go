@@ -0,0 +1,99 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package bootstrap
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network/consensus/packets"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestPayload_MarshalUnmarshal(t *testing.T) {
+	claim := &packets.NodeJoinClaim{
+		ShortNodeID:   insolar.ShortNodeID(10),
+		NodeRoleRecID: insolar.StaticRoleVirtual,
+		NodeRef:       testutils.RandomRef(),
+	}
+	claim.NodePK[0] = 1
+	claim.Signature[0] = 2
+
+	cases := []packet.Payload{
+		&NodeBootstrapRequest{JoinClaim: *claim, LastNodePulse: insolar.FirstPulseNumber},
+		&NodeBootstrapResponse{Code: Redirected, RejectReason: "reason", ETA: 5, AssignShortID: 3,
+			UpdateSincePulse: insolar.FirstPulseNumber, RedirectHost: "127.0.0.1:1234", NetworkSize: 7},
+		&StartSessionRequest{},
+		&StartSessionResponse{SessionID: 42},
+		&GenesisRequest{LastPulse: insolar.FirstPulseNumber, Discovery: &NodeStruct{
+			ID: testutils.RandomRef(), SID: 5, Role: insolar.StaticRoleHeavyMaterial, PK: []byte{1, 2, 3},
+			Address: "127.0.0.1:1234", Version: "v1"}},
+		&GenesisResponse{Response: GenesisRequest{LastPulse: insolar.FirstPulseNumber}, Error: "error"},
+		&AuthorizationRequest{Certificate: []byte{1, 2, 3}, ProtocolVersion: packet.ProtocolVersion},
+		&AuthorizationResponse{Code: OpConfirmed, Data: &AuthorizationData{SessionID: 42, AssignShortID: 3},
+			ProtocolVersion: packet.ProtocolVersion},
+		&RegistrationRequest{SessionID: 42, Version: "v1", JoinClaim: claim},
+		&RegistrationResponse{Code: OpRetry, RetryIn: time.Second, Error: "error"},
+	}
+	for _, expected := range cases {
+		data, err := expected.Marshal()
+		require.NoError(t, err)
+
+		actual := reflect.New(reflect.TypeOf(expected).Elem()).Interface().(packet.Payload)
+		require.NoError(t, actual.Unmarshal(data))
+		require.Equal(t, expected, actual)
+	}
+}
@@ -52,7 +52,6 @@ package controller
 
 import (
 	"context"
-	"encoding/gob"
 	"fmt"
 	"strings"
 	"time"
@@ -70,6 +69,7 @@ import (
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/cascade"
 	"github.com/insolar/insolar/network/controller/common"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 )
 
@@ -117,10 +117,10 @@ type ResponseCascade struct {
 }
 
 func init() {
-	gob.Register(&RequestRPC{})
-	gob.Register(&ResponseRPC{})
-	gob.Register(&RequestCascade{})
-	gob.Register(&ResponseCascade{})
+	packet.RegisterPayload(packet.PayloadRequestRPC, &RequestRPC{})
+	packet.RegisterPayload(packet.PayloadResponseRPC, &ResponseRPC{})
+	packet.RegisterPayload(packet.PayloadRequestCascade, &RequestCascade{})
+	packet.RegisterPayload(packet.PayloadResponseCascade, &ResponseCascade{})
 }
 
 func (rpc *rpcController) IAmRPCController() {
@@ -0,0 +1,136 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package controller
+
+import (
+	"github.com/insolar/insolar/network/hostnetwork/packet/wire"
+)
+
+func (r *RequestRPC) toWire() *wire.RequestRPC {
+	return &wire.RequestRPC{Method: r.Method, Data: r.Data}
+}
+
+func (r *RequestRPC) fromWire(pb *wire.RequestRPC) {
+	*r = RequestRPC{}
+	if pb != nil {
+		r.Method = pb.Method
+		r.Data = pb.Data
+	}
+}
+
+func (r *RequestRPC) Marshal() ([]byte, error) {
+	return r.toWire().Marshal()
+}
+
+func (r *RequestRPC) Unmarshal(data []byte) error {
+	pb := wire.RequestRPC{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	r.fromWire(&pb)
+	return nil
+}
+
+func (r *ResponseRPC) Marshal() ([]byte, error) {
+	pb := wire.ResponseRPC{Success: r.Success, Result: r.Result, Error: r.Error}
+	return pb.Marshal()
+}
+
+func (r *ResponseRPC) Unmarshal(data []byte) error {
+	pb := wire.ResponseRPC{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	*r = ResponseRPC{Success: pb.Success, Result: pb.Result, Error: pb.Error}
+	return nil
+}
+
+func (r *RequestCascade) Marshal() ([]byte, error) {
+	pb := wire.RequestCascade{
+		TraceID: r.TraceID,
+		RPC:     r.RPC.toWire(),
+		Cascade: &wire.Cascade{
+			NodeIds:           r.Cascade.NodeIds,
+			Entropy:           r.Cascade.Entropy[:],
+			ReplicationFactor: uint64(r.Cascade.ReplicationFactor),
+		},
+	}
+	return pb.Marshal()
+}
+
+func (r *RequestCascade) Unmarshal(data []byte) error {
+	pb := wire.RequestCascade{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	*r = RequestCascade{TraceID: pb.TraceID}
+	r.RPC.fromWire(pb.RPC)
+	if pb.Cascade != nil {
+		r.Cascade.NodeIds = pb.Cascade.NodeIds
+		copy(r.Cascade.Entropy[:], pb.Cascade.Entropy)
+		r.Cascade.ReplicationFactor = uint(pb.Cascade.ReplicationFactor)
+	}
+	return nil
+}
+
+func (r *ResponseCascade) Marshal() ([]byte, error) {
+	pb := wire.ResponseCascade{Success: r.Success, Error: r.Error}
+	return pb.Marshal()
+}
+
+func (r *ResponseCascade) Unmarshal(data []byte) error {
+	pb := wire.ResponseCascade{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	*r = ResponseCascade{Success: pb.Success, Error: pb.Error}
+	return nil
+}
@@ -61,7 +61,9 @@ import (
 	"github.com/insolar/insolar/metrics"
 	"github.com/insolar/insolar/network/hostnetwork/future"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
+	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 	"github.com/insolar/insolar/network/hostnetwork/pool"
+	"github.com/insolar/insolar/network/transport"
 )
 
 // RequestHandler is callback function for request handling
@@ -82,6 +84,7 @@ func NewStreamHandler(requestHandler RequestHandler, responseHandler future.Pack
 }
 
 func (s *StreamHandler) HandleStream(address string, reader io.ReadWriteCloser) {
+	peer, verified := transport.PeerOf(reader)
 	for {
 		p, err := packet.DeserializePacket(reader)
 
@@ -96,6 +99,13 @@ func (s *StreamHandler) HandleStream(address string, reader io.ReadWriteCloser)
 			ctx, logger := inslogger.WithTraceField(context.Background(), p.TraceID)
 			logger.Debug("[ HandleStream ] Handling packet RequestID = ", p.RequestID)
 
+			if verified {
+				if err := checkPeer(peer, p); err != nil {
+					logger.Warn("[ HandleStream ] Packet is dropped: ", err.Error())
+					continue
+				}
+			}
+
 			if p.IsResponse {
 				go s.responseHandler.Handle(ctx, p)
 			} else {
@@ -105,6 +115,28 @@ func (s *StreamHandler) HandleStream(address string, reader io.ReadWriteCloser)
 	}
 }
 
+// checkPeer checks that the packet is sent by the peer verified by TLS transport. Peers with unknown keys are joining
+// nodes, they can send bootstrap and authorization requests only. Pulsars have no reference and send pulses only.
+func checkPeer(peer transport.Peer, p *packet.Packet) error {
+	if peer.Reference == nil {
+		if p.IsResponse || (p.Type != types.Ping && p.Type != types.Pulse) {
+			return errors.Errorf("%s packet is not allowed for pulsar", p.Type)
+		}
+		return nil
+	}
+	if p.Sender == nil || !p.Sender.NodeID.Equal(*peer.Reference) {
+		return errors.Errorf("packet sender doesn't match peer %s", peer.Reference)
+	}
+	if peer.Known || p.IsResponse {
+		return nil
+	}
+	switch p.Type {
+	case types.Bootstrap, types.Authorize, types.Register:
+		return nil
+	}
+	return errors.Errorf("%s request is not allowed for unknown node %s", p.Type, peer.Reference)
+}
+
 // SendPacket sends packet using connection from pool
 func SendPacket(ctx context.Context, pool pool.ConnectionPool, p *packet.Packet) error {
 	data, err := packet.SerializePacket(p)
@@ -89,6 +89,7 @@ func NewHostNetwork(nodeRef string) (network.HostNetwork, error) {
 		nodeID:            *id,
 		futureManager:     futureManager,
 		responseHandler:   future.NewPacketHandler(futureManager),
+		protocols:         make(map[insolar.Reference]uint32),
 	}
 
 	return result, nil
@@ -109,6 +110,9 @@ type hostNetwork struct {
 
 	muOrigin sync.RWMutex
 	origin   *host.Host
+
+	muProtocols sync.RWMutex
+	protocols   map[insolar.Reference]uint32
 }
 
 func (hn *hostNetwork) Init(ctx context.Context) error {
@@ -162,7 +166,7 @@ func (hn *hostNetwork) Stop(ctx context.Context) error {
 
 func (hn *hostNetwork) buildRequest(ctx context.Context, request network.Request, receiver *host.Host) *packet.Packet {
 	return packet.NewBuilder(hn.getOrigin()).Receiver(receiver).Type(request.GetType()).RequestID(request.GetRequestID()).
-		Request(request.GetData()).TraceID(inslogger.TraceID(ctx)).Build()
+		Request(request.GetData()).TraceID(inslogger.TraceID(ctx)).Protocol(hn.getProtocol(receiver.NodeID)).Build()
 }
 
 // PublicAddress returns public address that can be published for all nodes.
@@ -239,8 +243,13 @@ func (hn *hostNetwork) RegisterPacketHandler(t types.PacketType, handler network
 func (hn *hostNetwork) BuildResponse(ctx context.Context, request network.Request, responseData interface{}) network.Response {
 
 	sender := request.GetSenderHost()
+	protocol := hn.getProtocol(sender.NodeID)
+	// response is written with the version of request, sender is able to read it for sure
+	if p, ok := request.(*packet.Packet); ok && p.Protocol != 0 {
+		protocol = p.Protocol
+	}
 	p := packet.NewBuilder(hn.getOrigin()).Type(request.GetType()).Receiver(sender).RequestID(request.GetRequestID()).
-		Response(responseData).TraceID(inslogger.TraceID(ctx)).Build()
+		Response(responseData).TraceID(inslogger.TraceID(ctx)).Protocol(protocol).Build()
 	return p
 }
 
@@ -262,6 +271,27 @@ func (hn *hostNetwork) RegisterRequestHandler(t types.PacketType, handler networ
 	hn.RegisterPacketHandler(t, f)
 }
 
+// SetProtocol stores packet protocol version agreed with a remote node.
+// Packets to the node are written with this version.
+func (hn *hostNetwork) SetProtocol(node insolar.Reference, version uint32) {
+	hn.muProtocols.Lock()
+	defer hn.muProtocols.Unlock()
+
+	hn.protocols[node] = version
+}
+
+// getProtocol returns packet protocol version agreed with a remote node.
+// Nodes that have not agreed on a version yet get packets of the oldest supported version.
+func (hn *hostNetwork) getProtocol(node insolar.Reference) uint32 {
+	hn.muProtocols.RLock()
+	defer hn.muProtocols.RUnlock()
+
+	if version, ok := hn.protocols[node]; ok {
+		return version
+	}
+	return packet.MinProtocolVersion
+}
+
 func (hn *hostNetwork) getOrigin() *host.Host {
 	hn.muOrigin.RLock()
 	defer hn.muOrigin.RUnlock()
@@ -52,7 +52,6 @@ package hostnetwork
 
 import (
 	"context"
-	"encoding/gob"
 	"sync"
 	"testing"
 	"time"
@@ -63,6 +62,7 @@ import (
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/hostnetwork/host"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 	"github.com/insolar/insolar/network/transport"
 	"github.com/insolar/insolar/network/utils"
@@ -335,15 +335,10 @@ func TestHostNetwork_SendRequestPacket3(t *testing.T) {
 	ctx := context.Background()
 	ctx2 := context.Background()
 
-	type Data struct {
-		Number int
-	}
-	gob.Register(&Data{})
-
 	handler := func(ctx context.Context, r network.Request) (network.Response, error) {
 		log.Info("handler triggered")
-		d := r.GetData().(*Data)
-		return n2.BuildResponse(ctx, r, &Data{Number: d.Number + 1}), nil
+		d := r.GetData().(*packet.ResponseTest)
+		return n2.BuildResponse(ctx, r, &packet.ResponseTest{Number: d.Number + 1}), nil
 	}
 	n2.RegisterRequestHandler(types.Ping, handler)
 
@@ -355,7 +350,7 @@ func TestHostNetwork_SendRequestPacket3(t *testing.T) {
 	}()
 
 	magicNumber := 42
-	request := n1.NewRequestBuilder().Type(types.Ping).Data(&Data{Number: magicNumber}).Build()
+	request := n1.NewRequestBuilder().Type(types.Ping).Data(&packet.ResponseTest{Number: magicNumber}).Build()
 	ref, err := insolar.NewReferenceFromBase58(ID2 + DOMAIN)
 	require.NoError(t, err)
 	f, err := n1.SendRequest(ctx, request, *ref)
@@ -365,16 +360,16 @@ func TestHostNetwork_SendRequestPacket3(t *testing.T) {
 	r, err := f.WaitResponse(time.Minute)
 	require.NoError(t, err)
 
-	d := r.GetData().(*Data)
+	d := r.GetData().(*packet.ResponseTest)
 	require.Equal(t, magicNumber+1, d.Number)
 
 	magicNumber = 666
-	request = n1.NewRequestBuilder().Type(types.Ping).Data(&Data{Number: magicNumber}).Build()
+	request = n1.NewRequestBuilder().Type(types.Ping).Data(&packet.ResponseTest{Number: magicNumber}).Build()
 	f, err = n1.SendRequest(ctx, request, *ref)
 	require.NoError(t, err)
 
 	r = <-f.Response()
-	d = r.GetData().(*Data)
+	d = r.GetData().(*packet.ResponseTest)
 	require.Equal(t, magicNumber+1, d.Number)
 }
 
@@ -507,3 +502,60 @@ func TestHostNetwork_SendRequestToHost_NotStarted(t *testing.T) {
 	_, err = hn.SendRequestToHost(context.Background(), nil, nil)
 	require.EqualError(t, err, "host network is not started")
 }
+
+func TestCheckPeer(t *testing.T) {
+	ref1, err := insolar.NewReferenceFromBase58(ID1 + DOMAIN)
+	require.NoError(t, err)
+	ref2, err := insolar.NewReferenceFromBase58(ID2 + DOMAIN)
+	require.NoError(t, err)
+	newPacket := func(sender *insolar.Reference, t types.PacketType, response bool) *packet.Packet {
+		p := &packet.Packet{Type: t, IsResponse: response}
+		if sender != nil {
+			p.Sender = &host.Host{NodeID: *sender}
+		}
+		return p
+	}
+
+	known := transport.Peer{Reference: ref1, Known: true}
+	assert.NoError(t, checkPeer(known, newPacket(ref1, types.RPC, false)))
+	assert.Error(t, checkPeer(known, newPacket(ref2, types.RPC, false)), "sender doesn't match peer")
+	assert.Error(t, checkPeer(known, newPacket(nil, types.RPC, false)), "no sender")
+
+	unknown := transport.Peer{Reference: ref1}
+	for _, pt := range []types.PacketType{types.Bootstrap, types.Authorize, types.Register} {
+		assert.NoError(t, checkPeer(unknown, newPacket(ref1, pt, false)))
+	}
+	assert.Error(t, checkPeer(unknown, newPacket(ref1, types.RPC, false)), "joining node can only bootstrap")
+	assert.Error(t, checkPeer(unknown, newPacket(ref2, types.Bootstrap, false)), "sender doesn't match peer")
+	assert.NoError(t, checkPeer(unknown, newPacket(ref1, types.Ping, true)))
+
+	pulsar := transport.Peer{Known: true}
+	assert.NoError(t, checkPeer(pulsar, newPacket(nil, types.Pulse, false)))
+	assert.NoError(t, checkPeer(pulsar, newPacket(nil, types.Ping, false)))
+	assert.Error(t, checkPeer(pulsar, newPacket(nil, types.RPC, false)))
+}
+
+func TestHostNetwork_Protocol(t *testing.T) {
+	n, err := NewHostNetwork(ID1 + DOMAIN)
+	require.NoError(t, err)
+	hn := n.(*hostNetwork)
+	hn.origin, err = host.NewHostN("127.0.0.1:0", hn.nodeID)
+	require.NoError(t, err)
+	ref, err := insolar.NewReferenceFromBase58(ID2 + DOMAIN)
+	require.NoError(t, err)
+	receiver, err := host.NewHostN("127.0.0.1:0", *ref)
+	require.NoError(t, err)
+	ctx := context.Background()
+	request := hn.NewRequestBuilder().Type(types.Ping).Data(nil).Build()
+
+	p := hn.buildRequest(ctx, request, receiver)
+	assert.Equal(t, packet.MinProtocolVersion, p.Protocol)
+
+	hn.SetProtocol(receiver.NodeID, packet.ProtocolVersion)
+	p = hn.buildRequest(ctx, request, receiver)
+	assert.Equal(t, packet.ProtocolVersion, p.Protocol)
+
+	incoming := packet.NewBuilder(receiver).Receiver(hn.origin).Type(types.Ping).Build()
+	response := hn.BuildResponse(ctx, incoming, nil).(*packet.Packet)
+	assert.Equal(t, packet.MinProtocolVersion, response.Protocol)
+}
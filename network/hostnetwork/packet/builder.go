@@ -68,6 +68,7 @@ func NewBuilder(sender *host.Host) Builder {
 	cb.actions = append(cb.actions, func(packet *Packet) {
 		packet.Sender = sender
 		packet.RemoteAddress = sender.Address.String()
+		packet.Protocol = MinProtocolVersion
 	})
 	return cb
 }
@@ -128,6 +129,14 @@ func (cb Builder) Response(response interface{}) Builder {
 	return cb
 }
 
+// Protocol sets version of the packet format.
+func (cb Builder) Protocol(version uint32) Builder {
+	cb.actions = append(cb.actions, func(packet *Packet) {
+		packet.Protocol = version
+	})
+	return cb
+}
+
 // Error adds error description to packet.
 func (cb Builder) Error(err error) Builder {
 	cb.actions = append(cb.actions, func(packet *Packet) {
@@ -83,6 +83,7 @@ func TestBuilder_Build_RequestPacket(t *testing.T) {
 		Error:         nil,
 		RequestID:     network.RequestID(123),
 		TraceID:       "trace_id",
+		Protocol:      MinProtocolVersion,
 	}
 	require.Equal(t, expectedPacket, m)
 }
@@ -109,6 +110,7 @@ func TestBuilder_Build_ResponsePacket(t *testing.T) {
 		Error:         nil,
 		RequestID:     network.RequestID(123),
 		TraceID:       "trace_id",
+		Protocol:      MinProtocolVersion,
 	}
 	require.Equal(t, expectedPacket, m)
 }
@@ -136,6 +138,7 @@ func TestBuilder_Build_ErrorPacket(t *testing.T) {
 		Error:         errors.New("test error"),
 		RequestID:     network.RequestID(123),
 		TraceID:       "trace_id",
+		Protocol:      MinProtocolVersion,
 	}
 	require.Equal(t, expectedPacket, m)
 }
@@ -110,5 +110,21 @@ And deserialized therefore:
 
 	// do something with packet
 
+
+On the wire packet is a length-prefixed protobuf envelope (see package wire) that carries protocol version.
+Packet data must implement Payload and be registered with its PayloadType:
+
+	func init() {
+		packet.RegisterPayload(packet.PayloadRequestPulse, &packet.RequestPulse{})
+	}
+
+A joining node and a discovery node agree on protocol version during authorization. Packets to the node
+are written with the agreed version, responses are written with the version of request and packets to
+nodes that have not agreed on a version yet are written with MinProtocolVersion.
+
+The envelope format is not compatible with gob encoded packets of previous releases. Nodes of these
+releases can't communicate with each other, so the upgrade to the envelope format requires
+a restart of the whole network: stop every node and pulsar, update them and start the network again.
+
 */
 package packet
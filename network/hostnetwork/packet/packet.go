@@ -53,7 +53,6 @@ package packet
 import (
 	"bytes"
 	"encoding/binary"
-	"encoding/gob"
 	"io"
 
 	"github.com/pkg/errors"
@@ -63,8 +62,35 @@ import (
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
+	"github.com/insolar/insolar/network/hostnetwork/packet/wire"
 )
 
+const (
+	// ProtocolVersion is the version of the packet format written by this node.
+	ProtocolVersion uint32 = 1
+	// MinProtocolVersion is the oldest packet format this node is still able to read and write.
+	// It is used for peers that have not agreed on a version yet.
+	// Raise it only after every node of the network is upgraded to a newer version.
+	MinProtocolVersion uint32 = 1
+)
+
+// SupportsProtocol checks if packets of the given protocol version can be read by this node.
+func SupportsProtocol(version uint32) bool {
+	return version >= MinProtocolVersion && version <= ProtocolVersion
+}
+
+// NegotiateProtocol returns the highest protocol version supported by both this node and the remote one.
+func NegotiateProtocol(remote uint32) (uint32, error) {
+	if remote < MinProtocolVersion {
+		return 0, errors.Errorf("protocol version %d is not supported, minimal supported version is %d",
+			remote, MinProtocolVersion)
+	}
+	if remote > ProtocolVersion {
+		return ProtocolVersion, nil
+	}
+	return remote, nil
+}
+
 // Packet is network packet object for requests and responses.
 type Packet struct {
 	Sender        *host.Host
@@ -77,6 +103,9 @@ type Packet struct {
 	Data       interface{}
 	Error      error
 	IsResponse bool
+
+	// Protocol is the version of the packet format. Zero means MinProtocolVersion.
+	Protocol uint32
 }
 
 func (p *Packet) GetSender() insolar.Reference {
@@ -101,21 +130,21 @@ func (p *Packet) GetRequestID() network.RequestID {
 
 // SerializePacket converts packet to byte slice.
 func SerializePacket(q *Packet) ([]byte, error) {
-	var msgBuffer bytes.Buffer
-	enc := gob.NewEncoder(&msgBuffer)
-	err := enc.Encode(q)
+	envelope, err := toEnvelope(q)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to serialize packet")
+	}
+	data, err := envelope.Marshal()
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to serialize packet")
 	}
-
-	length := msgBuffer.Len()
 
 	var lengthBytes [8]byte
-	binary.PutUvarint(lengthBytes[:], uint64(length))
+	binary.PutUvarint(lengthBytes[:], uint64(len(data)))
 
 	var result []byte
 	result = append(result, lengthBytes[:]...)
-	result = append(result, msgBuffer.Bytes()...)
+	result = append(result, data...)
 
 	return result, nil
 }
@@ -141,10 +170,13 @@ func DeserializePacket(conn io.Reader) (*Packet, error) {
 	}
 	log.Debugf("[ DeserializePacket ] read packet")
 
-	msg := &Packet{}
-	dec := gob.NewDecoder(bytes.NewReader(buf))
-
-	err = dec.Decode(msg)
+	envelope := &wire.Envelope{}
+	err = envelope.Unmarshal(buf)
+	if err != nil {
+		log.Error("[ DeserializePacket ] couldn't decode packet: ", err)
+		return nil, err
+	}
+	msg, err := fromEnvelope(envelope)
 	if err != nil {
 		log.Error("[ DeserializePacket ] couldn't decode packet: ", err)
 		return nil, err
@@ -155,7 +187,96 @@ func DeserializePacket(conn io.Reader) (*Packet, error) {
 	return msg, nil
 }
 
+func toEnvelope(q *Packet) (*wire.Envelope, error) {
+	protocol := q.Protocol
+	if protocol == 0 {
+		protocol = MinProtocolVersion
+	}
+	if !SupportsProtocol(protocol) {
+		return nil, errors.Errorf("unsupported protocol version %d", protocol)
+	}
+	payloadType, payload, err := marshalPayload(q.Data)
+	if err != nil {
+		return nil, err
+	}
+	envelope := &wire.Envelope{
+		Protocol:      protocol,
+		Sender:        hostToWire(q.Sender),
+		Receiver:      hostToWire(q.Receiver),
+		Type:          uint32(q.Type),
+		RequestID:     uint64(q.RequestID),
+		RemoteAddress: q.RemoteAddress,
+		TraceID:       q.TraceID,
+		IsResponse:    q.IsResponse,
+		PayloadType:   uint32(payloadType),
+		Payload:       payload,
+	}
+	if q.Error != nil {
+		envelope.Error = q.Error.Error()
+	}
+	return envelope, nil
+}
+
+func fromEnvelope(envelope *wire.Envelope) (*Packet, error) {
+	if !SupportsProtocol(envelope.Protocol) {
+		return nil, errors.Errorf("unsupported protocol version %d", envelope.Protocol)
+	}
+	sender, err := hostFromWire(envelope.Sender)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode sender")
+	}
+	receiver, err := hostFromWire(envelope.Receiver)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode receiver")
+	}
+	data, err := unmarshalPayload(PayloadType(envelope.PayloadType), envelope.Payload)
+	if err != nil {
+		return nil, err
+	}
+	msg := &Packet{
+		Sender:        sender,
+		Receiver:      receiver,
+		Type:          types.PacketType(envelope.Type),
+		RequestID:     network.RequestID(envelope.RequestID),
+		RemoteAddress: envelope.RemoteAddress,
+		TraceID:       envelope.TraceID,
+		Data:          data,
+		IsResponse:    envelope.IsResponse,
+		Protocol:      envelope.Protocol,
+	}
+	if envelope.Error != "" {
+		msg.Error = errors.New(envelope.Error)
+	}
+	return msg, nil
+}
+
+func hostToWire(h *host.Host) *wire.Host {
+	if h == nil {
+		return nil
+	}
+	result := &wire.Host{NodeID: h.NodeID, ShortID: uint32(h.ShortID)}
+	if h.Address != nil {
+		result.Address = h.Address.String()
+	}
+	return result
+}
+
+func hostFromWire(h *wire.Host) (*host.Host, error) {
+	if h == nil {
+		return nil, nil
+	}
+	result := &host.Host{NodeID: h.NodeID, ShortID: insolar.ShortNodeID(h.ShortID)}
+	if h.Address != "" {
+		address, err := host.NewAddress(h.Address)
+		if err != nil {
+			return nil, err
+		}
+		result.Address = address
+	}
+	return result, nil
+}
+
 func init() {
-	gob.Register(&RequestPulse{})
-	gob.Register(&ResponsePulse{})
+	RegisterPayload(PayloadRequestPulse, &RequestPulse{})
+	RegisterPayload(PayloadResponsePulse, &ResponsePulse{})
 }
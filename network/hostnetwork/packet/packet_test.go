@@ -53,20 +53,18 @@ package packet
 import (
 	"bytes"
 	"crypto/rand"
-	"encoding/gob"
+	"encoding/binary"
 	"testing"
 
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/hostnetwork/host"
+	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 	"github.com/insolar/insolar/testutils"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
-func init() {
-	gob.Register(&RequestTest{})
-}
-
 func TestSerializePacket(t *testing.T) {
 	sender, _ := host.NewHostN("127.0.0.1:31337", testutils.RandomRef())
 	receiver, _ := host.NewHostN("127.0.0.2:31338", testutils.RandomRef())
@@ -160,3 +158,87 @@ func TestPacketMethods(t *testing.T) {
 		packet: p,
 	})
 }
+
+func TestSerializePacket_UnregisteredPayload(t *testing.T) {
+	sender, _ := host.NewHostN("127.0.0.1:31337", testutils.RandomRef())
+	msg := NewBuilder(sender).Receiver(sender).Type(TestPacket).Request(&struct{ Data []byte }{}).Build()
+
+	_, err := SerializePacket(msg)
+
+	require.Error(t, err)
+}
+
+func TestDeserializePacket_UnsupportedProtocol(t *testing.T) {
+	sender, _ := host.NewHostN("127.0.0.1:31337", testutils.RandomRef())
+	msg := NewBuilder(sender).Receiver(sender).Type(TestPacket).Request(&RequestTest{[]byte{0, 1, 2, 3}}).Build()
+
+	envelope, err := toEnvelope(msg)
+	require.NoError(t, err)
+	envelope.Protocol = ProtocolVersion + 1
+	data, err := envelope.Marshal()
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	var lengthBytes [8]byte
+	binary.PutUvarint(lengthBytes[:], uint64(len(data)))
+	buffer.Write(lengthBytes[:])
+	buffer.Write(data)
+
+	_, err = DeserializePacket(&buffer)
+	require.Error(t, err)
+}
+
+func TestSerializePacket_Protocol(t *testing.T) {
+	sender, _ := host.NewHostN("127.0.0.1:31337", testutils.RandomRef())
+	builder := NewBuilder(sender).Receiver(sender).Type(TestPacket).Request(&RequestTest{[]byte{0, 1, 2, 3}})
+
+	msg := builder.Build()
+	require.Equal(t, MinProtocolVersion, msg.Protocol)
+
+	msg = builder.Protocol(ProtocolVersion).Build()
+	serialized, err := SerializePacket(msg)
+	require.NoError(t, err)
+	deserialized, err := DeserializePacket(bytes.NewReader(serialized))
+	require.NoError(t, err)
+	require.Equal(t, ProtocolVersion, deserialized.Protocol)
+
+	_, err = SerializePacket(builder.Protocol(ProtocolVersion + 1).Build())
+	require.Error(t, err)
+}
+
+func TestDeserializePacket_Pulse(t *testing.T) {
+	sender, _ := host.NewHostN("127.0.0.1:31337", testutils.RandomRef())
+	pulse := insolar.Pulse{
+		PulseNumber:      insolar.FirstPulseNumber + 10,
+		PrevPulseNumber:  insolar.FirstPulseNumber,
+		NextPulseNumber:  insolar.FirstPulseNumber + 20,
+		PulseTimestamp:   123,
+		EpochPulseNumber: 1,
+		Signs: map[string]insolar.PulseSenderConfirmation{
+			"pulsar": {PulseNumber: insolar.FirstPulseNumber + 10, ChosenPublicKey: "key", Signature: []byte{1, 2}},
+		},
+	}
+	rand.Read(pulse.Entropy[:])
+	rand.Read(pulse.OriginID[:])
+	msg := NewBuilder(sender).Receiver(sender).Type(types.Pulse).Request(&RequestPulse{Pulse: pulse}).Build()
+
+	serialized, err := SerializePacket(msg)
+	require.NoError(t, err)
+	deserialized, err := DeserializePacket(bytes.NewReader(serialized))
+	require.NoError(t, err)
+
+	require.Equal(t, msg, deserialized)
+}
+
+func TestNegotiateProtocol(t *testing.T) {
+	version, err := NegotiateProtocol(ProtocolVersion + 1)
+	require.NoError(t, err)
+	require.Equal(t, ProtocolVersion, version)
+
+	version, err = NegotiateProtocol(MinProtocolVersion)
+	require.NoError(t, err)
+	require.Equal(t, MinProtocolVersion, version)
+
+	_, err = NegotiateProtocol(MinProtocolVersion - 1)
+	require.Error(t, err)
+}
@@ -0,0 +1,139 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package packet
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// PayloadType identifies wire encoding of the packet data. Values are part of the protocol and must never be reused.
+type PayloadType uint32
+
+const (
+	// PayloadNone means that packet carries no data.
+	PayloadNone PayloadType = iota
+	PayloadRequestPulse
+	PayloadResponsePulse
+	PayloadRequestRPC
+	PayloadResponseRPC
+	PayloadRequestCascade
+	PayloadResponseCascade
+	PayloadNodeBootstrapRequest
+	PayloadNodeBootstrapResponse
+	PayloadStartSessionRequest
+	PayloadStartSessionResponse
+	PayloadGenesisRequest
+	PayloadGenesisResponse
+	PayloadAuthorizationRequest
+	PayloadAuthorizationResponse
+	PayloadRegistrationRequest
+	PayloadRegistrationResponse
+)
+
+// Payload is packet data that is able to encode itself into the wire format.
+type Payload interface {
+	Marshal() ([]byte, error)
+	Unmarshal(data []byte) error
+}
+
+var (
+	payloadTypes   = map[PayloadType]reflect.Type{}
+	payloadsByType = map[reflect.Type]PayloadType{}
+)
+
+// RegisterPayload binds payload type to the concrete type of the sample. Sample must be a pointer to struct.
+// It is not safe for concurrent use and is meant to be called from init functions.
+func RegisterPayload(t PayloadType, sample Payload) {
+	rt := reflect.TypeOf(sample)
+	if t == PayloadNone || rt.Kind() != reflect.Ptr {
+		panic("packet: invalid payload registration for " + rt.String())
+	}
+	if _, ok := payloadTypes[t]; ok {
+		panic("packet: payload type registered twice for " + rt.String())
+	}
+	if _, ok := payloadsByType[rt]; ok {
+		panic("packet: type registered twice " + rt.String())
+	}
+	payloadTypes[t] = rt.Elem()
+	payloadsByType[rt] = t
+}
+
+func marshalPayload(data interface{}) (PayloadType, []byte, error) {
+	if data == nil {
+		return PayloadNone, nil, nil
+	}
+	t, ok := payloadsByType[reflect.TypeOf(data)]
+	if !ok {
+		return PayloadNone, nil, errors.Errorf("unregistered payload type %T", data)
+	}
+	buf, err := data.(Payload).Marshal()
+	if err != nil {
+		return PayloadNone, nil, errors.Wrapf(err, "failed to marshal payload %T", data)
+	}
+	return t, buf, nil
+}
+
+func unmarshalPayload(t PayloadType, data []byte) (interface{}, error) {
+	if t == PayloadNone {
+		return nil, nil
+	}
+	rt, ok := payloadTypes[t]
+	if !ok {
+		return nil, errors.Errorf("unknown payload type %d", t)
+	}
+	pl := reflect.New(rt).Interface().(Payload)
+	if err := pl.Unmarshal(data); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal payload %T", pl)
+	}
+	return pl, nil
+}
@@ -52,9 +52,67 @@ package packet
 
 import (
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network/hostnetwork/packet/wire"
 )
 
 // RequestPulse is data received from a pulsar.
 type RequestPulse struct {
 	Pulse insolar.Pulse
 }
+
+func (r *RequestPulse) Marshal() ([]byte, error) {
+	pb := wire.RequestPulse{Pulse: &wire.Pulse{
+		PulseNumber:      uint32(r.Pulse.PulseNumber),
+		PrevPulseNumber:  uint32(r.Pulse.PrevPulseNumber),
+		NextPulseNumber:  uint32(r.Pulse.NextPulseNumber),
+		PulseTimestamp:   r.Pulse.PulseTimestamp,
+		EpochPulseNumber: int64(r.Pulse.EpochPulseNumber),
+		OriginID:         r.Pulse.OriginID[:],
+		Entropy:          r.Pulse.Entropy[:],
+	}}
+	if len(r.Pulse.Signs) > 0 {
+		pb.Pulse.Signs = make(map[string]*wire.PulseSenderConfirmation, len(r.Pulse.Signs))
+		for key, sign := range r.Pulse.Signs {
+			pb.Pulse.Signs[key] = &wire.PulseSenderConfirmation{
+				PulseNumber:     uint32(sign.PulseNumber),
+				ChosenPublicKey: sign.ChosenPublicKey,
+				Entropy:         sign.Entropy[:],
+				Signature:       sign.Signature,
+			}
+		}
+	}
+	return pb.Marshal()
+}
+
+func (r *RequestPulse) Unmarshal(data []byte) error {
+	pb := wire.RequestPulse{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	*r = RequestPulse{}
+	if pb.Pulse == nil {
+		return nil
+	}
+	r.Pulse = insolar.Pulse{
+		PulseNumber:      insolar.PulseNumber(pb.Pulse.PulseNumber),
+		PrevPulseNumber:  insolar.PulseNumber(pb.Pulse.PrevPulseNumber),
+		NextPulseNumber:  insolar.PulseNumber(pb.Pulse.NextPulseNumber),
+		PulseTimestamp:   pb.Pulse.PulseTimestamp,
+		EpochPulseNumber: int(pb.Pulse.EpochPulseNumber),
+	}
+	copy(r.Pulse.OriginID[:], pb.Pulse.OriginID)
+	copy(r.Pulse.Entropy[:], pb.Pulse.Entropy)
+	if len(pb.Pulse.Signs) > 0 {
+		r.Pulse.Signs = make(map[string]insolar.PulseSenderConfirmation, len(pb.Pulse.Signs))
+		for key, sign := range pb.Pulse.Signs {
+			confirmation := insolar.PulseSenderConfirmation{
+				PulseNumber:     insolar.PulseNumber(sign.PulseNumber),
+				ChosenPublicKey: sign.ChosenPublicKey,
+				Signature:       sign.Signature,
+			}
+			copy(confirmation.Entropy[:], sign.Entropy)
+			r.Pulse.Signs[key] = confirmation
+		}
+	}
+	return nil
+}
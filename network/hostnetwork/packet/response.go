@@ -50,8 +50,26 @@
 
 package packet
 
+import (
+	"github.com/insolar/insolar/network/hostnetwork/packet/wire"
+)
+
 // ResponsePulse is the response for a new pulse from a pulsar.
 type ResponsePulse struct {
 	Success bool
 	Error   string
 }
+
+func (r *ResponsePulse) Marshal() ([]byte, error) {
+	pb := wire.ResponsePulse{Success: r.Success, Error: r.Error}
+	return pb.Marshal()
+}
+
+func (r *ResponsePulse) Unmarshal(data []byte) error {
+	pb := wire.ResponsePulse{}
+	if err := pb.Unmarshal(data); err != nil {
+		return err
+	}
+	*r = ResponsePulse{Success: pb.Success, Error: pb.Error}
+	return nil
+}
@@ -51,17 +51,52 @@
 package packet
 
 import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 )
 
 const (
 	TestPacket = types.PacketType(1337)
+
+	PayloadRequestTest  = PayloadType(1337)
+	PayloadResponseTest = PayloadType(1338)
 )
 
 type RequestTest struct {
 	Data []byte
 }
 
+func (r *RequestTest) Marshal() ([]byte, error) {
+	return r.Data, nil
+}
+
+func (r *RequestTest) Unmarshal(data []byte) error {
+	r.Data = data
+	return nil
+}
+
 type ResponseTest struct {
 	Number int
 }
+
+func (r *ResponseTest) Marshal() ([]byte, error) {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return buf[:binary.PutVarint(buf, int64(r.Number))], nil
+}
+
+func (r *ResponseTest) Unmarshal(data []byte) error {
+	n, read := binary.Varint(data)
+	if read <= 0 {
+		return errors.New("failed to read number")
+	}
+	r.Number = int(n)
+	return nil
+}
+
+func init() {
+	RegisterPayload(PayloadRequestTest, &RequestTest{})
+	RegisterPayload(PayloadResponseTest, &ResponseTest{})
+}
@@ -0,0 +1,7281 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: network/hostnetwork/packet/wire/wire.proto
+
+package wire
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	github_com_insolar_insolar_insolar "github.com/insolar/insolar/insolar"
+	io "io"
+	math "math"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type Host struct {
+	NodeID  github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,1,opt,name=NodeID,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"NodeID"`
+	ShortID uint32                                       `protobuf:"varint,2,opt,name=ShortID,proto3" json:"ShortID,omitempty"`
+	Address string                                       `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+}
+
+func (m *Host) Reset()      { *m = Host{} }
+func (*Host) ProtoMessage() {}
+func (*Host) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{0}
+}
+func (m *Host) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Host) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Host.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Host) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Host.Merge(m, src)
+}
+func (m *Host) XXX_Size() int {
+	return m.Size()
+}
+func (m *Host) XXX_DiscardUnknown() {
+	xxx_messageInfo_Host.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Host proto.InternalMessageInfo
+
+type Envelope struct {
+	Protocol      uint32 `protobuf:"varint,1,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	Sender        *Host  `protobuf:"bytes,2,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	Receiver      *Host  `protobuf:"bytes,3,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Type          uint32 `protobuf:"varint,4,opt,name=Type,proto3" json:"Type,omitempty"`
+	RequestID     uint64 `protobuf:"varint,5,opt,name=RequestID,proto3" json:"RequestID,omitempty"`
+	RemoteAddress string `protobuf:"bytes,6,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
+	TraceID       string `protobuf:"bytes,7,opt,name=TraceID,proto3" json:"TraceID,omitempty"`
+	IsResponse    bool   `protobuf:"varint,8,opt,name=IsResponse,proto3" json:"IsResponse,omitempty"`
+	Error         string `protobuf:"bytes,9,opt,name=Error,proto3" json:"Error,omitempty"`
+	PayloadType   uint32 `protobuf:"varint,10,opt,name=PayloadType,proto3" json:"PayloadType,omitempty"`
+	Payload       []byte `protobuf:"bytes,11,opt,name=Payload,proto3" json:"Payload,omitempty"`
+}
+
+func (m *Envelope) Reset()      { *m = Envelope{} }
+func (*Envelope) ProtoMessage() {}
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{1}
+}
+func (m *Envelope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Envelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Envelope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Envelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Envelope.Merge(m, src)
+}
+func (m *Envelope) XXX_Size() int {
+	return m.Size()
+}
+func (m *Envelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_Envelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Envelope proto.InternalMessageInfo
+
+type PulseSenderConfirmation struct {
+	PulseNumber     uint32 `protobuf:"varint,1,opt,name=PulseNumber,proto3" json:"PulseNumber,omitempty"`
+	ChosenPublicKey string `protobuf:"bytes,2,opt,name=ChosenPublicKey,proto3" json:"ChosenPublicKey,omitempty"`
+	Entropy         []byte `protobuf:"bytes,3,opt,name=Entropy,proto3" json:"Entropy,omitempty"`
+	Signature       []byte `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
+}
+
+func (m *PulseSenderConfirmation) Reset()      { *m = PulseSenderConfirmation{} }
+func (*PulseSenderConfirmation) ProtoMessage() {}
+func (*PulseSenderConfirmation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{2}
+}
+func (m *PulseSenderConfirmation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PulseSenderConfirmation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PulseSenderConfirmation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PulseSenderConfirmation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PulseSenderConfirmation.Merge(m, src)
+}
+func (m *PulseSenderConfirmation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PulseSenderConfirmation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PulseSenderConfirmation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PulseSenderConfirmation proto.InternalMessageInfo
+
+type Pulse struct {
+	PulseNumber      uint32                              `protobuf:"varint,1,opt,name=PulseNumber,proto3" json:"PulseNumber,omitempty"`
+	PrevPulseNumber  uint32                              `protobuf:"varint,2,opt,name=PrevPulseNumber,proto3" json:"PrevPulseNumber,omitempty"`
+	NextPulseNumber  uint32                              `protobuf:"varint,3,opt,name=NextPulseNumber,proto3" json:"NextPulseNumber,omitempty"`
+	PulseTimestamp   int64                               `protobuf:"varint,4,opt,name=PulseTimestamp,proto3" json:"PulseTimestamp,omitempty"`
+	EpochPulseNumber int64                               `protobuf:"varint,5,opt,name=EpochPulseNumber,proto3" json:"EpochPulseNumber,omitempty"`
+	OriginID         []byte                              `protobuf:"bytes,6,opt,name=OriginID,proto3" json:"OriginID,omitempty"`
+	Entropy          []byte                              `protobuf:"bytes,7,opt,name=Entropy,proto3" json:"Entropy,omitempty"`
+	Signs            map[string]*PulseSenderConfirmation `protobuf:"bytes,8,rep,name=Signs,proto3" json:"Signs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Pulse) Reset()      { *m = Pulse{} }
+func (*Pulse) ProtoMessage() {}
+func (*Pulse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{3}
+}
+func (m *Pulse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pulse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pulse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pulse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pulse.Merge(m, src)
+}
+func (m *Pulse) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pulse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pulse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pulse proto.InternalMessageInfo
+
+type RequestPulse struct {
+	Pulse *Pulse `protobuf:"bytes,1,opt,name=Pulse,proto3" json:"Pulse,omitempty"`
+}
+
+func (m *RequestPulse) Reset()      { *m = RequestPulse{} }
+func (*RequestPulse) ProtoMessage() {}
+func (*RequestPulse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{4}
+}
+func (m *RequestPulse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestPulse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestPulse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestPulse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPulse.Merge(m, src)
+}
+func (m *RequestPulse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestPulse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPulse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPulse proto.InternalMessageInfo
+
+type ResponsePulse struct {
+	Success bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *ResponsePulse) Reset()      { *m = ResponsePulse{} }
+func (*ResponsePulse) ProtoMessage() {}
+func (*ResponsePulse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{5}
+}
+func (m *ResponsePulse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponsePulse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponsePulse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponsePulse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponsePulse.Merge(m, src)
+}
+func (m *ResponsePulse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponsePulse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponsePulse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponsePulse proto.InternalMessageInfo
+
+type RequestRPC struct {
+	Method string   `protobuf:"bytes,1,opt,name=Method,proto3" json:"Method,omitempty"`
+	Data   [][]byte `protobuf:"bytes,2,rep,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (m *RequestRPC) Reset()      { *m = RequestRPC{} }
+func (*RequestRPC) ProtoMessage() {}
+func (*RequestRPC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{6}
+}
+func (m *RequestRPC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestRPC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestRPC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestRPC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestRPC.Merge(m, src)
+}
+func (m *RequestRPC) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestRPC) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestRPC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestRPC proto.InternalMessageInfo
+
+type ResponseRPC struct {
+	Success bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Result  []byte `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
+	Error   string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *ResponseRPC) Reset()      { *m = ResponseRPC{} }
+func (*ResponseRPC) ProtoMessage() {}
+func (*ResponseRPC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{7}
+}
+func (m *ResponseRPC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseRPC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseRPC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseRPC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseRPC.Merge(m, src)
+}
+func (m *ResponseRPC) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseRPC) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseRPC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseRPC proto.InternalMessageInfo
+
+type Cascade struct {
+	NodeIds           []github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,1,rep,name=NodeIds,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"NodeIds"`
+	Entropy           []byte                                         `protobuf:"bytes,2,opt,name=Entropy,proto3" json:"Entropy,omitempty"`
+	ReplicationFactor uint64                                         `protobuf:"varint,3,opt,name=ReplicationFactor,proto3" json:"ReplicationFactor,omitempty"`
+}
+
+func (m *Cascade) Reset()      { *m = Cascade{} }
+func (*Cascade) ProtoMessage() {}
+func (*Cascade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{8}
+}
+func (m *Cascade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Cascade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Cascade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Cascade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cascade.Merge(m, src)
+}
+func (m *Cascade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Cascade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cascade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cascade proto.InternalMessageInfo
+
+type RequestCascade struct {
+	TraceID string      `protobuf:"bytes,1,opt,name=TraceID,proto3" json:"TraceID,omitempty"`
+	RPC     *RequestRPC `protobuf:"bytes,2,opt,name=RPC,proto3" json:"RPC,omitempty"`
+	Cascade *Cascade    `protobuf:"bytes,3,opt,name=Cascade,proto3" json:"Cascade,omitempty"`
+}
+
+func (m *RequestCascade) Reset()      { *m = RequestCascade{} }
+func (*RequestCascade) ProtoMessage() {}
+func (*RequestCascade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{9}
+}
+func (m *RequestCascade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestCascade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestCascade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestCascade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCascade.Merge(m, src)
+}
+func (m *RequestCascade) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestCascade) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCascade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestCascade proto.InternalMessageInfo
+
+type ResponseCascade struct {
+	Success bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *ResponseCascade) Reset()      { *m = ResponseCascade{} }
+func (*ResponseCascade) ProtoMessage() {}
+func (*ResponseCascade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{10}
+}
+func (m *ResponseCascade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseCascade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseCascade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseCascade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseCascade.Merge(m, src)
+}
+func (m *ResponseCascade) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseCascade) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseCascade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseCascade proto.InternalMessageInfo
+
+type NodeBootstrapRequest struct {
+	JoinClaim     []byte `protobuf:"bytes,1,opt,name=JoinClaim,proto3" json:"JoinClaim,omitempty"`
+	LastNodePulse uint32 `protobuf:"varint,2,opt,name=LastNodePulse,proto3" json:"LastNodePulse,omitempty"`
+}
+
+func (m *NodeBootstrapRequest) Reset()      { *m = NodeBootstrapRequest{} }
+func (*NodeBootstrapRequest) ProtoMessage() {}
+func (*NodeBootstrapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{11}
+}
+func (m *NodeBootstrapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeBootstrapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeBootstrapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeBootstrapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeBootstrapRequest.Merge(m, src)
+}
+func (m *NodeBootstrapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeBootstrapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeBootstrapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeBootstrapRequest proto.InternalMessageInfo
+
+type NodeBootstrapResponse struct {
+	Code             uint32 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	RejectReason     string `protobuf:"bytes,2,opt,name=RejectReason,proto3" json:"RejectReason,omitempty"`
+	ETA              int64  `protobuf:"varint,3,opt,name=ETA,proto3" json:"ETA,omitempty"`
+	AssignShortID    uint32 `protobuf:"varint,4,opt,name=AssignShortID,proto3" json:"AssignShortID,omitempty"`
+	UpdateSincePulse uint32 `protobuf:"varint,5,opt,name=UpdateSincePulse,proto3" json:"UpdateSincePulse,omitempty"`
+	RedirectHost     string `protobuf:"bytes,6,opt,name=RedirectHost,proto3" json:"RedirectHost,omitempty"`
+	NetworkSize      int64  `protobuf:"varint,7,opt,name=NetworkSize,proto3" json:"NetworkSize,omitempty"`
+}
+
+func (m *NodeBootstrapResponse) Reset()      { *m = NodeBootstrapResponse{} }
+func (*NodeBootstrapResponse) ProtoMessage() {}
+func (*NodeBootstrapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{12}
+}
+func (m *NodeBootstrapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeBootstrapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeBootstrapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeBootstrapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeBootstrapResponse.Merge(m, src)
+}
+func (m *NodeBootstrapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeBootstrapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeBootstrapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeBootstrapResponse proto.InternalMessageInfo
+
+type Node struct {
+	ID      github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,1,opt,name=ID,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"ID"`
+	SID     uint32                                       `protobuf:"varint,2,opt,name=SID,proto3" json:"SID,omitempty"`
+	Role    uint32                                       `protobuf:"varint,3,opt,name=Role,proto3" json:"Role,omitempty"`
+	PK      []byte                                       `protobuf:"bytes,4,opt,name=PK,proto3" json:"PK,omitempty"`
+	Address string                                       `protobuf:"bytes,5,opt,name=Address,proto3" json:"Address,omitempty"`
+	Version string                                       `protobuf:"bytes,6,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *Node) Reset()      { *m = Node{} }
+func (*Node) ProtoMessage() {}
+func (*Node) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{13}
+}
+func (m *Node) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Node.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Node) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Node.Merge(m, src)
+}
+func (m *Node) XXX_Size() int {
+	return m.Size()
+}
+func (m *Node) XXX_DiscardUnknown() {
+	xxx_messageInfo_Node.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Node proto.InternalMessageInfo
+
+type GenesisRequest struct {
+	LastPulse uint32 `protobuf:"varint,1,opt,name=LastPulse,proto3" json:"LastPulse,omitempty"`
+	Discovery *Node  `protobuf:"bytes,2,opt,name=Discovery,proto3" json:"Discovery,omitempty"`
+}
+
+func (m *GenesisRequest) Reset()      { *m = GenesisRequest{} }
+func (*GenesisRequest) ProtoMessage() {}
+func (*GenesisRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{14}
+}
+func (m *GenesisRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisRequest.Merge(m, src)
+}
+func (m *GenesisRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisRequest proto.InternalMessageInfo
+
+type GenesisResponse struct {
+	Response *GenesisRequest `protobuf:"bytes,1,opt,name=Response,proto3" json:"Response,omitempty"`
+	Error    string          `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *GenesisResponse) Reset()      { *m = GenesisResponse{} }
+func (*GenesisResponse) ProtoMessage() {}
+func (*GenesisResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{15}
+}
+func (m *GenesisResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisResponse.Merge(m, src)
+}
+func (m *GenesisResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisResponse proto.InternalMessageInfo
+
+type StartSessionRequest struct {
+}
+
+func (m *StartSessionRequest) Reset()      { *m = StartSessionRequest{} }
+func (*StartSessionRequest) ProtoMessage() {}
+func (*StartSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{16}
+}
+func (m *StartSessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartSessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartSessionRequest.Merge(m, src)
+}
+func (m *StartSessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartSessionRequest proto.InternalMessageInfo
+
+type StartSessionResponse struct {
+	SessionID uint64 `protobuf:"varint,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+}
+
+func (m *StartSessionResponse) Reset()      { *m = StartSessionResponse{} }
+func (*StartSessionResponse) ProtoMessage() {}
+func (*StartSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{17}
+}
+func (m *StartSessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartSessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartSessionResponse.Merge(m, src)
+}
+func (m *StartSessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartSessionResponse proto.InternalMessageInfo
+
+type AuthorizationRequest struct {
+	Certificate []byte `protobuf:"bytes,1,opt,name=Certificate,proto3" json:"Certificate,omitempty"`
+	Protocol    uint32 `protobuf:"varint,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+}
+
+func (m *AuthorizationRequest) Reset()      { *m = AuthorizationRequest{} }
+func (*AuthorizationRequest) ProtoMessage() {}
+func (*AuthorizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{18}
+}
+func (m *AuthorizationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationRequest.Merge(m, src)
+}
+func (m *AuthorizationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizationRequest proto.InternalMessageInfo
+
+type AuthorizationData struct {
+	SessionID     uint64 `protobuf:"varint,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	AssignShortID uint32 `protobuf:"varint,2,opt,name=AssignShortID,proto3" json:"AssignShortID,omitempty"`
+}
+
+func (m *AuthorizationData) Reset()      { *m = AuthorizationData{} }
+func (*AuthorizationData) ProtoMessage() {}
+func (*AuthorizationData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{19}
+}
+func (m *AuthorizationData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizationData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizationData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizationData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationData.Merge(m, src)
+}
+func (m *AuthorizationData) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizationData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizationData proto.InternalMessageInfo
+
+type AuthorizationResponse struct {
+	Code     uint32             `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Error    string             `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	Data     *AuthorizationData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	Protocol uint32             `protobuf:"varint,4,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+}
+
+func (m *AuthorizationResponse) Reset()      { *m = AuthorizationResponse{} }
+func (*AuthorizationResponse) ProtoMessage() {}
+func (*AuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{20}
+}
+func (m *AuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationResponse.Merge(m, src)
+}
+func (m *AuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizationResponse proto.InternalMessageInfo
+
+type RegistrationRequest struct {
+	SessionID uint64 `protobuf:"varint,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	JoinClaim []byte `protobuf:"bytes,3,opt,name=JoinClaim,proto3" json:"JoinClaim,omitempty"`
+}
+
+func (m *RegistrationRequest) Reset()      { *m = RegistrationRequest{} }
+func (*RegistrationRequest) ProtoMessage() {}
+func (*RegistrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{21}
+}
+func (m *RegistrationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationRequest.Merge(m, src)
+}
+func (m *RegistrationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationRequest proto.InternalMessageInfo
+
+type RegistrationResponse struct {
+	Code    uint32 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	RetryIn int64  `protobuf:"varint,2,opt,name=RetryIn,proto3" json:"RetryIn,omitempty"`
+	Error   string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *RegistrationResponse) Reset()      { *m = RegistrationResponse{} }
+func (*RegistrationResponse) ProtoMessage() {}
+func (*RegistrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cfc99b63d1fcdb1, []int{22}
+}
+func (m *RegistrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationResponse.Merge(m, src)
+}
+func (m *RegistrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Host)(nil), "wire.Host")
+	proto.RegisterType((*Envelope)(nil), "wire.Envelope")
+	proto.RegisterType((*PulseSenderConfirmation)(nil), "wire.PulseSenderConfirmation")
+	proto.RegisterType((*Pulse)(nil), "wire.Pulse")
+	proto.RegisterMapType((map[string]*PulseSenderConfirmation)(nil), "wire.Pulse.SignsEntry")
+	proto.RegisterType((*RequestPulse)(nil), "wire.RequestPulse")
+	proto.RegisterType((*ResponsePulse)(nil), "wire.ResponsePulse")
+	proto.RegisterType((*RequestRPC)(nil), "wire.RequestRPC")
+	proto.RegisterType((*ResponseRPC)(nil), "wire.ResponseRPC")
+	proto.RegisterType((*Cascade)(nil), "wire.Cascade")
+	proto.RegisterType((*RequestCascade)(nil), "wire.RequestCascade")
+	proto.RegisterType((*ResponseCascade)(nil), "wire.ResponseCascade")
+	proto.RegisterType((*NodeBootstrapRequest)(nil), "wire.NodeBootstrapRequest")
+	proto.RegisterType((*NodeBootstrapResponse)(nil), "wire.NodeBootstrapResponse")
+	proto.RegisterType((*Node)(nil), "wire.Node")
+	proto.RegisterType((*GenesisRequest)(nil), "wire.GenesisRequest")
+	proto.RegisterType((*GenesisResponse)(nil), "wire.GenesisResponse")
+	proto.RegisterType((*StartSessionRequest)(nil), "wire.StartSessionRequest")
+	proto.RegisterType((*StartSessionResponse)(nil), "wire.StartSessionResponse")
+	proto.RegisterType((*AuthorizationRequest)(nil), "wire.AuthorizationRequest")
+	proto.RegisterType((*AuthorizationData)(nil), "wire.AuthorizationData")
+	proto.RegisterType((*AuthorizationResponse)(nil), "wire.AuthorizationResponse")
+	proto.RegisterType((*RegistrationRequest)(nil), "wire.RegistrationRequest")
+	proto.RegisterType((*RegistrationResponse)(nil), "wire.RegistrationResponse")
+}
+
+func init() {
+	proto.RegisterFile("network/hostnetwork/packet/wire/wire.proto", fileDescriptor_0cfc99b63d1fcdb1)
+}
+
+var fileDescriptor_0cfc99b63d1fcdb1 = []byte{
+	// 1285 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x72, 0x1b, 0x45,
+	0x10, 0xd6, 0xee, 0x4a, 0xb6, 0xdc, 0x92, 0x9d, 0x64, 0xe2, 0x24, 0xaa, 0x14, 0x6c, 0xc4, 0x16,
+	0x15, 0x54, 0x21, 0xd8, 0x90, 0xe4, 0x90, 0xe2, 0x42, 0x39, 0x92, 0x01, 0x93, 0x60, 0x54, 0x23,
+	0x87, 0x00, 0xb7, 0xf5, 0xaa, 0x2d, 0x2d, 0x96, 0x76, 0xc4, 0xcc, 0xc8, 0x41, 0x39, 0x71, 0xa4,
+	0x38, 0x50, 0xbc, 0x00, 0x07, 0x6e, 0x3c, 0x01, 0xcf, 0x90, 0x63, 0x4e, 0x54, 0x8a, 0x43, 0x0a,
+	0x2b, 0x17, 0x8e, 0xb9, 0x72, 0xa3, 0xe6, 0x67, 0xad, 0x5d, 0x29, 0xb8, 0xf8, 0xb9, 0x48, 0xd3,
+	0x5f, 0xf7, 0xf4, 0x74, 0xf7, 0xf4, 0x7c, 0xbd, 0x70, 0x2d, 0x41, 0xf9, 0x90, 0xf1, 0xc3, 0xcd,
+	0x3e, 0x13, 0x32, 0x5d, 0x8f, 0xc2, 0xe8, 0x10, 0xe5, 0xe6, 0xc3, 0x98, 0xa3, 0xfe, 0xd9, 0x18,
+	0x71, 0x26, 0x19, 0x29, 0xaa, 0xf5, 0xe5, 0xb7, 0x7a, 0xb1, 0xec, 0x8f, 0xf7, 0x37, 0x22, 0x36,
+	0xdc, 0xec, 0xb1, 0x1e, 0xdb, 0xd4, 0xca, 0xfd, 0xf1, 0x81, 0x96, 0xb4, 0xa0, 0x57, 0x66, 0x53,
+	0xf0, 0xad, 0x03, 0xc5, 0x0f, 0x99, 0x90, 0xe4, 0x1e, 0x2c, 0xed, 0xb2, 0x2e, 0xee, 0xb4, 0x6a,
+	0x4e, 0xdd, 0x69, 0x54, 0xef, 0xdc, 0x7a, 0xfc, 0xec, 0x4a, 0xe1, 0xb7, 0x67, 0x57, 0xae, 0x67,
+	0xfc, 0xc5, 0x89, 0x60, 0x83, 0x90, 0xcf, 0xff, 0x6f, 0x50, 0x3c, 0x40, 0x8e, 0x49, 0x84, 0xd4,
+	0xfa, 0x20, 0x35, 0x58, 0xee, 0xf4, 0x19, 0x97, 0x3b, 0xad, 0x9a, 0x5b, 0x77, 0x1a, 0xab, 0x34,
+	0x15, 0x95, 0x66, 0xab, 0xdb, 0xe5, 0x28, 0x44, 0xcd, 0xab, 0x3b, 0x8d, 0x15, 0x9a, 0x8a, 0xc1,
+	0xaf, 0x2e, 0x94, 0xb7, 0x93, 0x23, 0x1c, 0xb0, 0x11, 0x92, 0xcb, 0x50, 0x6e, 0xab, 0x00, 0x23,
+	0x36, 0xd0, 0x01, 0xad, 0xd2, 0x13, 0x99, 0x04, 0xb0, 0xd4, 0xc1, 0xa4, 0x8b, 0x5c, 0xfb, 0xae,
+	0xdc, 0x80, 0x0d, 0x5d, 0x05, 0x95, 0x06, 0xb5, 0x1a, 0x72, 0x15, 0xca, 0x14, 0x23, 0x8c, 0x8f,
+	0x90, 0xd7, 0xbc, 0x05, 0xab, 0x13, 0x1d, 0x21, 0x50, 0xdc, 0x9b, 0x8c, 0xb0, 0x56, 0xd4, 0x67,
+	0xe8, 0x35, 0x79, 0x05, 0x56, 0x28, 0x7e, 0x35, 0x46, 0xa1, 0xc2, 0x2f, 0xd5, 0x9d, 0x46, 0x91,
+	0xce, 0x00, 0xf2, 0x3a, 0xac, 0x52, 0x1c, 0x32, 0x89, 0x69, 0x1a, 0x4b, 0x3a, 0x8d, 0x3c, 0xa8,
+	0xd2, 0xdc, 0xe3, 0x61, 0xa4, 0xea, 0xb9, 0x6c, 0xd2, 0xb4, 0x22, 0xf1, 0x01, 0x76, 0x04, 0x45,
+	0x31, 0x62, 0x89, 0xc0, 0x5a, 0xb9, 0xee, 0x34, 0xca, 0x34, 0x83, 0x90, 0x75, 0x28, 0x6d, 0x73,
+	0xce, 0x78, 0x6d, 0x45, 0xef, 0x33, 0x02, 0xa9, 0x43, 0xa5, 0x1d, 0x4e, 0x06, 0x2c, 0xec, 0xea,
+	0x70, 0x41, 0x87, 0x9b, 0x85, 0xd4, 0x89, 0x56, 0xac, 0x55, 0xd4, 0x0d, 0xd2, 0x54, 0x0c, 0x7e,
+	0x74, 0xe0, 0x52, 0x7b, 0x3c, 0x10, 0x68, 0x6a, 0xd3, 0x64, 0xc9, 0x41, 0xcc, 0x87, 0xa1, 0x8c,
+	0x59, 0xa2, 0xfd, 0x2a, 0xd5, 0xee, 0x78, 0xb8, 0x8f, 0xdc, 0x96, 0x3a, 0x0b, 0x91, 0x06, 0x9c,
+	0x69, 0xf6, 0x99, 0xc0, 0xa4, 0x3d, 0xde, 0x1f, 0xc4, 0xd1, 0x5d, 0x9c, 0xe8, 0xb2, 0xaf, 0xd0,
+	0x79, 0x58, 0x45, 0xb0, 0x9d, 0x48, 0xce, 0x46, 0x13, 0x5d, 0xf2, 0x2a, 0x4d, 0x45, 0x55, 0xd1,
+	0x4e, 0xdc, 0x4b, 0x42, 0x39, 0xe6, 0xa6, 0xd4, 0x55, 0x3a, 0x03, 0x82, 0xef, 0x3d, 0x28, 0xe9,
+	0x13, 0xff, 0x59, 0x34, 0x6d, 0x8e, 0x47, 0x59, 0x2b, 0xd3, 0x60, 0xf3, 0xb0, 0xb2, 0xdc, 0xc5,
+	0xaf, 0x65, 0xd6, 0xd2, 0x33, 0x96, 0x73, 0x30, 0xb9, 0x0a, 0x6b, 0x5a, 0xdc, 0x8b, 0x87, 0x28,
+	0x64, 0x38, 0x1c, 0xe9, 0x10, 0x3d, 0x3a, 0x87, 0x92, 0x6b, 0x70, 0x76, 0x7b, 0xc4, 0xa2, 0x7e,
+	0xd6, 0x65, 0x49, 0x5b, 0x2e, 0xe0, 0xaa, 0x7f, 0x3f, 0xe1, 0x71, 0x2f, 0x4e, 0x76, 0x5a, 0xba,
+	0x41, 0xaa, 0xf4, 0x44, 0xce, 0xd6, 0x69, 0x39, 0x5f, 0xa7, 0xeb, 0x50, 0x52, 0x65, 0x11, 0xb5,
+	0x72, 0xdd, 0x6b, 0x54, 0x6e, 0x5c, 0x34, 0x2d, 0xab, 0xfd, 0x6e, 0x68, 0x85, 0x32, 0x9c, 0x50,
+	0x63, 0x74, 0xf9, 0x01, 0xc0, 0x0c, 0x24, 0x67, 0xc1, 0x3b, 0xc4, 0x89, 0xae, 0xd9, 0x0a, 0x55,
+	0x4b, 0x72, 0x13, 0x4a, 0x47, 0xe1, 0x60, 0x8c, 0xf6, 0x99, 0xbc, 0x9a, 0xf1, 0xb6, 0xd8, 0x09,
+	0xd4, 0xd8, 0xbe, 0xeb, 0xde, 0x76, 0x82, 0x77, 0xa0, 0x6a, 0xfb, 0xdd, 0x5c, 0xcb, 0x6b, 0xf6,
+	0x7e, 0xb4, 0xf3, 0xca, 0x8d, 0x4a, 0xc6, 0x11, 0x35, 0x9a, 0xe0, 0x3d, 0x58, 0x4d, 0x3b, 0xd8,
+	0xec, 0x51, 0x0c, 0x30, 0x8e, 0x22, 0xf5, 0x40, 0x1c, 0xdd, 0xe3, 0xa9, 0x38, 0x6b, 0x70, 0x37,
+	0xd3, 0xe0, 0xc1, 0x6d, 0x00, 0x7b, 0x26, 0x6d, 0x37, 0xc9, 0x45, 0x58, 0xfa, 0x18, 0x65, 0x9f,
+	0x75, 0x6d, 0x3e, 0x56, 0x52, 0xcf, 0xb5, 0x15, 0xca, 0xb0, 0xe6, 0xd6, 0xbd, 0x46, 0x95, 0xea,
+	0x75, 0x70, 0x1f, 0x2a, 0xe9, 0xd1, 0x6a, 0xeb, 0xdf, 0x1f, 0x7c, 0x11, 0x96, 0x28, 0x8a, 0xf1,
+	0x40, 0xea, 0x93, 0xab, 0xd4, 0x4a, 0xb3, 0x80, 0xbc, 0x6c, 0x40, 0x3f, 0x39, 0xb0, 0xdc, 0x0c,
+	0x45, 0x14, 0x76, 0x91, 0xec, 0xc2, 0xb2, 0x26, 0xb6, 0xae, 0xf2, 0xe9, 0xfd, 0x67, 0x76, 0x4c,
+	0x9d, 0x64, 0x3b, 0xc0, 0x9d, 0xef, 0x80, 0x73, 0x14, 0x47, 0x83, 0x38, 0xd2, 0x97, 0xf2, 0x7e,
+	0x18, 0x49, 0x1b, 0x57, 0x91, 0x2e, 0x2a, 0x82, 0x87, 0xb0, 0x66, 0x8b, 0x96, 0x46, 0x9a, 0xe1,
+	0x1d, 0x27, 0xcf, 0x3b, 0x01, 0x78, 0xb4, 0xdd, 0xb4, 0xbd, 0x70, 0xd6, 0x5c, 0xe1, 0xac, 0xe2,
+	0x54, 0x29, 0xc9, 0x1b, 0x27, 0x29, 0x5b, 0xd2, 0x5c, 0x35, 0x76, 0x16, 0xa4, 0xa9, 0x36, 0xd8,
+	0x82, 0x33, 0x69, 0xcd, 0x33, 0x27, 0xff, 0xab, 0x0b, 0xff, 0x02, 0xd6, 0x55, 0x39, 0xee, 0x30,
+	0x26, 0x85, 0xe4, 0xe1, 0xc8, 0xc6, 0xa2, 0xb8, 0xe2, 0x23, 0x16, 0x27, 0xcd, 0x41, 0x18, 0x0f,
+	0xcd, 0x2c, 0xa2, 0x33, 0x40, 0xb1, 0xef, 0xbd, 0x50, 0x48, 0xb5, 0xd3, 0xb4, 0xa4, 0x79, 0xfd,
+	0x79, 0x30, 0xf8, 0xd3, 0x81, 0x0b, 0x73, 0xce, 0x2d, 0xbb, 0x12, 0x28, 0x36, 0x59, 0x17, 0x2d,
+	0xb5, 0xe8, 0x35, 0x09, 0x54, 0xbb, 0x7f, 0x89, 0x91, 0xa4, 0x18, 0x0a, 0x96, 0xd8, 0x30, 0x73,
+	0x98, 0x7a, 0x5d, 0xdb, 0x7b, 0x5b, 0xba, 0x2a, 0x1e, 0x55, 0x4b, 0x15, 0xc9, 0x96, 0x10, 0x71,
+	0x2f, 0x49, 0x07, 0x9d, 0x19, 0x21, 0x79, 0x50, 0x71, 0xc6, 0xfd, 0x51, 0x37, 0x94, 0xd8, 0x89,
+	0x93, 0xc8, 0x86, 0x5c, 0xd2, 0x86, 0x0b, 0xb8, 0x89, 0xa3, 0x1b, 0x73, 0x8c, 0xa4, 0x9a, 0x52,
+	0x76, 0xb0, 0xe4, 0x30, 0xc5, 0x90, 0xbb, 0xe6, 0x33, 0xa0, 0x13, 0x3f, 0x42, 0xcd, 0x1f, 0x1e,
+	0xcd, 0x42, 0xc1, 0x2f, 0x0e, 0x14, 0x55, 0xee, 0xa4, 0x05, 0xee, 0xff, 0x9c, 0xe6, 0xee, 0x4e,
+	0x4b, 0x25, 0xde, 0x39, 0x99, 0xe2, 0x6a, 0xa9, 0x4a, 0x48, 0xd9, 0x00, 0x2d, 0x9b, 0xea, 0x35,
+	0x59, 0x03, 0xb7, 0x7d, 0xd7, 0x32, 0xbb, 0xdb, 0xbe, 0x9b, 0x9d, 0xf2, 0xa5, 0xdc, 0x94, 0x57,
+	0x9a, 0x4f, 0x91, 0x8b, 0x98, 0x25, 0x36, 0xbf, 0x54, 0x0c, 0x3e, 0x83, 0xb5, 0x0f, 0x30, 0x41,
+	0x11, 0x8b, 0x4c, 0x2b, 0xa8, 0x7b, 0x9d, 0x71, 0xcf, 0x2a, 0x9d, 0x01, 0xa4, 0x01, 0x2b, 0xad,
+	0x58, 0x44, 0xec, 0x08, 0xf9, 0x24, 0xff, 0x25, 0xa0, 0xd2, 0xa7, 0x33, 0x65, 0xf0, 0x39, 0x9c,
+	0x39, 0xf1, 0x6c, 0xfb, 0xe0, 0x6d, 0x28, 0xa7, 0x6b, 0xcb, 0x6a, 0xeb, 0x66, 0x6f, 0x3e, 0x04,
+	0x5a, 0x5e, 0x9c, 0xcb, 0xb9, 0x2e, 0xbe, 0x00, 0xe7, 0x3b, 0x32, 0xe4, 0xb2, 0x83, 0x42, 0x25,
+	0x61, 0xb7, 0x05, 0xb7, 0x60, 0x3d, 0x0f, 0x5b, 0x27, 0x6a, 0x10, 0x1a, 0xc8, 0x5e, 0x4d, 0x91,
+	0xce, 0x80, 0x60, 0x0f, 0xd6, 0xb7, 0xc6, 0xb2, 0xcf, 0x78, 0xfc, 0x28, 0x94, 0x33, 0x6f, 0xea,
+	0xd2, 0x9b, 0xc8, 0x65, 0x7c, 0xa0, 0x5e, 0x3f, 0xda, 0x47, 0x91, 0x85, 0x72, 0x9f, 0x4b, 0x6e,
+	0xfe, 0x73, 0x29, 0x78, 0x00, 0xe7, 0x72, 0x5e, 0x15, 0x69, 0x9e, 0x1e, 0xc8, 0x62, 0x6f, 0xbb,
+	0x2f, 0xe9, 0xed, 0xe0, 0x3b, 0x07, 0x2e, 0xcc, 0xc5, 0x7b, 0xca, 0x2b, 0x7b, 0x69, 0xfd, 0xc8,
+	0x9b, 0x96, 0xd0, 0x0d, 0xdd, 0x5c, 0x32, 0x77, 0xb0, 0x10, 0xae, 0x61, 0xfa, 0x5c, 0x96, 0xc5,
+	0xb9, 0x2c, 0x0f, 0xe1, 0x3c, 0xc5, 0x5e, 0xac, 0x5e, 0x7b, 0xb6, 0x74, 0xa7, 0xe7, 0x99, 0x69,
+	0x46, 0x37, 0xd7, 0x8c, 0x79, 0x16, 0xf2, 0xe6, 0x58, 0x48, 0x71, 0x57, 0xfe, 0xb0, 0x53, 0xf2,
+	0xae, 0xc1, 0x32, 0x45, 0xc9, 0x27, 0x3b, 0xe6, 0x0c, 0x8f, 0xa6, 0xe2, 0xcb, 0xe7, 0xce, 0x9d,
+	0xdb, 0x8f, 0x8f, 0xfd, 0xc2, 0x93, 0x63, 0xbf, 0xf0, 0xf4, 0xd8, 0x2f, 0xbc, 0x38, 0xf6, 0x9d,
+	0x6f, 0xa6, 0xbe, 0xf3, 0xf3, 0xd4, 0x77, 0x1e, 0x4f, 0x7d, 0xe7, 0xc9, 0xd4, 0x77, 0x7e, 0x9f,
+	0xfa, 0xce, 0x1f, 0x53, 0xbf, 0xf0, 0x62, 0xea, 0x3b, 0x3f, 0x3c, 0xf7, 0x0b, 0x4f, 0x9e, 0xfb,
+	0x85, 0xa7, 0xcf, 0xfd, 0xc2, 0xfe, 0x92, 0xfe, 0xa4, 0xbf, 0xf9, 0xd7, 0x00, 0x1b, 0x3f, 0xd8,
+	0x54, 0x35, 0x0c, 0x00, 0x00,
+}
+
+func (this *Host) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Host)
+	if !ok {
+		that2, ok := that.(Host)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.NodeID.Equal(that1.NodeID) {
+		return false
+	}
+	if this.ShortID != that1.ShortID {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+func (this *Envelope) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Envelope)
+	if !ok {
+		that2, ok := that.(Envelope)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Protocol != that1.Protocol {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if !this.Receiver.Equal(that1.Receiver) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	if this.RemoteAddress != that1.RemoteAddress {
+		return false
+	}
+	if this.TraceID != that1.TraceID {
+		return false
+	}
+	if this.IsResponse != that1.IsResponse {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.PayloadType != that1.PayloadType {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	return true
+}
+func (this *PulseSenderConfirmation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PulseSenderConfirmation)
+	if !ok {
+		that2, ok := that.(PulseSenderConfirmation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PulseNumber != that1.PulseNumber {
+		return false
+	}
+	if this.ChosenPublicKey != that1.ChosenPublicKey {
+		return false
+	}
+	if !bytes.Equal(this.Entropy, that1.Entropy) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	return true
+}
+func (this *Pulse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Pulse)
+	if !ok {
+		that2, ok := that.(Pulse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PulseNumber != that1.PulseNumber {
+		return false
+	}
+	if this.PrevPulseNumber != that1.PrevPulseNumber {
+		return false
+	}
+	if this.NextPulseNumber != that1.NextPulseNumber {
+		return false
+	}
+	if this.PulseTimestamp != that1.PulseTimestamp {
+		return false
+	}
+	if this.EpochPulseNumber != that1.EpochPulseNumber {
+		return false
+	}
+	if !bytes.Equal(this.OriginID, that1.OriginID) {
+		return false
+	}
+	if !bytes.Equal(this.Entropy, that1.Entropy) {
+		return false
+	}
+	if len(this.Signs) != len(that1.Signs) {
+		return false
+	}
+	for i := range this.Signs {
+		if !this.Signs[i].Equal(that1.Signs[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RequestPulse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RequestPulse)
+	if !ok {
+		that2, ok := that.(RequestPulse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pulse.Equal(that1.Pulse) {
+		return false
+	}
+	return true
+}
+func (this *ResponsePulse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResponsePulse)
+	if !ok {
+		that2, ok := that.(ResponsePulse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *RequestRPC) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RequestRPC)
+	if !ok {
+		that2, ok := that.(RequestRPC)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if len(this.Data) != len(that1.Data) {
+		return false
+	}
+	for i := range this.Data {
+		if !bytes.Equal(this.Data[i], that1.Data[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ResponseRPC) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResponseRPC)
+	if !ok {
+		that2, ok := that.(ResponseRPC)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if !bytes.Equal(this.Result, that1.Result) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *Cascade) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Cascade)
+	if !ok {
+		that2, ok := that.(Cascade)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.NodeIds) != len(that1.NodeIds) {
+		return false
+	}
+	for i := range this.NodeIds {
+		if !this.NodeIds[i].Equal(that1.NodeIds[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Entropy, that1.Entropy) {
+		return false
+	}
+	if this.ReplicationFactor != that1.ReplicationFactor {
+		return false
+	}
+	return true
+}
+func (this *RequestCascade) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RequestCascade)
+	if !ok {
+		that2, ok := that.(RequestCascade)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TraceID != that1.TraceID {
+		return false
+	}
+	if !this.RPC.Equal(that1.RPC) {
+		return false
+	}
+	if !this.Cascade.Equal(that1.Cascade) {
+		return false
+	}
+	return true
+}
+func (this *ResponseCascade) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResponseCascade)
+	if !ok {
+		that2, ok := that.(ResponseCascade)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *NodeBootstrapRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NodeBootstrapRequest)
+	if !ok {
+		that2, ok := that.(NodeBootstrapRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.JoinClaim, that1.JoinClaim) {
+		return false
+	}
+	if this.LastNodePulse != that1.LastNodePulse {
+		return false
+	}
+	return true
+}
+func (this *NodeBootstrapResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NodeBootstrapResponse)
+	if !ok {
+		that2, ok := that.(NodeBootstrapResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.RejectReason != that1.RejectReason {
+		return false
+	}
+	if this.ETA != that1.ETA {
+		return false
+	}
+	if this.AssignShortID != that1.AssignShortID {
+		return false
+	}
+	if this.UpdateSincePulse != that1.UpdateSincePulse {
+		return false
+	}
+	if this.RedirectHost != that1.RedirectHost {
+		return false
+	}
+	if this.NetworkSize != that1.NetworkSize {
+		return false
+	}
+	return true
+}
+func (this *Node) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Node)
+	if !ok {
+		that2, ok := that.(Node)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ID.Equal(that1.ID) {
+		return false
+	}
+	if this.SID != that1.SID {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if !bytes.Equal(this.PK, that1.PK) {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *GenesisRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GenesisRequest)
+	if !ok {
+		that2, ok := that.(GenesisRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LastPulse != that1.LastPulse {
+		return false
+	}
+	if !this.Discovery.Equal(that1.Discovery) {
+		return false
+	}
+	return true
+}
+func (this *GenesisResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GenesisResponse)
+	if !ok {
+		that2, ok := that.(GenesisResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Response.Equal(that1.Response) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *StartSessionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartSessionRequest)
+	if !ok {
+		that2, ok := that.(StartSessionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *StartSessionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartSessionResponse)
+	if !ok {
+		that2, ok := that.(StartSessionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
+	return true
+}
+func (this *AuthorizationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuthorizationRequest)
+	if !ok {
+		that2, ok := that.(AuthorizationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Certificate, that1.Certificate) {
+		return false
+	}
+	if this.Protocol != that1.Protocol {
+		return false
+	}
+	return true
+}
+func (this *AuthorizationData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuthorizationData)
+	if !ok {
+		that2, ok := that.(AuthorizationData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
+	if this.AssignShortID != that1.AssignShortID {
+		return false
+	}
+	return true
+}
+func (this *AuthorizationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuthorizationResponse)
+	if !ok {
+		that2, ok := that.(AuthorizationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if !this.Data.Equal(that1.Data) {
+		return false
+	}
+	if this.Protocol != that1.Protocol {
+		return false
+	}
+	return true
+}
+func (this *RegistrationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegistrationRequest)
+	if !ok {
+		that2, ok := that.(RegistrationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !bytes.Equal(this.JoinClaim, that1.JoinClaim) {
+		return false
+	}
+	return true
+}
+func (this *RegistrationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegistrationResponse)
+	if !ok {
+		that2, ok := that.(RegistrationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.RetryIn != that1.RetryIn {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *Host) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&wire.Host{")
+	s = append(s, "NodeID: "+fmt.Sprintf("%#v", this.NodeID)+",\n")
+	s = append(s, "ShortID: "+fmt.Sprintf("%#v", this.ShortID)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Envelope) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&wire.Envelope{")
+	s = append(s, "Protocol: "+fmt.Sprintf("%#v", this.Protocol)+",\n")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	if this.Receiver != nil {
+		s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
+	}
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "RequestID: "+fmt.Sprintf("%#v", this.RequestID)+",\n")
+	s = append(s, "RemoteAddress: "+fmt.Sprintf("%#v", this.RemoteAddress)+",\n")
+	s = append(s, "TraceID: "+fmt.Sprintf("%#v", this.TraceID)+",\n")
+	s = append(s, "IsResponse: "+fmt.Sprintf("%#v", this.IsResponse)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "PayloadType: "+fmt.Sprintf("%#v", this.PayloadType)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PulseSenderConfirmation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&wire.PulseSenderConfirmation{")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "ChosenPublicKey: "+fmt.Sprintf("%#v", this.ChosenPublicKey)+",\n")
+	s = append(s, "Entropy: "+fmt.Sprintf("%#v", this.Entropy)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Pulse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&wire.Pulse{")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "PrevPulseNumber: "+fmt.Sprintf("%#v", this.PrevPulseNumber)+",\n")
+	s = append(s, "NextPulseNumber: "+fmt.Sprintf("%#v", this.NextPulseNumber)+",\n")
+	s = append(s, "PulseTimestamp: "+fmt.Sprintf("%#v", this.PulseTimestamp)+",\n")
+	s = append(s, "EpochPulseNumber: "+fmt.Sprintf("%#v", this.EpochPulseNumber)+",\n")
+	s = append(s, "OriginID: "+fmt.Sprintf("%#v", this.OriginID)+",\n")
+	s = append(s, "Entropy: "+fmt.Sprintf("%#v", this.Entropy)+",\n")
+	keysForSigns := make([]string, 0, len(this.Signs))
+	for k, _ := range this.Signs {
+		keysForSigns = append(keysForSigns, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSigns)
+	mapStringForSigns := "map[string]*PulseSenderConfirmation{"
+	for _, k := range keysForSigns {
+		mapStringForSigns += fmt.Sprintf("%#v: %#v,", k, this.Signs[k])
+	}
+	mapStringForSigns += "}"
+	if this.Signs != nil {
+		s = append(s, "Signs: "+mapStringForSigns+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RequestPulse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&wire.RequestPulse{")
+	if this.Pulse != nil {
+		s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResponsePulse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&wire.ResponsePulse{")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RequestRPC) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&wire.RequestRPC{")
+	s = append(s, "Method: "+fmt.Sprintf("%#v", this.Method)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResponseRPC) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&wire.ResponseRPC{")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Cascade) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&wire.Cascade{")
+	s = append(s, "NodeIds: "+fmt.Sprintf("%#v", this.NodeIds)+",\n")
+	s = append(s, "Entropy: "+fmt.Sprintf("%#v", this.Entropy)+",\n")
+	s = append(s, "ReplicationFactor: "+fmt.Sprintf("%#v", this.ReplicationFactor)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RequestCascade) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&wire.RequestCascade{")
+	s = append(s, "TraceID: "+fmt.Sprintf("%#v", this.TraceID)+",\n")
+	if this.RPC != nil {
+		s = append(s, "RPC: "+fmt.Sprintf("%#v", this.RPC)+",\n")
+	}
+	if this.Cascade != nil {
+		s = append(s, "Cascade: "+fmt.Sprintf("%#v", this.Cascade)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResponseCascade) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&wire.ResponseCascade{")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NodeBootstrapRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&wire.NodeBootstrapRequest{")
+	s = append(s, "JoinClaim: "+fmt.Sprintf("%#v", this.JoinClaim)+",\n")
+	s = append(s, "LastNodePulse: "+fmt.Sprintf("%#v", this.LastNodePulse)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NodeBootstrapResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&wire.NodeBootstrapResponse{")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "RejectReason: "+fmt.Sprintf("%#v", this.RejectReason)+",\n")
+	s = append(s, "ETA: "+fmt.Sprintf("%#v", this.ETA)+",\n")
+	s = append(s, "AssignShortID: "+fmt.Sprintf("%#v", this.AssignShortID)+",\n")
+	s = append(s, "UpdateSincePulse: "+fmt.Sprintf("%#v", this.UpdateSincePulse)+",\n")
+	s = append(s, "RedirectHost: "+fmt.Sprintf("%#v", this.RedirectHost)+",\n")
+	s = append(s, "NetworkSize: "+fmt.Sprintf("%#v", this.NetworkSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Node) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&wire.Node{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "SID: "+fmt.Sprintf("%#v", this.SID)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "PK: "+fmt.Sprintf("%#v", this.PK)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GenesisRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&wire.GenesisRequest{")
+	s = append(s, "LastPulse: "+fmt.Sprintf("%#v", this.LastPulse)+",\n")
+	if this.Discovery != nil {
+		s = append(s, "Discovery: "+fmt.Sprintf("%#v", this.Discovery)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GenesisResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&wire.GenesisResponse{")
+	if this.Response != nil {
+		s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
+	}
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StartSessionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&wire.StartSessionRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StartSessionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&wire.StartSessionResponse{")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuthorizationRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&wire.AuthorizationRequest{")
+	s = append(s, "Certificate: "+fmt.Sprintf("%#v", this.Certificate)+",\n")
+	s = append(s, "Protocol: "+fmt.Sprintf("%#v", this.Protocol)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuthorizationData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&wire.AuthorizationData{")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "AssignShortID: "+fmt.Sprintf("%#v", this.AssignShortID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuthorizationResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&wire.AuthorizationResponse{")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	if this.Data != nil {
+		s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	}
+	s = append(s, "Protocol: "+fmt.Sprintf("%#v", this.Protocol)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RegistrationRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&wire.RegistrationRequest{")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "JoinClaim: "+fmt.Sprintf("%#v", this.JoinClaim)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RegistrationResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&wire.RegistrationResponse{")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "RetryIn: "+fmt.Sprintf("%#v", this.RetryIn)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringWire(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *Host) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Host) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintWire(dAtA, i, uint64(m.NodeID.Size()))
+	n1, err := m.NodeID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if m.ShortID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.ShortID))
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
+func (m *Envelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Envelope) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Protocol != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Protocol))
+	}
+	if m.Sender != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Sender.Size()))
+		n2, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Receiver != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Receiver.Size()))
+		n3, err := m.Receiver.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Type))
+	}
+	if m.RequestID != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.RequestID))
+	}
+	if len(m.RemoteAddress) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.RemoteAddress)))
+		i += copy(dAtA[i:], m.RemoteAddress)
+	}
+	if len(m.TraceID) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.TraceID)))
+		i += copy(dAtA[i:], m.TraceID)
+	}
+	if m.IsResponse {
+		dAtA[i] = 0x40
+		i++
+		if m.IsResponse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.PayloadType != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.PayloadType))
+	}
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	return i, nil
+}
+
+func (m *PulseSenderConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PulseSenderConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PulseNumber != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.PulseNumber))
+	}
+	if len(m.ChosenPublicKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.ChosenPublicKey)))
+		i += copy(dAtA[i:], m.ChosenPublicKey)
+	}
+	if len(m.Entropy) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Entropy)))
+		i += copy(dAtA[i:], m.Entropy)
+	}
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
+	}
+	return i, nil
+}
+
+func (m *Pulse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pulse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PulseNumber != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.PulseNumber))
+	}
+	if m.PrevPulseNumber != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.PrevPulseNumber))
+	}
+	if m.NextPulseNumber != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.NextPulseNumber))
+	}
+	if m.PulseTimestamp != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.PulseTimestamp))
+	}
+	if m.EpochPulseNumber != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.EpochPulseNumber))
+	}
+	if len(m.OriginID) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.OriginID)))
+		i += copy(dAtA[i:], m.OriginID)
+	}
+	if len(m.Entropy) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Entropy)))
+		i += copy(dAtA[i:], m.Entropy)
+	}
+	if len(m.Signs) > 0 {
+		for k, _ := range m.Signs {
+			dAtA[i] = 0x42
+			i++
+			v := m.Signs[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovWire(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovWire(uint64(len(k))) + msgSize
+			i = encodeVarintWire(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintWire(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintWire(dAtA, i, uint64(v.Size()))
+				n4, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n4
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *RequestPulse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestPulse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pulse != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Pulse.Size()))
+		n5, err := m.Pulse.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *ResponsePulse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponsePulse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		dAtA[i] = 0x8
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *RequestRPC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestRPC) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Method) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
+	}
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintWire(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *ResponseRPC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseRPC) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		dAtA[i] = 0x8
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Result) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Result)))
+		i += copy(dAtA[i:], m.Result)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *Cascade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cascade) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeIds) > 0 {
+		for _, msg := range m.NodeIds {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintWire(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Entropy) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Entropy)))
+		i += copy(dAtA[i:], m.Entropy)
+	}
+	if m.ReplicationFactor != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.ReplicationFactor))
+	}
+	return i, nil
+}
+
+func (m *RequestCascade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCascade) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TraceID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.TraceID)))
+		i += copy(dAtA[i:], m.TraceID)
+	}
+	if m.RPC != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.RPC.Size()))
+		n6, err := m.RPC.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Cascade != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Cascade.Size()))
+		n7, err := m.Cascade.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
+func (m *ResponseCascade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseCascade) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		dAtA[i] = 0x8
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *NodeBootstrapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeBootstrapRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.JoinClaim) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.JoinClaim)))
+		i += copy(dAtA[i:], m.JoinClaim)
+	}
+	if m.LastNodePulse != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.LastNodePulse))
+	}
+	return i, nil
+}
+
+func (m *NodeBootstrapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeBootstrapResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Code))
+	}
+	if len(m.RejectReason) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.RejectReason)))
+		i += copy(dAtA[i:], m.RejectReason)
+	}
+	if m.ETA != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.ETA))
+	}
+	if m.AssignShortID != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.AssignShortID))
+	}
+	if m.UpdateSincePulse != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.UpdateSincePulse))
+	}
+	if len(m.RedirectHost) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.RedirectHost)))
+		i += copy(dAtA[i:], m.RedirectHost)
+	}
+	if m.NetworkSize != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.NetworkSize))
+	}
+	return i, nil
+}
+
+func (m *Node) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Node) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintWire(dAtA, i, uint64(m.ID.Size()))
+	n8, err := m.ID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	if m.SID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.SID))
+	}
+	if m.Role != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Role))
+	}
+	if len(m.PK) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.PK)))
+		i += copy(dAtA[i:], m.PK)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	return i, nil
+}
+
+func (m *GenesisRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LastPulse != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.LastPulse))
+	}
+	if m.Discovery != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Discovery.Size()))
+		n9, err := m.Discovery.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *GenesisResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Response != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Response.Size()))
+		n10, err := m.Response.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *StartSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *StartSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.SessionID))
+	}
+	return i, nil
+}
+
+func (m *AuthorizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Certificate) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Certificate)))
+		i += copy(dAtA[i:], m.Certificate)
+	}
+	if m.Protocol != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Protocol))
+	}
+	return i, nil
+}
+
+func (m *AuthorizationData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizationData) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.SessionID))
+	}
+	if m.AssignShortID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.AssignShortID))
+	}
+	return i, nil
+}
+
+func (m *AuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.Data != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Data.Size()))
+		n11, err := m.Data.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.Protocol != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Protocol))
+	}
+	return i, nil
+}
+
+func (m *RegistrationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.SessionID))
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.JoinClaim) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.JoinClaim)))
+		i += copy(dAtA[i:], m.JoinClaim)
+	}
+	return i, nil
+}
+
+func (m *RegistrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.Code))
+	}
+	if m.RetryIn != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(m.RetryIn))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func encodeVarintWire(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Host) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NodeID.Size()
+	n += 1 + l + sovWire(uint64(l))
+	if m.ShortID != 0 {
+		n += 1 + sovWire(uint64(m.ShortID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *Envelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Protocol != 0 {
+		n += 1 + sovWire(uint64(m.Protocol))
+	}
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.Receiver != nil {
+		l = m.Receiver.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovWire(uint64(m.Type))
+	}
+	if m.RequestID != 0 {
+		n += 1 + sovWire(uint64(m.RequestID))
+	}
+	l = len(m.RemoteAddress)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	l = len(m.TraceID)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.IsResponse {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.PayloadType != 0 {
+		n += 1 + sovWire(uint64(m.PayloadType))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *PulseSenderConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PulseNumber != 0 {
+		n += 1 + sovWire(uint64(m.PulseNumber))
+	}
+	l = len(m.ChosenPublicKey)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	l = len(m.Entropy)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *Pulse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PulseNumber != 0 {
+		n += 1 + sovWire(uint64(m.PulseNumber))
+	}
+	if m.PrevPulseNumber != 0 {
+		n += 1 + sovWire(uint64(m.PrevPulseNumber))
+	}
+	if m.NextPulseNumber != 0 {
+		n += 1 + sovWire(uint64(m.NextPulseNumber))
+	}
+	if m.PulseTimestamp != 0 {
+		n += 1 + sovWire(uint64(m.PulseTimestamp))
+	}
+	if m.EpochPulseNumber != 0 {
+		n += 1 + sovWire(uint64(m.EpochPulseNumber))
+	}
+	l = len(m.OriginID)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	l = len(m.Entropy)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if len(m.Signs) > 0 {
+		for k, v := range m.Signs {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovWire(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovWire(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovWire(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *RequestPulse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pulse != nil {
+		l = m.Pulse.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *ResponsePulse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *RequestRPC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			l = len(b)
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResponseRPC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *Cascade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeIds) > 0 {
+		for _, e := range m.NodeIds {
+			l = e.Size()
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	l = len(m.Entropy)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.ReplicationFactor != 0 {
+		n += 1 + sovWire(uint64(m.ReplicationFactor))
+	}
+	return n
+}
+
+func (m *RequestCascade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraceID)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.RPC != nil {
+		l = m.RPC.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.Cascade != nil {
+		l = m.Cascade.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *ResponseCascade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *NodeBootstrapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JoinClaim)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.LastNodePulse != 0 {
+		n += 1 + sovWire(uint64(m.LastNodePulse))
+	}
+	return n
+}
+
+func (m *NodeBootstrapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovWire(uint64(m.Code))
+	}
+	l = len(m.RejectReason)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.ETA != 0 {
+		n += 1 + sovWire(uint64(m.ETA))
+	}
+	if m.AssignShortID != 0 {
+		n += 1 + sovWire(uint64(m.AssignShortID))
+	}
+	if m.UpdateSincePulse != 0 {
+		n += 1 + sovWire(uint64(m.UpdateSincePulse))
+	}
+	l = len(m.RedirectHost)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.NetworkSize != 0 {
+		n += 1 + sovWire(uint64(m.NetworkSize))
+	}
+	return n
+}
+
+func (m *Node) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ID.Size()
+	n += 1 + l + sovWire(uint64(l))
+	if m.SID != 0 {
+		n += 1 + sovWire(uint64(m.SID))
+	}
+	if m.Role != 0 {
+		n += 1 + sovWire(uint64(m.Role))
+	}
+	l = len(m.PK)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastPulse != 0 {
+		n += 1 + sovWire(uint64(m.LastPulse))
+	}
+	if m.Discovery != nil {
+		l = m.Discovery.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *StartSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StartSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovWire(uint64(m.SessionID))
+	}
+	return n
+}
+
+func (m *AuthorizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.Protocol != 0 {
+		n += 1 + sovWire(uint64(m.Protocol))
+	}
+	return n
+}
+
+func (m *AuthorizationData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovWire(uint64(m.SessionID))
+	}
+	if m.AssignShortID != 0 {
+		n += 1 + sovWire(uint64(m.AssignShortID))
+	}
+	return n
+}
+
+func (m *AuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovWire(uint64(m.Code))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.Protocol != 0 {
+		n += 1 + sovWire(uint64(m.Protocol))
+	}
+	return n
+}
+
+func (m *RegistrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovWire(uint64(m.SessionID))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	l = len(m.JoinClaim)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *RegistrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovWire(uint64(m.Code))
+	}
+	if m.RetryIn != 0 {
+		n += 1 + sovWire(uint64(m.RetryIn))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func sovWire(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozWire(x uint64) (n int) {
+	return sovWire(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Host) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Host{`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`ShortID:` + fmt.Sprintf("%v", this.ShortID) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Envelope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Envelope{`,
+		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "Host", "Host", 1) + `,`,
+		`Receiver:` + strings.Replace(fmt.Sprintf("%v", this.Receiver), "Host", "Host", 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`RequestID:` + fmt.Sprintf("%v", this.RequestID) + `,`,
+		`RemoteAddress:` + fmt.Sprintf("%v", this.RemoteAddress) + `,`,
+		`TraceID:` + fmt.Sprintf("%v", this.TraceID) + `,`,
+		`IsResponse:` + fmt.Sprintf("%v", this.IsResponse) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`PayloadType:` + fmt.Sprintf("%v", this.PayloadType) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PulseSenderConfirmation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PulseSenderConfirmation{`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`ChosenPublicKey:` + fmt.Sprintf("%v", this.ChosenPublicKey) + `,`,
+		`Entropy:` + fmt.Sprintf("%v", this.Entropy) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Pulse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSigns := make([]string, 0, len(this.Signs))
+	for k, _ := range this.Signs {
+		keysForSigns = append(keysForSigns, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSigns)
+	mapStringForSigns := "map[string]*PulseSenderConfirmation{"
+	for _, k := range keysForSigns {
+		mapStringForSigns += fmt.Sprintf("%v: %v,", k, this.Signs[k])
+	}
+	mapStringForSigns += "}"
+	s := strings.Join([]string{`&Pulse{`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`PrevPulseNumber:` + fmt.Sprintf("%v", this.PrevPulseNumber) + `,`,
+		`NextPulseNumber:` + fmt.Sprintf("%v", this.NextPulseNumber) + `,`,
+		`PulseTimestamp:` + fmt.Sprintf("%v", this.PulseTimestamp) + `,`,
+		`EpochPulseNumber:` + fmt.Sprintf("%v", this.EpochPulseNumber) + `,`,
+		`OriginID:` + fmt.Sprintf("%v", this.OriginID) + `,`,
+		`Entropy:` + fmt.Sprintf("%v", this.Entropy) + `,`,
+		`Signs:` + mapStringForSigns + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RequestPulse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RequestPulse{`,
+		`Pulse:` + strings.Replace(fmt.Sprintf("%v", this.Pulse), "Pulse", "Pulse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResponsePulse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResponsePulse{`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RequestRPC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RequestRPC{`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResponseRPC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResponseRPC{`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Cascade) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Cascade{`,
+		`NodeIds:` + fmt.Sprintf("%v", this.NodeIds) + `,`,
+		`Entropy:` + fmt.Sprintf("%v", this.Entropy) + `,`,
+		`ReplicationFactor:` + fmt.Sprintf("%v", this.ReplicationFactor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RequestCascade) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RequestCascade{`,
+		`TraceID:` + fmt.Sprintf("%v", this.TraceID) + `,`,
+		`RPC:` + strings.Replace(fmt.Sprintf("%v", this.RPC), "RequestRPC", "RequestRPC", 1) + `,`,
+		`Cascade:` + strings.Replace(fmt.Sprintf("%v", this.Cascade), "Cascade", "Cascade", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResponseCascade) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResponseCascade{`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeBootstrapRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeBootstrapRequest{`,
+		`JoinClaim:` + fmt.Sprintf("%v", this.JoinClaim) + `,`,
+		`LastNodePulse:` + fmt.Sprintf("%v", this.LastNodePulse) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeBootstrapResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeBootstrapResponse{`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`RejectReason:` + fmt.Sprintf("%v", this.RejectReason) + `,`,
+		`ETA:` + fmt.Sprintf("%v", this.ETA) + `,`,
+		`AssignShortID:` + fmt.Sprintf("%v", this.AssignShortID) + `,`,
+		`UpdateSincePulse:` + fmt.Sprintf("%v", this.UpdateSincePulse) + `,`,
+		`RedirectHost:` + fmt.Sprintf("%v", this.RedirectHost) + `,`,
+		`NetworkSize:` + fmt.Sprintf("%v", this.NetworkSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Node) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Node{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`SID:` + fmt.Sprintf("%v", this.SID) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`PK:` + fmt.Sprintf("%v", this.PK) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GenesisRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GenesisRequest{`,
+		`LastPulse:` + fmt.Sprintf("%v", this.LastPulse) + `,`,
+		`Discovery:` + strings.Replace(fmt.Sprintf("%v", this.Discovery), "Node", "Node", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GenesisResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GenesisResponse{`,
+		`Response:` + strings.Replace(fmt.Sprintf("%v", this.Response), "GenesisRequest", "GenesisRequest", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartSessionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartSessionRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartSessionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartSessionResponse{`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthorizationRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthorizationRequest{`,
+		`Certificate:` + fmt.Sprintf("%v", this.Certificate) + `,`,
+		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthorizationData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthorizationData{`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`AssignShortID:` + fmt.Sprintf("%v", this.AssignShortID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuthorizationResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuthorizationResponse{`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Data:` + strings.Replace(fmt.Sprintf("%v", this.Data), "AuthorizationData", "AuthorizationData", 1) + `,`,
+		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegistrationRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegistrationRequest{`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`JoinClaim:` + fmt.Sprintf("%v", this.JoinClaim) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegistrationResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegistrationResponse{`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`RetryIn:` + fmt.Sprintf("%v", this.RetryIn) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringWire(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Host) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Host: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Host: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NodeID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortID", wireType)
+			}
+			m.ShortID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Envelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Envelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Envelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sender == nil {
+				m.Sender = &Host{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receiver == nil {
+				m.Receiver = &Host{}
+			}
+			if err := m.Receiver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsResponse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsResponse = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadType", wireType)
+			}
+			m.PayloadType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PulseSenderConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PulseSenderConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PulseSenderConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
+			}
+			m.PulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChosenPublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChosenPublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entropy = append(m.Entropy[:0], dAtA[iNdEx:postIndex]...)
+			if m.Entropy == nil {
+				m.Entropy = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pulse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pulse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pulse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
+			}
+			m.PulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevPulseNumber", wireType)
+			}
+			m.PrevPulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevPulseNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPulseNumber", wireType)
+			}
+			m.NextPulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPulseNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseTimestamp", wireType)
+			}
+			m.PulseTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochPulseNumber", wireType)
+			}
+			m.EpochPulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochPulseNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginID = append(m.OriginID[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginID == nil {
+				m.OriginID = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entropy = append(m.Entropy[:0], dAtA[iNdEx:postIndex]...)
+			if m.Entropy == nil {
+				m.Entropy = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signs == nil {
+				m.Signs = make(map[string]*PulseSenderConfirmation)
+			}
+			var mapkey string
+			var mapvalue *PulseSenderConfirmation
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWire
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWire
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthWire
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthWire
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWire
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthWire
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthWire
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PulseSenderConfirmation{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipWire(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthWire
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Signs[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestPulse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestPulse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestPulse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pulse == nil {
+				m.Pulse = &Pulse{}
+			}
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponsePulse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponsePulse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponsePulse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestRPC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestRPC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestRPC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
+			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseRPC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseRPC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseRPC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cascade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cascade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cascade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.Reference
+			m.NodeIds = append(m.NodeIds, v)
+			if err := m.NodeIds[len(m.NodeIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entropy = append(m.Entropy[:0], dAtA[iNdEx:postIndex]...)
+			if m.Entropy == nil {
+				m.Entropy = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
+			}
+			m.ReplicationFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationFactor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCascade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCascade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCascade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RPC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RPC == nil {
+				m.RPC = &RequestRPC{}
+			}
+			if err := m.RPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cascade == nil {
+				m.Cascade = &Cascade{}
+			}
+			if err := m.Cascade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseCascade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseCascade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseCascade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeBootstrapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeBootstrapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeBootstrapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinClaim = append(m.JoinClaim[:0], dAtA[iNdEx:postIndex]...)
+			if m.JoinClaim == nil {
+				m.JoinClaim = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNodePulse", wireType)
+			}
+			m.LastNodePulse = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastNodePulse |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeBootstrapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeBootstrapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeBootstrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ETA", wireType)
+			}
+			m.ETA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ETA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignShortID", wireType)
+			}
+			m.AssignShortID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssignShortID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateSincePulse", wireType)
+			}
+			m.UpdateSincePulse = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateSincePulse |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectHost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedirectHost = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkSize", wireType)
+			}
+			m.NetworkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Node) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SID", wireType)
+			}
+			m.SID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PK", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PK = append(m.PK[:0], dAtA[iNdEx:postIndex]...)
+			if m.PK == nil {
+				m.PK = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPulse", wireType)
+			}
+			m.LastPulse = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPulse |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Discovery == nil {
+				m.Discovery = &Node{}
+			}
+			if err := m.Discovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &GenesisRequest{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizationData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizationData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizationData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignShortID", wireType)
+			}
+			m.AssignShortID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssignShortID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &AuthorizationData{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistrationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinClaim = append(m.JoinClaim[:0], dAtA[iNdEx:postIndex]...)
+			if m.JoinClaim == nil {
+				m.JoinClaim = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryIn", wireType)
+			}
+			m.RetryIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryIn |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWire(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWire
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthWire
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowWire
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipWire(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthWire
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthWire = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWire   = fmt.Errorf("proto: integer overflow")
+)
@@ -106,7 +106,7 @@ func (e *entry) dial(ctx context.Context) (io.ReadWriteCloser, error) {
 	)
 	defer span.End()
 
-	conn, err := e.transport.Dial(ctx, e.host.Address.String())
+	conn, err := e.transport.Dial(ctx, e.host.Address.String(), e.host.NodeID)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ Open ] Failed to create TCP connection")
 	}
@@ -57,6 +57,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/transport"
 	"github.com/insolar/insolar/testutils/network"
@@ -81,7 +82,7 @@ func (fakeConnection) Close() error {
 
 func newTransportMock(t *testing.T) transport.StreamTransport {
 	tr := network.NewStreamTransportMock(t)
-	tr.DialMock.Set(func(p context.Context, p1 string) (r io.ReadWriteCloser, r1 error) {
+	tr.DialMock.Set(func(p context.Context, p1 string, p2 insolar.Reference) (r io.ReadWriteCloser, r1 error) {
 		return fakeConnection{}, nil
 	})
 	return tr
@@ -116,6 +116,8 @@ type HostNetwork interface {
 	NewRequestBuilder() RequestBuilder
 	// BuildResponse create response to an incoming request with Data set to responseData.
 	BuildResponse(ctx context.Context, request Request, responseData interface{}) Response
+	// SetProtocol stores packet protocol version agreed with a remote node.
+	SetProtocol(node insolar.Reference, version uint32)
 }
 
 // ConsensusPacketHandler callback function for consensus packets handling
@@ -97,6 +97,7 @@ type ServiceNetwork struct {
 	PulseManager        insolar.PulseManager        `inject:""`
 	PulseAccessor       pulse.Accessor              `inject:""`
 	CryptographyService insolar.CryptographyService `inject:""`
+	KeyStore            insolar.KeyStore            `inject:""`
 	NodeKeeper          network.NodeKeeper          `inject:""`
 	TerminationHandler  insolar.TerminationHandler  `inject:""`
 	GIL                 insolar.GlobalInsolarLock   `inject:""`
@@ -178,7 +179,7 @@ func (n *ServiceNetwork) Init(ctx context.Context) error {
 	n.cm.Inject(n,
 		&routing.Table{},
 		cert,
-		transport.NewFactory(n.cfg.Host.Transport),
+		transport.NewFactoryWithIdentity(n.cfg.Host.Transport, transport.NewIdentity(n.KeyStore, cert, n.NodeKeeper)),
 		hostNetwork,
 		// use flaky network instead of hostNetwork to imitate network delays
 		// NewFlakyNetwork(hostNetwork),
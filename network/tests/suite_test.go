@@ -74,6 +74,7 @@ import (
 	"github.com/insolar/insolar/cryptography"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/keystore"
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/consensus/packets"
@@ -493,6 +494,7 @@ func (s *testSuite) preInitNode(node *networkNode) {
 	node.componentManager.Register(terminationHandler, realKeeper, newPulseManagerMock(realKeeper.(network.NodeKeeper)), pubMock)
 
 	node.componentManager.Register(&amMock, certManager, cryptographyService, mblocker, GIL)
+	node.componentManager.Register(keystore.NewInplaceKeyStore(node.privateKey))
 	node.componentManager.Inject(serviceNetwork, keyProc, terminationHandler, transport.NewFakeFactory(cfg.Host.Transport),
 		testutils.NewMessageBusMock(t), testutils.NewContractRequesterMock(t))
 
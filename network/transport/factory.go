@@ -51,7 +51,7 @@
 package transport
 
 import (
-	"errors"
+	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/configuration"
 )
@@ -67,15 +67,31 @@ func NewFactory(cfg configuration.Transport) Factory {
 	return &factory{cfg: cfg}
 }
 
+// NewFactoryWithIdentity creates new transport factory, which is able to create TLS transport authenticated
+// by provided identity.
+func NewFactoryWithIdentity(cfg configuration.Transport, identity Identity) Factory {
+	return &factory{cfg: cfg, identity: identity}
+}
+
 type factory struct {
-	cfg configuration.Transport
+	cfg      configuration.Transport
+	identity Identity
 }
 
-// CreateStreamTransport creates new TCP transport
+// CreateStreamTransport creates new TCP or TLS transport
 func (f *factory) CreateStreamTransport(handler StreamHandler) (StreamTransport, error) {
 	switch f.cfg.Protocol {
 	case "TCP":
 		return newTCPTransport(f.cfg.Address, f.cfg.FixedPublicAddress, handler), nil
+	case "TLS":
+		if f.identity == nil {
+			return nil, errors.New("TLS transport requires node identity")
+		}
+		tlsConfig, err := newTLSConfig(f.identity)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create TLS config")
+		}
+		return newTLSTransport(f.cfg.Address, f.cfg.FixedPublicAddress, handler, tlsConfig, f.identity), nil
 	default:
 		return nil, errors.New("invalid transport configuration")
 	}
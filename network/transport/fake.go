@@ -60,6 +60,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/log"
 )
 
@@ -158,7 +159,7 @@ func (f *fakeStreamTransport) Stop(ctx context.Context) error {
 	return nil
 }
 
-func (f *fakeStreamTransport) Dial(ctx context.Context, address string) (io.ReadWriteCloser, error) {
+func (f *fakeStreamTransport) Dial(ctx context.Context, address string, _ insolar.Reference) (io.ReadWriteCloser, error) {
 	log.Debug("fakeStreamTransport Dial from %s to %s", f.address, address)
 
 	tcpMutex.RLock()
@@ -52,6 +52,7 @@ package transport
 
 import (
 	"context"
+	"crypto/tls"
 	"io"
 	"net"
 	"sync/atomic"
@@ -59,6 +60,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/network/hostnetwork/resolver"
 	"github.com/insolar/insolar/network/utils"
@@ -75,6 +77,9 @@ type tcpTransport struct {
 	fixedPublicAddress string
 	handler            StreamHandler
 	cancel             context.CancelFunc
+	// tlsConfig is set for TLS transport, streams are plain TCP connections otherwise.
+	tlsConfig *tls.Config
+	identity  Identity
 }
 
 func newTCPTransport(listenAddress, fixedPublicAddress string, handler StreamHandler) *tcpTransport {
@@ -85,11 +90,20 @@ func newTCPTransport(listenAddress, fixedPublicAddress string, handler StreamHan
 	}
 }
 
+func newTLSTransport(
+	listenAddress, fixedPublicAddress string, handler StreamHandler, cfg *tls.Config, id Identity,
+) *tcpTransport {
+	t := newTCPTransport(listenAddress, fixedPublicAddress, handler)
+	t.tlsConfig = cfg
+	t.identity = id
+	return t
+}
+
 func (t *tcpTransport) Address() string {
 	return t.address
 }
 
-func (t *tcpTransport) Dial(ctx context.Context, address string) (io.ReadWriteCloser, error) {
+func (t *tcpTransport) Dial(ctx context.Context, address string, node insolar.Reference) (io.ReadWriteCloser, error) {
 	logger := inslogger.FromContext(ctx).WithField("address", address)
 	tcpAddress, err := net.ResolveTCPAddr("tcp", address)
 	if err != nil {
@@ -104,7 +118,38 @@ func (t *tcpTransport) Dial(ctx context.Context, address string) (io.ReadWriteCl
 
 	setupConnection(ctx, conn)
 
-	return conn, nil
+	if t.tlsConfig == nil {
+		return conn, nil
+	}
+
+	tlsConn := tls.Client(conn, t.tlsConfig)
+	if err := handshake(tlsConn); err != nil {
+		logger.Error("[ Dial ] TLS handshake failed: ", err)
+		return nil, errors.Wrap(err, "[ Dial ] TLS handshake failed")
+	}
+	if err := t.checkDialed(tlsConn, node); err != nil {
+		logger.Error("[ Dial ] Failed to verify peer: ", err)
+		tlsConn.Close() // nolint: errcheck
+		return nil, errors.Wrap(err, "[ Dial ] Failed to verify peer")
+	}
+	return tlsConn, nil
+}
+
+// checkDialed checks that the server of dialed TLS connection is the expected node. Keys of known nodes are already
+// checked by verifyPeer during handshake, so a peer with unknown key passes only if the expected node is unknown too,
+// e.g. a joining node, that receives responses to its bootstrap requests.
+func (t *tcpTransport) checkDialed(conn *tls.Conn, node insolar.Reference) error {
+	if node.IsEmpty() {
+		return nil
+	}
+	peer, err := verifyPeer(t.identity, peerCertificates(conn))
+	if err != nil {
+		return err
+	}
+	if peer.Reference == nil || !peer.Reference.Equal(node) {
+		return errors.Errorf("peer isn't node %s", node)
+	}
+	return nil
 }
 
 // Start starts networking.
@@ -158,8 +203,50 @@ func (t *tcpTransport) listen(ctx context.Context) {
 		logger.Infof("[ listen ] Accepted new connection")
 		setupConnection(ctx, conn)
 
-		go t.handler.HandleStream(conn.RemoteAddr().String(), conn)
+		if t.tlsConfig == nil {
+			go t.handler.HandleStream(conn.RemoteAddr().String(), conn)
+			continue
+		}
+		go t.handleTLS(ctx, conn)
+	}
+}
+
+func (t *tcpTransport) handleTLS(ctx context.Context, conn *net.TCPConn) {
+	logger := inslogger.FromContext(ctx)
+	tlsConn := tls.Server(conn, t.tlsConfig)
+	if err := handshake(tlsConn); err != nil {
+		logger.Error("[ handleTLS ] TLS handshake failed: ", err)
+		return
+	}
+
+	peer, err := verifyPeer(t.identity, peerCertificates(tlsConn))
+	if err != nil {
+		logger.Error("[ handleTLS ] Failed to verify peer: ", err)
+		tlsConn.Close() // nolint: errcheck
+		return
+	}
+	t.handler.HandleStream(conn.RemoteAddr().String(), &tlsStream{Conn: tlsConn, peer: peer})
+}
+
+func peerCertificates(conn *tls.Conn) [][]byte {
+	var rawCerts [][]byte
+	for _, cert := range conn.ConnectionState().PeerCertificates {
+		rawCerts = append(rawCerts, cert.Raw)
+	}
+	return rawCerts
+}
+
+// handshake runs TLS handshake with a timeout and closes connection on failure.
+func handshake(conn *tls.Conn) error {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		conn.Close() // nolint: errcheck
+		return err
+	}
+	if err := conn.Handshake(); err != nil {
+		conn.Close() // nolint: errcheck
+		return err
 	}
+	return conn.SetDeadline(time.Time{})
 }
 
 // Stop stops networking.
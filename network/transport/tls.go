@@ -0,0 +1,257 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+package transport
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+const (
+	handshakeTimeout = 10 * time.Second
+	certificateTTL   = 10 * 365 * 24 * time.Hour
+	// pulsarCommonName is a common name of certificates of processes without node reference. Their keys are checked
+	// against keys of trusted pulsars.
+	pulsarCommonName = "pulsar"
+)
+
+// Identity provides keys of current node and its peers for mutually authenticated stream transport.
+type Identity interface {
+	// Reference returns reference of current node or nil if the process has no node reference (e.g. pulsar).
+	Reference() *insolar.Reference
+	// PrivateKey returns private key of current node.
+	PrivateKey() (crypto.PrivateKey, error)
+	// PeerKey returns public key of a known node or nil if the node is unknown.
+	PeerKey(ref insolar.Reference) crypto.PublicKey
+	// PulsarKeys returns public keys of trusted pulsars.
+	PulsarKeys() []crypto.PublicKey
+}
+
+// Peer is a remote side of TLS stream verified during handshake.
+type Peer struct {
+	// Reference is a node reference of the peer. It's nil for pulsars.
+	Reference *insolar.Reference
+	// Known is true if the key of the peer matches the key of a known node or of a trusted pulsar. Unknown peers are
+	// joining nodes, which are authorized at application level.
+	Known bool
+}
+
+// PeerOf returns the peer of TLS stream accepted by transport. It returns false for streams of plain TCP transport.
+func PeerOf(stream io.ReadWriteCloser) (Peer, bool) {
+	s, ok := stream.(*tlsStream)
+	if !ok {
+		return Peer{}, false
+	}
+	return s.peer, true
+}
+
+type tlsStream struct {
+	*tls.Conn
+	peer Peer
+}
+
+type identity struct {
+	keyStore insolar.KeyStore
+	cert     insolar.Certificate
+	nodes    insolar.NodeNetwork
+}
+
+// NewIdentity creates identity from node key store and certificate. Keys of peers are looked up among working nodes
+// and discovery nodes of the certificate. Certificate and nodes may be nil for processes without node reference.
+func NewIdentity(keyStore insolar.KeyStore, cert insolar.Certificate, nodes insolar.NodeNetwork) Identity {
+	return &identity{keyStore: keyStore, cert: cert, nodes: nodes}
+}
+
+func (i *identity) Reference() *insolar.Reference {
+	if i.cert == nil {
+		return nil
+	}
+	return i.cert.GetNodeRef()
+}
+
+func (i *identity) PrivateKey() (crypto.PrivateKey, error) {
+	return i.keyStore.GetPrivateKey("")
+}
+
+func (i *identity) PeerKey(ref insolar.Reference) crypto.PublicKey {
+	if i.nodes != nil {
+		if node := i.nodes.GetWorkingNode(ref); node != nil {
+			return node.PublicKey()
+		}
+	}
+	if i.cert != nil {
+		for _, discovery := range i.cert.GetDiscoveryNodes() {
+			if *discovery.GetNodeRef() == ref {
+				return discovery.GetPublicKey()
+			}
+		}
+	}
+	return nil
+}
+
+func (i *identity) PulsarKeys() []crypto.PublicKey {
+	if i.cert == nil {
+		return nil
+	}
+	return i.cert.GetPulsarPublicKeys()
+}
+
+// newTLSConfig creates TLS config with a self-signed certificate derived from node key. Node reference is stored
+// in common name of the certificate, pulsars use pulsarCommonName. Both sides of a connection present certificates
+// and a peer is rejected if it has no common name, if it claims a reference of a known node and its key doesn't match
+// the known one or if it claims to be a pulsar and its key isn't trusted. Peers with unknown references are accepted,
+// since joining nodes are authorized at application level during bootstrap. Packets of such peers are restricted
+// by host network, see PeerOf. Dialed peers must also have the reference of the node, that is dialed.
+func newTLSConfig(id Identity) (*tls.Config, error) {
+	key, err := id.PrivateKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get node private key")
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("node private key can't be used for signing certificates")
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate certificate serial number")
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(certificateTTL),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	template.Subject = pkix.Name{CommonName: pulsarCommonName}
+	if ref := id.Reference(); ref != nil {
+		template.Subject = pkix.Name{CommonName: ref.String()}
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create node certificate")
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{raw}, PrivateKey: key}},
+		ClientAuth:   tls.RequireAnyClientCert,
+		// Nodes don't have a certificate authority, peer certificates are checked by verifyPeer.
+		InsecureSkipVerify: true, // nolint: gosec
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			_, err := verifyPeer(id, rawCerts)
+			return err
+		},
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
+func verifyPeer(id Identity, rawCerts [][]byte) (Peer, error) {
+	if len(rawCerts) == 0 {
+		return Peer{}, errors.New("peer didn't present a certificate")
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return Peer{}, errors.Wrap(err, "failed to parse peer certificate")
+	}
+	err = cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature)
+	if err != nil {
+		return Peer{}, errors.Wrap(err, "peer certificate is not signed by its key")
+	}
+	peerKey, err := x509.MarshalPKIXPublicKey(cert.PublicKey)
+	if err != nil {
+		return Peer{}, errors.Wrap(err, "failed to export peer public key")
+	}
+
+	switch cert.Subject.CommonName {
+	case "":
+		return Peer{}, errors.New("peer certificate has no common name")
+	case pulsarCommonName:
+		for _, known := range id.PulsarKeys() {
+			if sameKey(peerKey, known) {
+				return Peer{Known: true}, nil
+			}
+		}
+		return Peer{}, errors.New("peer key doesn't match keys of trusted pulsars")
+	}
+
+	ref, err := insolar.NewReferenceFromBase58(cert.Subject.CommonName)
+	if err != nil {
+		return Peer{}, errors.Wrap(err, "failed to parse node reference of peer certificate")
+	}
+	known := id.PeerKey(*ref)
+	if known == nil {
+		return Peer{Reference: ref}, nil
+	}
+	if !sameKey(peerKey, known) {
+		return Peer{}, errors.Errorf("peer key doesn't match known certificate of node %s", ref)
+	}
+	return Peer{Reference: ref, Known: true}, nil
+}
+
+// sameKey returns true if known key is equal to peer key exported in PKIX form.
+func sameKey(peerKey []byte, known crypto.PublicKey) bool {
+	knownKey, err := x509.MarshalPKIXPublicKey(known)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(peerKey, knownKey)
+}
@@ -0,0 +1,213 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+package transport
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+)
+
+type testIdentity struct {
+	ref     insolar.Reference
+	key     *ecdsa.PrivateKey
+	peers   map[insolar.Reference]crypto.PublicKey
+	pulsars []crypto.PublicKey
+}
+
+func newTestIdentity(t *testing.T) *testIdentity {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &testIdentity{ref: gen.Reference(), key: key, peers: map[insolar.Reference]crypto.PublicKey{}}
+}
+
+func (i *testIdentity) Reference() *insolar.Reference {
+	return &i.ref
+}
+
+func (i *testIdentity) PrivateKey() (crypto.PrivateKey, error) {
+	return i.key, nil
+}
+
+func (i *testIdentity) PeerKey(ref insolar.Reference) crypto.PublicKey {
+	return i.peers[ref]
+}
+
+func (i *testIdentity) PulsarKeys() []crypto.PublicKey {
+	return i.pulsars
+}
+
+// testCert creates self-signed certificate of the key with provided common name.
+func testCert(t *testing.T, key *ecdsa.PrivateKey, commonName string) [][]byte {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	return [][]byte{raw}
+}
+
+func TestVerifyPeer(t *testing.T) {
+	id, peer, pulsar := newTestIdentity(t), newTestIdentity(t), newTestIdentity(t)
+
+	_, err := verifyPeer(id, testCert(t, peer.key, ""))
+	require.Error(t, err, "common name is required")
+
+	verified, err := verifyPeer(id, testCert(t, peer.key, peer.ref.String()))
+	require.NoError(t, err)
+	require.Equal(t, Peer{Reference: &peer.ref}, verified, "unknown node")
+
+	id.peers[peer.ref] = peer.key.Public()
+	verified, err = verifyPeer(id, testCert(t, peer.key, peer.ref.String()))
+	require.NoError(t, err)
+	require.Equal(t, Peer{Reference: &peer.ref, Known: true}, verified)
+	_, err = verifyPeer(id, testCert(t, pulsar.key, peer.ref.String()))
+	require.Error(t, err, "key doesn't match known node")
+
+	_, err = verifyPeer(id, testCert(t, pulsar.key, pulsarCommonName))
+	require.Error(t, err, "pulsar is not trusted")
+	id.pulsars = append(id.pulsars, pulsar.key.Public())
+	verified, err = verifyPeer(id, testCert(t, pulsar.key, pulsarCommonName))
+	require.NoError(t, err)
+	require.Equal(t, Peer{Known: true}, verified)
+}
+
+func TestPeerOf(t *testing.T) {
+	conn, other := net.Pipe()
+	defer conn.Close()  // nolint: errcheck
+	defer other.Close() // nolint: errcheck
+
+	_, ok := PeerOf(conn)
+	require.False(t, ok, "plain stream")
+
+	ref := gen.Reference()
+	peer, ok := PeerOf(&tlsStream{peer: Peer{Reference: &ref}})
+	require.True(t, ok)
+	require.Equal(t, &ref, peer.Reference)
+}
+
+func TestTLSTransport(t *testing.T) {
+	id1, id2 := newTestIdentity(t), newTestIdentity(t)
+	id1.peers[id2.ref] = id2.key.Public()
+	id2.peers[id1.ref] = id1.key.Public()
+
+	cfg1 := configuration.Transport{Protocol: "TLS", Address: "127.0.0.1:0"}
+	cfg2 := configuration.Transport{Protocol: "TLS", Address: "127.0.0.1:0"}
+
+	f1 := NewFactoryWithIdentity(cfg1, id1)
+	f2 := NewFactoryWithIdentity(cfg2, id2)
+	suite.Run(t, &suiteTest{factory1: f1, factory2: f2})
+}
+
+func TestTLSTransport_RejectsImpostor(t *testing.T) {
+	ctx := context.Background()
+	id1, id2 := newTestIdentity(t), newTestIdentity(t)
+	impostor := newTestIdentity(t)
+	// Impostor claims reference of the second node, which is known to the first one.
+	impostor.ref = id2.ref
+	id1.peers[id2.ref] = id2.key.Public()
+
+	n1 := newFakeNode(NewFactoryWithIdentity(configuration.Transport{Protocol: "TLS", Address: "127.0.0.1:0"}, id1))
+	n2 := newFakeNode(NewFactoryWithIdentity(configuration.Transport{Protocol: "TLS", Address: "127.0.0.1:0"}, impostor))
+	require.NoError(t, n1.Start(ctx))
+	require.NoError(t, n2.Start(ctx))
+	defer n1.Stop(ctx) // nolint: errcheck
+	defer n2.Stop(ctx) // nolint: errcheck
+
+	_, err := n1.tcp.Dial(ctx, n2.tcp.Address(), insolar.Reference{})
+	require.Error(t, err)
+}
+
+func TestTLSTransport_ChecksDialedNode(t *testing.T) {
+	ctx := context.Background()
+	id1, id2 := newTestIdentity(t), newTestIdentity(t)
+	// The second node is unknown to the first one, so only its reference is checked.
+	n1 := newFakeNode(NewFactoryWithIdentity(configuration.Transport{Protocol: "TLS", Address: "127.0.0.1:0"}, id1))
+	n2 := newFakeNode(NewFactoryWithIdentity(configuration.Transport{Protocol: "TLS", Address: "127.0.0.1:0"}, id2))
+	require.NoError(t, n1.Start(ctx))
+	require.NoError(t, n2.Start(ctx))
+	defer n1.Stop(ctx) // nolint: errcheck
+	defer n2.Stop(ctx) // nolint: errcheck
+
+	_, err := n1.tcp.Dial(ctx, n2.tcp.Address(), gen.Reference())
+	require.Error(t, err)
+
+	conn, err := n1.tcp.Dial(ctx, n2.tcp.Address(), id2.ref)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	conn, err = n1.tcp.Dial(ctx, n2.tcp.Address(), insolar.Reference{})
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+}
+
+func TestFactory_TLSRequiresIdentity(t *testing.T) {
+	f := NewFactory(configuration.Transport{Protocol: "TLS", Address: "127.0.0.1:0"})
+	_, err := f.CreateStreamTransport(&fakeNode{})
+	require.Error(t, err)
+}
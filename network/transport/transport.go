@@ -55,6 +55,7 @@ import (
 	"io"
 
 	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/insolar"
 )
 
 // DatagramHandler interface provides callback method to process received datagrams
@@ -83,6 +84,8 @@ type StreamTransport interface {
 	component.Starter
 	component.Stopper
 
-	Dial(ctx context.Context, address string) (io.ReadWriteCloser, error)
+	// Dial opens stream to a node at the address. If node reference isn't empty, the transport checks that the remote
+	// side is this node. Empty reference is used to reach nodes by address only, e.g. discovery nodes during bootstrap.
+	Dial(ctx context.Context, address string, node insolar.Reference) (io.ReadWriteCloser, error)
 	Address() string
 }
@@ -60,6 +60,7 @@ import (
 
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
 )
 
 type suiteTest struct {
@@ -135,13 +136,13 @@ func (s *suiteTest) TestStreamTransport() {
 	s.NoError(n1.Start(ctx))
 	s.NoError(n2.Start(ctx))
 
-	_, err := n2.tcp.Dial(ctx, "127.0.0.1:5555")
+	_, err := n2.tcp.Dial(ctx, "127.0.0.1:5555", insolar.Reference{})
 	s.Error(err)
 
-	_, err = n2.tcp.Dial(ctx, "invalid address")
+	_, err = n2.tcp.Dial(ctx, "invalid address", insolar.Reference{})
 	s.Error(err)
 
-	conn, err := n1.tcp.Dial(ctx, n2.tcp.Address())
+	conn, err := n1.tcp.Dial(ctx, n2.tcp.Address(), insolar.Reference{})
 	s.NoError(err)
 
 	count, err := conn.Write([]byte{1, 2, 3})
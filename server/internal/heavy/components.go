@@ -47,9 +47,12 @@ import (
 	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/keystore"
 	"github.com/insolar/insolar/ledger/blob"
-	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/backup"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
 	"github.com/insolar/insolar/ledger/heavy/handler"
+	"github.com/insolar/insolar/ledger/heavy/pruning"
 	"github.com/insolar/insolar/ledger/heavy/pulsemanager"
+	"github.com/insolar/insolar/ledger/migration"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/insolar/insolar/logicrunner/artifacts"
 	"github.com/insolar/insolar/messagebus"
@@ -74,6 +77,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		CryptoScheme  insolar.PlatformCryptographyScheme
 		CryptoService insolar.CryptographyService
 		CertManager   insolar.CertificateManager
+		KeyStore      insolar.KeyStore
 	)
 	{
 		var err error
@@ -91,6 +95,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 
 		c := component.Manager{}
 		c.Inject(CryptoService, CryptoScheme, KeyProcessor, ks)
+		KeyStore = ks
 
 		publicKey, err := CryptoService.GetPublicKey()
 		if err != nil {
@@ -140,7 +145,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 	var (
 		Requester insolar.ContractRequester
 		Genesis   insolar.GenesisDataProvider
-		API       insolar.APIRunner
+		API       *api.Runner
 	)
 	{
 		var err error
@@ -174,11 +179,15 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		if err != nil {
 			panic(errors.Wrap(err, "failed to initialize DB"))
 		}
+		err = migration.Default.Migrate(ctx, DB)
+		if err != nil {
+			panic(errors.Wrap(err, "failed to migrate DB"))
+		}
 		Nodes = node.NewStorage()
 		Pulses = pulse.NewDB(DB)
 		Jets = jet.NewStore()
 
-		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit)
+		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit, cfg.Ledger.HeavyReplicaCount)
 		c.PulseCalculator = Pulses
 		c.PulseAccessor = Pulses
 		c.JetAccessor = Jets
@@ -227,7 +236,6 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		records := object.NewRecordDB(DB)
 		indexes := object.NewIndexDB(DB)
 		blobs := blob.NewDB(DB)
-		drops := drop.NewDB(DB)
 
 		pm := pulsemanager.NewPulseManager()
 		pm.Bus = Bus
@@ -235,18 +243,42 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		pm.NodeSetter = Nodes
 		pm.Nodes = Nodes
 		pm.PulseAppender = pulses
+		pm.PulseAccessor = pulses
+
+		blobCodec, err := blob.ParseCodec(cfg.Ledger.Storage.BlobCompression)
+		if err != nil {
+			return nil, err
+		}
+
+		syncer, err := handler.NewHistorySyncer(DB, Bus, Coordinator, Nodes, CryptoScheme, pulses, blobCodec)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to start HistorySyncer")
+		}
+		pm.HistorySyncer = syncer
+		if cfg.Ledger.Pruning.Depth > 0 {
+			pruner, err := pruning.NewPruner(DB, pulses, cfg.Ledger.Pruning.Depth, cfg.Ledger.LightChainLimit)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to start Pruner")
+			}
+			pm.Pruner = pruner
+		}
+
+		API.Exporter = exporter.NewExporter(DB, pulses, pulses, CryptoScheme, cfg.Ledger.Exporter)
+		if cfg.Ledger.Backup.Directory != "" {
+			API.Backuper = backup.NewBackuper(DB, cfg.Ledger.Backup.Directory)
+		}
 
 		h := handler.New()
 		h.RecordAccessor = records
-		h.RecordModifier = records
 		h.JetCoordinator = Coordinator
 		h.IndexLifelineAccessor = indexes
-		h.IndexBucketModifier = indexes
+		h.ChildIndexAccessor = object.NewChildIndexDB(DB)
 		h.Bus = Bus
 		h.BlobAccessor = blobs
-		h.BlobModifier = blobs
-		h.DropModifier = drops
+		h.PulseCalculator = pulses
+		h.DB = DB
 		h.PCS = CryptoScheme
+		h.BlobCodec = blobCodec
 
 		PulseManager = pm
 		Handler = h
@@ -282,6 +314,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		Genesis,
 		API,
 		KeyProcessor,
+		KeyStore,
 		Termination,
 		CryptoScheme,
 		CryptoService,
@@ -47,6 +47,7 @@ import (
 	"github.com/insolar/insolar/ledger/light/pulsemanager"
 	"github.com/insolar/insolar/ledger/light/recentstorage"
 	"github.com/insolar/insolar/ledger/light/replication"
+	"github.com/insolar/insolar/ledger/light/wal"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/logicrunner/artifacts"
@@ -71,6 +72,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		CryptoScheme  insolar.PlatformCryptographyScheme
 		CryptoService insolar.CryptographyService
 		CertManager   insolar.CertificateManager
+		KeyStore      insolar.KeyStore
 	)
 	{
 		var err error
@@ -88,6 +90,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 
 		c := component.Manager{}
 		c.Inject(CryptoService, CryptoScheme, KeyProcessor, ks)
+		KeyStore = ks
 
 		publicKey, err := CryptoService.GetPublicKey()
 		if err != nil {
@@ -169,7 +172,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		Pulses = pulse.NewStorageMem()
 		Jets = jet.NewStore()
 
-		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit)
+		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit, cfg.Ledger.HeavyReplicaCount)
 		c.PulseCalculator = Pulses
 		c.PulseAccessor = Pulses
 		c.JetAccessor = Jets
@@ -217,9 +220,15 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		conf := cfg.Ledger
 		idLocker := object.NewIDLocker()
 		drops := drop.NewStorageMemory()
-		blobs := blob.NewStorageMemory()
-		records := object.NewRecordMemory()
-		indexes := object.NewInMemoryIndex()
+		blobs, records, indexes, err := newHotStorages(ctx, conf.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		walLog, err := newWriteAheadLog(conf.Storage)
+		if err != nil {
+			return nil, err
+		}
 
 		c := component.Manager{}
 		c.Inject(CryptoScheme)
@@ -227,27 +236,51 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		hots := recentstorage.NewRecentStorageProvider()
 		waiter := hot.NewChannelWaiter()
 
-		handler := artifactmanager.NewMessageHandler(indexes, indexes, indexes, &conf)
-		handler.RecentStorageProvider = hots
+		// Modifiers of hot data. They are wrapped by write-ahead log if it's enabled.
+		var (
+			recordModifier object.RecordModifier        = records
+			blobStore      blob.Storage                 = blobs
+			lifelines      object.LifelineIndex         = indexes
+			buckets        object.IndexBucketModifier   = indexes
+			states         object.LifelineStateModifier = indexes
+			dropModifier   drop.Modifier                = drops
+			pulseAppender  pulse.Appender               = Pulses
+			nodeModifier   node.Modifier                = Nodes
+			pendings       recentstorage.Provider       = hots
+		)
+		if walLog != nil {
+			recordModifier = walLog.RecordModifier(records)
+			blobStore = walLog.BlobStorage(blobs)
+			lifelines = walLog.LifelineIndex(indexes)
+			buckets = walLog.IndexBucketModifier(indexes)
+			states = walLog.LifelineStateModifier(indexes)
+			dropModifier = walLog.DropModifier(drops)
+			pulseAppender = walLog.PulseAppender(Pulses)
+			nodeModifier = walLog.NodeModifier(Nodes)
+			pendings = walLog.PendingProvider(hots)
+		}
+
+		handler := artifactmanager.NewMessageHandler(lifelines, buckets, states, &conf)
+		handler.RecentStorageProvider = pendings
 		handler.Bus = Bus
 		handler.PCS = CryptoScheme
 		handler.JetCoordinator = Coordinator
 		handler.CryptographyService = CryptoService
 		handler.DelegationTokenFactory = Tokens
 		handler.JetStorage = Jets
-		handler.DropModifier = drops
-		handler.BlobModifier = blobs
+		handler.DropModifier = dropModifier
+		handler.BlobModifier = blobStore
 		handler.BlobAccessor = blobs
-		handler.Blobs = blobs
+		handler.Blobs = blobStore
 		handler.IDLocker = idLocker
-		handler.RecordModifier = records
+		handler.RecordModifier = recordModifier
 		handler.RecordAccessor = records
 		handler.Nodes = Nodes
 		handler.HotDataWaiter = waiter
 		handler.JetReleaser = waiter
 
 		jetCalculator := jet.NewCalculator(Coordinator, Jets)
-		var lightCleaner = replication.NewCleaner(
+		var lightCleaner replication.Cleaner = replication.NewCleaner(
 			Jets,
 			Nodes,
 			drops,
@@ -258,13 +291,18 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 			Pulses,
 			conf.LightChainLimit,
 		)
+		if walLog != nil {
+			lightCleaner = walLog.Cleaner(lightCleaner)
+		}
 		dataGatherer := replication.NewDataGatherer(drops, blobs, records, indexes)
 		lthSyncer := replication.NewReplicatorDefault(
 			jetCalculator,
 			dataGatherer,
 			lightCleaner,
 			Bus,
+			Coordinator,
 			Pulses,
+			conf.HeavySyncBackoff,
 		)
 
 		pm := pulsemanager.NewPulseManager(
@@ -284,18 +322,39 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		pm.JetCoordinator = Coordinator
 		pm.CryptographyService = CryptoService
 		pm.PlatformCryptographyScheme = CryptoScheme
-		pm.RecentStorageProvider = hots
+		pm.RecentStorageProvider = pendings
 		pm.JetReleaser = waiter
 		pm.JetAccessor = Jets
 		pm.JetModifier = Jets
-		pm.NodeSetter = Nodes
+		pm.NodeSetter = nodeModifier
 		pm.Nodes = Nodes
-		pm.DropModifier = drops
+		pm.DropModifier = dropModifier
 		pm.DropAccessor = drops
 		pm.DropCleaner = drops
 		pm.PulseAccessor = Pulses
 		pm.PulseCalculator = Pulses
-		pm.PulseAppender = Pulses
+		pm.PulseAppender = pulseAppender
+
+		if walLog != nil {
+			err := walLog.Replay(ctx, wal.Storages{
+				Records:    records,
+				Blobs:      blobs,
+				Lifelines:  indexes,
+				Buckets:    indexes,
+				IndexState: indexes,
+				Drops:      drops,
+				Pulses:     Pulses,
+				Nodes:      Nodes,
+				Jets:       Jets,
+				Pendings:   hots,
+			})
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to replay write-ahead log")
+			}
+			if err := lthSyncer.RestoreConfirmed(ctx, walLog); err != nil {
+				return nil, err
+			}
+		}
 
 		PulseManager = pm
 		Handler = handler
@@ -317,6 +376,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		Genesis,
 		API,
 		KeyProcessor,
+		KeyStore,
 		Termination,
 		CryptoScheme,
 		CryptoService,
@@ -0,0 +1,86 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package light
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/light/wal"
+	"github.com/insolar/insolar/ledger/migration"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+type blobStorage interface {
+	blob.Storage
+	blob.CollectionAccessor
+	blob.Cleaner
+}
+
+type recordStorage interface {
+	object.RecordModifier
+	object.RecordAccessor
+	object.RecordCollectionAccessor
+	object.RecordCleaner
+}
+
+type indexStorage interface {
+	object.LifelineIndex
+	object.IndexBucketModifier
+	object.IndexBucketAccessor
+	object.LifelineStateModifier
+	object.IndexCleaner
+}
+
+// newHotStorages creates storages of hot data. Data is kept in memory unless HotDataOnDisk is set.
+func newHotStorages(ctx context.Context, conf configuration.Storage) (blobStorage, recordStorage, indexStorage, error) {
+	codec, err := blob.ParseCodec(conf.BlobCompression)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !conf.HotDataOnDisk {
+		return blob.NewCompressedStorageMemory(codec), object.NewRecordMemory(), object.NewInMemoryIndex(), nil
+	}
+
+	db, err := store.NewBadgerDB(conf.DataDirectory)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to open hot data storage")
+	}
+	err = migration.Default.Migrate(ctx, db)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to migrate hot data storage")
+	}
+	return blob.NewCompressedDBWithJetIndex(db, codec), object.NewRecordDBWithJetIndex(db), object.NewDiskIndex(db), nil
+}
+
+// newWriteAheadLog opens write-ahead log of hot data. Nil is returned if WriteAheadLog is not set.
+func newWriteAheadLog(conf configuration.Storage) (*wal.Log, error) {
+	if !conf.WriteAheadLog {
+		return nil, nil
+	}
+
+	db, err := store.NewBadgerDB(filepath.Join(conf.DataDirectory, "wal"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open write-ahead log")
+	}
+	return wal.NewLog(db), nil
+}
@@ -148,6 +148,7 @@ func initComponents(
 
 	apiRunner, err := api.NewRunner(&cfg.APIRunner)
 	checkError(ctx, err, "failed to start ApiRunner")
+	apiRunner.CallTracer = logicRunner
 
 	metricsHandler, err := metrics.NewMetrics(ctx, cfg.Metrics, metrics.GetInsolarRegistry("virtual"), "virtual")
 	checkError(ctx, err, "failed to start Metrics")
@@ -172,7 +173,7 @@ func initComponents(
 		pulsemanager.NewPulseManager(),
 	)
 
-	jc := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit)
+	jc := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit, cfg.Ledger.HeavyReplicaCount)
 	pulses := pulse.NewStorageMem()
 	b := bus.NewBus(pubsub, pulses, jc)
 
@@ -0,0 +1,266 @@
+package testutils
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "CallTracer" can be found in github.com/insolar/insolar/insolar
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//CallTracerMock implements github.com/insolar/insolar/insolar.CallTracer
+type CallTracerMock struct {
+	t minimock.Tester
+
+	CallTreeFunc       func(p context.Context, p1 string) (r []insolar.CallRecord, r1 error)
+	CallTreeCounter    uint64
+	CallTreePreCounter uint64
+	CallTreeMock       mCallTracerMockCallTree
+}
+
+//NewCallTracerMock returns a mock for github.com/insolar/insolar/insolar.CallTracer
+func NewCallTracerMock(t minimock.Tester) *CallTracerMock {
+	m := &CallTracerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CallTreeMock = mCallTracerMockCallTree{mock: m}
+
+	return m
+}
+
+type mCallTracerMockCallTree struct {
+	mock              *CallTracerMock
+	mainExpectation   *CallTracerMockCallTreeExpectation
+	expectationSeries []*CallTracerMockCallTreeExpectation
+}
+
+type CallTracerMockCallTreeExpectation struct {
+	input  *CallTracerMockCallTreeInput
+	result *CallTracerMockCallTreeResult
+}
+
+type CallTracerMockCallTreeInput struct {
+	p  context.Context
+	p1 string
+}
+
+type CallTracerMockCallTreeResult struct {
+	r  []insolar.CallRecord
+	r1 error
+}
+
+//Expect specifies that invocation of CallTracer.CallTree is expected from 1 to Infinity times
+func (m *mCallTracerMockCallTree) Expect(p context.Context, p1 string) *mCallTracerMockCallTree {
+	m.mock.CallTreeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CallTracerMockCallTreeExpectation{}
+	}
+	m.mainExpectation.input = &CallTracerMockCallTreeInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of CallTracer.CallTree
+func (m *mCallTracerMockCallTree) Return(r []insolar.CallRecord, r1 error) *CallTracerMock {
+	m.mock.CallTreeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CallTracerMockCallTreeExpectation{}
+	}
+	m.mainExpectation.result = &CallTracerMockCallTreeResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of CallTracer.CallTree is expected once
+func (m *mCallTracerMockCallTree) ExpectOnce(p context.Context, p1 string) *CallTracerMockCallTreeExpectation {
+	m.mock.CallTreeFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &CallTracerMockCallTreeExpectation{}
+	expectation.input = &CallTracerMockCallTreeInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *CallTracerMockCallTreeExpectation) Return(r []insolar.CallRecord, r1 error) {
+	e.result = &CallTracerMockCallTreeResult{r, r1}
+}
+
+//Set uses given function f as a mock of CallTracer.CallTree method
+func (m *mCallTracerMockCallTree) Set(f func(p context.Context, p1 string) (r []insolar.CallRecord, r1 error)) *CallTracerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.CallTreeFunc = f
+	return m.mock
+}
+
+//CallTree implements github.com/insolar/insolar/insolar.CallTracer interface
+func (m *CallTracerMock) CallTree(p context.Context, p1 string) (r []insolar.CallRecord, r1 error) {
+	counter := atomic.AddUint64(&m.CallTreePreCounter, 1)
+	defer atomic.AddUint64(&m.CallTreeCounter, 1)
+
+	if len(m.CallTreeMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.CallTreeMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to CallTracerMock.CallTree. %v %v", p, p1)
+			return
+		}
+
+		input := m.CallTreeMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, CallTracerMockCallTreeInput{p, p1}, "CallTracer.CallTree got unexpected parameters")
+
+		result := m.CallTreeMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the CallTracerMock.CallTree")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.CallTreeMock.mainExpectation != nil {
+
+		input := m.CallTreeMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, CallTracerMockCallTreeInput{p, p1}, "CallTracer.CallTree got unexpected parameters")
+		}
+
+		result := m.CallTreeMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the CallTracerMock.CallTree")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.CallTreeFunc == nil {
+		m.t.Fatalf("Unexpected call to CallTracerMock.CallTree. %v %v", p, p1)
+		return
+	}
+
+	return m.CallTreeFunc(p, p1)
+}
+
+//CallTreeMinimockCounter returns a count of CallTracerMock.CallTreeFunc invocations
+func (m *CallTracerMock) CallTreeMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.CallTreeCounter)
+}
+
+//CallTreeMinimockPreCounter returns the value of CallTracerMock.CallTree invocations
+func (m *CallTracerMock) CallTreeMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.CallTreePreCounter)
+}
+
+//CallTreeFinished returns true if mock invocations count is ok
+func (m *CallTracerMock) CallTreeFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.CallTreeMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.CallTreeCounter) == uint64(len(m.CallTreeMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.CallTreeMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.CallTreeCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.CallTreeFunc != nil {
+		return atomic.LoadUint64(&m.CallTreeCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *CallTracerMock) ValidateCallCounters() {
+
+	if !m.CallTreeFinished() {
+		m.t.Fatal("Expected call to CallTracerMock.CallTree")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *CallTracerMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *CallTracerMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *CallTracerMock) MinimockFinish() {
+
+	if !m.CallTreeFinished() {
+		m.t.Fatal("Expected call to CallTracerMock.CallTree")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *CallTracerMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *CallTracerMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.CallTreeFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.CallTreeFinished() {
+				m.t.Error("Expected call to CallTracerMock.CallTree")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *CallTracerMock) AllMocksCalled() bool {
+
+	if !m.CallTreeFinished() {
+		return false
+	}
+
+	return true
+}
@@ -38,6 +38,11 @@ type CertificateMock struct {
 	GetPublicKeyPreCounter uint64
 	GetPublicKeyMock       mCertificateMockGetPublicKey
 
+	GetPulsarPublicKeysFunc       func() (r []crypto.PublicKey)
+	GetPulsarPublicKeysCounter    uint64
+	GetPulsarPublicKeysPreCounter uint64
+	GetPulsarPublicKeysMock       mCertificateMockGetPulsarPublicKeys
+
 	GetRoleFunc       func() (r insolar.StaticRole)
 	GetRoleCounter    uint64
 	GetRolePreCounter uint64
@@ -66,6 +71,7 @@ func NewCertificateMock(t minimock.Tester) *CertificateMock {
 	m.GetDiscoverySignsMock = mCertificateMockGetDiscoverySigns{mock: m}
 	m.GetNodeRefMock = mCertificateMockGetNodeRef{mock: m}
 	m.GetPublicKeyMock = mCertificateMockGetPublicKey{mock: m}
+	m.GetPulsarPublicKeysMock = mCertificateMockGetPulsarPublicKeys{mock: m}
 	m.GetRoleMock = mCertificateMockGetRole{mock: m}
 	m.GetRootDomainReferenceMock = mCertificateMockGetRootDomainReference{mock: m}
 	m.SerializeNodePartMock = mCertificateMockSerializeNodePart{mock: m}
@@ -609,6 +615,140 @@ func (m *CertificateMock) GetPublicKeyFinished() bool {
 	return true
 }
 
+type mCertificateMockGetPulsarPublicKeys struct {
+	mock              *CertificateMock
+	mainExpectation   *CertificateMockGetPulsarPublicKeysExpectation
+	expectationSeries []*CertificateMockGetPulsarPublicKeysExpectation
+}
+
+type CertificateMockGetPulsarPublicKeysExpectation struct {
+	result *CertificateMockGetPulsarPublicKeysResult
+}
+
+type CertificateMockGetPulsarPublicKeysResult struct {
+	r []crypto.PublicKey
+}
+
+//Expect specifies that invocation of Certificate.GetPulsarPublicKeys is expected from 1 to Infinity times
+func (m *mCertificateMockGetPulsarPublicKeys) Expect() *mCertificateMockGetPulsarPublicKeys {
+	m.mock.GetPulsarPublicKeysFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CertificateMockGetPulsarPublicKeysExpectation{}
+	}
+
+	return m
+}
+
+//Return specifies results of invocation of Certificate.GetPulsarPublicKeys
+func (m *mCertificateMockGetPulsarPublicKeys) Return(r []crypto.PublicKey) *CertificateMock {
+	m.mock.GetPulsarPublicKeysFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CertificateMockGetPulsarPublicKeysExpectation{}
+	}
+	m.mainExpectation.result = &CertificateMockGetPulsarPublicKeysResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Certificate.GetPulsarPublicKeys is expected once
+func (m *mCertificateMockGetPulsarPublicKeys) ExpectOnce() *CertificateMockGetPulsarPublicKeysExpectation {
+	m.mock.GetPulsarPublicKeysFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &CertificateMockGetPulsarPublicKeysExpectation{}
+
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *CertificateMockGetPulsarPublicKeysExpectation) Return(r []crypto.PublicKey) {
+	e.result = &CertificateMockGetPulsarPublicKeysResult{r}
+}
+
+//Set uses given function f as a mock of Certificate.GetPulsarPublicKeys method
+func (m *mCertificateMockGetPulsarPublicKeys) Set(f func() (r []crypto.PublicKey)) *CertificateMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetPulsarPublicKeysFunc = f
+	return m.mock
+}
+
+//GetPulsarPublicKeys implements github.com/insolar/insolar/insolar.Certificate interface
+func (m *CertificateMock) GetPulsarPublicKeys() (r []crypto.PublicKey) {
+	counter := atomic.AddUint64(&m.GetPulsarPublicKeysPreCounter, 1)
+	defer atomic.AddUint64(&m.GetPulsarPublicKeysCounter, 1)
+
+	if len(m.GetPulsarPublicKeysMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetPulsarPublicKeysMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to CertificateMock.GetPulsarPublicKeys.")
+			return
+		}
+
+		result := m.GetPulsarPublicKeysMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the CertificateMock.GetPulsarPublicKeys")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.GetPulsarPublicKeysMock.mainExpectation != nil {
+
+		result := m.GetPulsarPublicKeysMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the CertificateMock.GetPulsarPublicKeys")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.GetPulsarPublicKeysFunc == nil {
+		m.t.Fatalf("Unexpected call to CertificateMock.GetPulsarPublicKeys.")
+		return
+	}
+
+	return m.GetPulsarPublicKeysFunc()
+}
+
+//GetPulsarPublicKeysMinimockCounter returns a count of CertificateMock.GetPulsarPublicKeysFunc invocations
+func (m *CertificateMock) GetPulsarPublicKeysMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetPulsarPublicKeysCounter)
+}
+
+//GetPulsarPublicKeysMinimockPreCounter returns the value of CertificateMock.GetPulsarPublicKeys invocations
+func (m *CertificateMock) GetPulsarPublicKeysMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetPulsarPublicKeysPreCounter)
+}
+
+//GetPulsarPublicKeysFinished returns true if mock invocations count is ok
+func (m *CertificateMock) GetPulsarPublicKeysFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetPulsarPublicKeysMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetPulsarPublicKeysCounter) == uint64(len(m.GetPulsarPublicKeysMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetPulsarPublicKeysMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetPulsarPublicKeysCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetPulsarPublicKeysFunc != nil {
+		return atomic.LoadUint64(&m.GetPulsarPublicKeysCounter) > 0
+	}
+
+	return true
+}
+
 type mCertificateMockGetRole struct {
 	mock              *CertificateMock
 	mainExpectation   *CertificateMockGetRoleExpectation
@@ -1031,6 +1171,10 @@ func (m *CertificateMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to CertificateMock.GetPublicKey")
 	}
 
+	if !m.GetPulsarPublicKeysFinished() {
+		m.t.Fatal("Expected call to CertificateMock.GetPulsarPublicKeys")
+	}
+
 	if !m.GetRoleFinished() {
 		m.t.Fatal("Expected call to CertificateMock.GetRole")
 	}
@@ -1076,6 +1220,10 @@ func (m *CertificateMock) MinimockFinish() {
 		m.t.Fatal("Expected call to CertificateMock.GetPublicKey")
 	}
 
+	if !m.GetPulsarPublicKeysFinished() {
+		m.t.Fatal("Expected call to CertificateMock.GetPulsarPublicKeys")
+	}
+
 	if !m.GetRoleFinished() {
 		m.t.Fatal("Expected call to CertificateMock.GetRole")
 	}
@@ -1106,6 +1254,7 @@ func (m *CertificateMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.GetDiscoverySignsFinished()
 		ok = ok && m.GetNodeRefFinished()
 		ok = ok && m.GetPublicKeyFinished()
+		ok = ok && m.GetPulsarPublicKeysFinished()
 		ok = ok && m.GetRoleFinished()
 		ok = ok && m.GetRootDomainReferenceFinished()
 		ok = ok && m.SerializeNodePartFinished()
@@ -1133,6 +1282,10 @@ func (m *CertificateMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to CertificateMock.GetPublicKey")
 			}
 
+			if !m.GetPulsarPublicKeysFinished() {
+				m.t.Error("Expected call to CertificateMock.GetPulsarPublicKeys")
+			}
+
 			if !m.GetRoleFinished() {
 				m.t.Error("Expected call to CertificateMock.GetRole")
 			}
@@ -1173,6 +1326,10 @@ func (m *CertificateMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetPulsarPublicKeysFinished() {
+		return false
+	}
+
 	if !m.GetRoleFinished() {
 		return false
 	}
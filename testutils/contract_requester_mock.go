@@ -39,6 +39,11 @@ type ContractRequesterMock struct {
 	SendRequestCounter    uint64
 	SendRequestPreCounter uint64
 	SendRequestMock       mContractRequesterMockSendRequest
+
+	SimulateFunc       func(p context.Context, p1 insolar.Message) (r insolar.Reply, r1 error)
+	SimulateCounter    uint64
+	SimulatePreCounter uint64
+	SimulateMock       mContractRequesterMockSimulate
 }
 
 //NewContractRequesterMock returns a mock for github.com/insolar/insolar/insolar.ContractRequester
@@ -53,6 +58,7 @@ func NewContractRequesterMock(t minimock.Tester) *ContractRequesterMock {
 	m.CallConstructorMock = mContractRequesterMockCallConstructor{mock: m}
 	m.CallMethodMock = mContractRequesterMockCallMethod{mock: m}
 	m.SendRequestMock = mContractRequesterMockSendRequest{mock: m}
+	m.SimulateMock = mContractRequesterMockSimulate{mock: m}
 
 	return m
 }
@@ -663,6 +669,157 @@ func (m *ContractRequesterMock) SendRequestFinished() bool {
 	return true
 }
 
+type mContractRequesterMockSimulate struct {
+	mock              *ContractRequesterMock
+	mainExpectation   *ContractRequesterMockSimulateExpectation
+	expectationSeries []*ContractRequesterMockSimulateExpectation
+}
+
+type ContractRequesterMockSimulateExpectation struct {
+	input  *ContractRequesterMockSimulateInput
+	result *ContractRequesterMockSimulateResult
+}
+
+type ContractRequesterMockSimulateInput struct {
+	p  context.Context
+	p1 insolar.Message
+}
+
+type ContractRequesterMockSimulateResult struct {
+	r  insolar.Reply
+	r1 error
+}
+
+//Expect specifies that invocation of ContractRequester.Simulate is expected from 1 to Infinity times
+func (m *mContractRequesterMockSimulate) Expect(p context.Context, p1 insolar.Message) *mContractRequesterMockSimulate {
+	m.mock.SimulateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ContractRequesterMockSimulateExpectation{}
+	}
+	m.mainExpectation.input = &ContractRequesterMockSimulateInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of ContractRequester.Simulate
+func (m *mContractRequesterMockSimulate) Return(r insolar.Reply, r1 error) *ContractRequesterMock {
+	m.mock.SimulateFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ContractRequesterMockSimulateExpectation{}
+	}
+	m.mainExpectation.result = &ContractRequesterMockSimulateResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of ContractRequester.Simulate is expected once
+func (m *mContractRequesterMockSimulate) ExpectOnce(p context.Context, p1 insolar.Message) *ContractRequesterMockSimulateExpectation {
+	m.mock.SimulateFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ContractRequesterMockSimulateExpectation{}
+	expectation.input = &ContractRequesterMockSimulateInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ContractRequesterMockSimulateExpectation) Return(r insolar.Reply, r1 error) {
+	e.result = &ContractRequesterMockSimulateResult{r, r1}
+}
+
+//Set uses given function f as a mock of ContractRequester.Simulate method
+func (m *mContractRequesterMockSimulate) Set(f func(p context.Context, p1 insolar.Message) (r insolar.Reply, r1 error)) *ContractRequesterMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.SimulateFunc = f
+	return m.mock
+}
+
+//Simulate implements github.com/insolar/insolar/insolar.ContractRequester interface
+func (m *ContractRequesterMock) Simulate(p context.Context, p1 insolar.Message) (r insolar.Reply, r1 error) {
+	counter := atomic.AddUint64(&m.SimulatePreCounter, 1)
+	defer atomic.AddUint64(&m.SimulateCounter, 1)
+
+	if len(m.SimulateMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.SimulateMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ContractRequesterMock.Simulate. %v %v", p, p1)
+			return
+		}
+
+		input := m.SimulateMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ContractRequesterMockSimulateInput{p, p1}, "ContractRequester.Simulate got unexpected parameters")
+
+		result := m.SimulateMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ContractRequesterMock.Simulate")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.SimulateMock.mainExpectation != nil {
+
+		input := m.SimulateMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ContractRequesterMockSimulateInput{p, p1}, "ContractRequester.Simulate got unexpected parameters")
+		}
+
+		result := m.SimulateMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ContractRequesterMock.Simulate")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.SimulateFunc == nil {
+		m.t.Fatalf("Unexpected call to ContractRequesterMock.Simulate. %v %v", p, p1)
+		return
+	}
+
+	return m.SimulateFunc(p, p1)
+}
+
+//SimulateMinimockCounter returns a count of ContractRequesterMock.SimulateFunc invocations
+func (m *ContractRequesterMock) SimulateMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.SimulateCounter)
+}
+
+//SimulateMinimockPreCounter returns the value of ContractRequesterMock.Simulate invocations
+func (m *ContractRequesterMock) SimulateMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.SimulatePreCounter)
+}
+
+//SimulateFinished returns true if mock invocations count is ok
+func (m *ContractRequesterMock) SimulateFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.SimulateMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.SimulateCounter) == uint64(len(m.SimulateMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.SimulateMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.SimulateCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.SimulateFunc != nil {
+		return atomic.LoadUint64(&m.SimulateCounter) > 0
+	}
+
+	return true
+}
+
 //ValidateCallCounters checks that all mocked methods of the interface have been called at least once
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *ContractRequesterMock) ValidateCallCounters() {
@@ -683,6 +840,10 @@ func (m *ContractRequesterMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ContractRequesterMock.SendRequest")
 	}
 
+	if !m.SimulateFinished() {
+		m.t.Fatal("Expected call to ContractRequesterMock.Simulate")
+	}
+
 }
 
 //CheckMocksCalled checks that all mocked methods of the interface have been called at least once
@@ -716,6 +877,10 @@ func (m *ContractRequesterMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ContractRequesterMock.SendRequest")
 	}
 
+	if !m.SimulateFinished() {
+		m.t.Fatal("Expected call to ContractRequesterMock.Simulate")
+	}
+
 }
 
 //Wait waits for all mocked methods to be called at least once
@@ -734,6 +899,7 @@ func (m *ContractRequesterMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.CallConstructorFinished()
 		ok = ok && m.CallMethodFinished()
 		ok = ok && m.SendRequestFinished()
+		ok = ok && m.SimulateFinished()
 
 		if ok {
 			return
@@ -758,6 +924,10 @@ func (m *ContractRequesterMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ContractRequesterMock.SendRequest")
 			}
 
+			if !m.SimulateFinished() {
+				m.t.Error("Expected call to ContractRequesterMock.Simulate")
+			}
+
 			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
 			return
 		default:
@@ -786,5 +956,9 @@ func (m *ContractRequesterMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.SimulateFinished() {
+		return false
+	}
+
 	return true
 }
@@ -58,6 +58,11 @@ type HostNetworkMock struct {
 	SendRequestToHostPreCounter uint64
 	SendRequestToHostMock       mHostNetworkMockSendRequestToHost
 
+	SetProtocolFunc       func(p insolar.Reference, p1 uint32)
+	SetProtocolCounter    uint64
+	SetProtocolPreCounter uint64
+	SetProtocolMock       mHostNetworkMockSetProtocol
+
 	StartFunc       func(p context.Context) (r error)
 	StartCounter    uint64
 	StartPreCounter uint64
@@ -84,6 +89,7 @@ func NewHostNetworkMock(t minimock.Tester) *HostNetworkMock {
 	m.RegisterRequestHandlerMock = mHostNetworkMockRegisterRequestHandler{mock: m}
 	m.SendRequestMock = mHostNetworkMockSendRequest{mock: m}
 	m.SendRequestToHostMock = mHostNetworkMockSendRequestToHost{mock: m}
+	m.SetProtocolMock = mHostNetworkMockSetProtocol{mock: m}
 	m.StartMock = mHostNetworkMockStart{mock: m}
 	m.StopMock = mHostNetworkMockStop{mock: m}
 
@@ -1082,6 +1088,130 @@ func (m *HostNetworkMock) SendRequestToHostFinished() bool {
 	return true
 }
 
+type mHostNetworkMockSetProtocol struct {
+	mock              *HostNetworkMock
+	mainExpectation   *HostNetworkMockSetProtocolExpectation
+	expectationSeries []*HostNetworkMockSetProtocolExpectation
+}
+
+type HostNetworkMockSetProtocolExpectation struct {
+	input  *HostNetworkMockSetProtocolInput
+}
+
+type HostNetworkMockSetProtocolInput struct {
+	p  insolar.Reference
+	p1 uint32
+}
+
+//Expect specifies that invocation of HostNetwork.SetProtocol is expected from 1 to Infinity times
+func (m *mHostNetworkMockSetProtocol) Expect(p insolar.Reference, p1 uint32) *mHostNetworkMockSetProtocol {
+	m.mock.SetProtocolFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &HostNetworkMockSetProtocolExpectation{}
+	}
+	m.mainExpectation.input = &HostNetworkMockSetProtocolInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of HostNetwork.SetProtocol
+func (m *mHostNetworkMockSetProtocol) Return() *HostNetworkMock {
+	m.mock.SetProtocolFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &HostNetworkMockSetProtocolExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of HostNetwork.SetProtocol is expected once
+func (m *mHostNetworkMockSetProtocol) ExpectOnce(p insolar.Reference, p1 uint32) *HostNetworkMockSetProtocolExpectation {
+	m.mock.SetProtocolFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &HostNetworkMockSetProtocolExpectation{}
+	expectation.input = &HostNetworkMockSetProtocolInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of HostNetwork.SetProtocol method
+func (m *mHostNetworkMockSetProtocol) Set(f func(p insolar.Reference, p1 uint32)) *HostNetworkMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.SetProtocolFunc = f
+	return m.mock
+}
+
+//SetProtocol implements github.com/insolar/insolar/network.HostNetwork interface
+func (m *HostNetworkMock) SetProtocol(p insolar.Reference, p1 uint32) {
+	counter := atomic.AddUint64(&m.SetProtocolPreCounter, 1)
+	defer atomic.AddUint64(&m.SetProtocolCounter, 1)
+
+	if len(m.SetProtocolMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.SetProtocolMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to HostNetworkMock.SetProtocol. %v %v", p, p1)
+			return
+		}
+
+		input := m.SetProtocolMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, HostNetworkMockSetProtocolInput{p, p1}, "HostNetwork.SetProtocol got unexpected parameters")
+
+		return
+	}
+
+	if m.SetProtocolMock.mainExpectation != nil {
+
+		input := m.SetProtocolMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, HostNetworkMockSetProtocolInput{p, p1}, "HostNetwork.SetProtocol got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.SetProtocolFunc == nil {
+		m.t.Fatalf("Unexpected call to HostNetworkMock.SetProtocol. %v %v", p, p1)
+		return
+	}
+
+	m.SetProtocolFunc(p, p1)
+}
+
+//SetProtocolMinimockCounter returns a count of HostNetworkMock.SetProtocolFunc invocations
+func (m *HostNetworkMock) SetProtocolMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.SetProtocolCounter)
+}
+
+//SetProtocolMinimockPreCounter returns the value of HostNetworkMock.SetProtocol invocations
+func (m *HostNetworkMock) SetProtocolMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.SetProtocolPreCounter)
+}
+
+//SetProtocolFinished returns true if mock invocations count is ok
+func (m *HostNetworkMock) SetProtocolFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.SetProtocolMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.SetProtocolCounter) == uint64(len(m.SetProtocolMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.SetProtocolMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.SetProtocolCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.SetProtocolFunc != nil {
+		return atomic.LoadUint64(&m.SetProtocolCounter) > 0
+	}
+
+	return true
+}
+
 type mHostNetworkMockStart struct {
 	mock              *HostNetworkMock
 	mainExpectation   *HostNetworkMockStartExpectation
@@ -1408,6 +1538,10 @@ func (m *HostNetworkMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to HostNetworkMock.SendRequestToHost")
 	}
 
+	if !m.SetProtocolFinished() {
+		m.t.Fatal("Expected call to HostNetworkMock.SetProtocol")
+	}
+
 	if !m.StartFinished() {
 		m.t.Fatal("Expected call to HostNetworkMock.Start")
 	}
@@ -1461,6 +1595,10 @@ func (m *HostNetworkMock) MinimockFinish() {
 		m.t.Fatal("Expected call to HostNetworkMock.SendRequestToHost")
 	}
 
+	if !m.SetProtocolFinished() {
+		m.t.Fatal("Expected call to HostNetworkMock.SetProtocol")
+	}
+
 	if !m.StartFinished() {
 		m.t.Fatal("Expected call to HostNetworkMock.Start")
 	}
@@ -1490,6 +1628,7 @@ func (m *HostNetworkMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.RegisterRequestHandlerFinished()
 		ok = ok && m.SendRequestFinished()
 		ok = ok && m.SendRequestToHostFinished()
+		ok = ok && m.SetProtocolFinished()
 		ok = ok && m.StartFinished()
 		ok = ok && m.StopFinished()
 
@@ -1528,6 +1667,10 @@ func (m *HostNetworkMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to HostNetworkMock.SendRequestToHost")
 			}
 
+			if !m.SetProtocolFinished() {
+				m.t.Error("Expected call to HostNetworkMock.SetProtocol")
+			}
+
 			if !m.StartFinished() {
 				m.t.Error("Expected call to HostNetworkMock.Start")
 			}
@@ -1576,6 +1719,10 @@ func (m *HostNetworkMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.SetProtocolFinished() {
+		return false
+	}
+
 	if !m.StartFinished() {
 		return false
 	}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
 
 	testify_assert "github.com/stretchr/testify/assert"
 )
@@ -25,7 +26,7 @@ type StreamTransportMock struct {
 	AddressPreCounter uint64
 	AddressMock       mStreamTransportMockAddress
 
-	DialFunc       func(p context.Context, p1 string) (r io.ReadWriteCloser, r1 error)
+	DialFunc       func(p context.Context, p1 string, p2 insolar.Reference) (r io.ReadWriteCloser, r1 error)
 	DialCounter    uint64
 	DialPreCounter uint64
 	DialMock       mStreamTransportMockDial
@@ -205,6 +206,7 @@ type StreamTransportMockDialExpectation struct {
 type StreamTransportMockDialInput struct {
 	p  context.Context
 	p1 string
+	p2 insolar.Reference
 }
 
 type StreamTransportMockDialResult struct {
@@ -213,14 +215,14 @@ type StreamTransportMockDialResult struct {
 }
 
 //Expect specifies that invocation of StreamTransport.Dial is expected from 1 to Infinity times
-func (m *mStreamTransportMockDial) Expect(p context.Context, p1 string) *mStreamTransportMockDial {
+func (m *mStreamTransportMockDial) Expect(p context.Context, p1 string, p2 insolar.Reference) *mStreamTransportMockDial {
 	m.mock.DialFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
 		m.mainExpectation = &StreamTransportMockDialExpectation{}
 	}
-	m.mainExpectation.input = &StreamTransportMockDialInput{p, p1}
+	m.mainExpectation.input = &StreamTransportMockDialInput{p, p1, p2}
 	return m
 }
 
@@ -237,12 +239,12 @@ func (m *mStreamTransportMockDial) Return(r io.ReadWriteCloser, r1 error) *Strea
 }
 
 //ExpectOnce specifies that invocation of StreamTransport.Dial is expected once
-func (m *mStreamTransportMockDial) ExpectOnce(p context.Context, p1 string) *StreamTransportMockDialExpectation {
+func (m *mStreamTransportMockDial) ExpectOnce(p context.Context, p1 string, p2 insolar.Reference) *StreamTransportMockDialExpectation {
 	m.mock.DialFunc = nil
 	m.mainExpectation = nil
 
 	expectation := &StreamTransportMockDialExpectation{}
-	expectation.input = &StreamTransportMockDialInput{p, p1}
+	expectation.input = &StreamTransportMockDialInput{p, p1, p2}
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
@@ -252,7 +254,7 @@ func (e *StreamTransportMockDialExpectation) Return(r io.ReadWriteCloser, r1 err
 }
 
 //Set uses given function f as a mock of StreamTransport.Dial method
-func (m *mStreamTransportMockDial) Set(f func(p context.Context, p1 string) (r io.ReadWriteCloser, r1 error)) *StreamTransportMock {
+func (m *mStreamTransportMockDial) Set(f func(p context.Context, p1 string, p2 insolar.Reference) (r io.ReadWriteCloser, r1 error)) *StreamTransportMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
@@ -261,18 +263,18 @@ func (m *mStreamTransportMockDial) Set(f func(p context.Context, p1 string) (r i
 }
 
 //Dial implements github.com/insolar/insolar/network/transport.StreamTransport interface
-func (m *StreamTransportMock) Dial(p context.Context, p1 string) (r io.ReadWriteCloser, r1 error) {
+func (m *StreamTransportMock) Dial(p context.Context, p1 string, p2 insolar.Reference) (r io.ReadWriteCloser, r1 error) {
 	counter := atomic.AddUint64(&m.DialPreCounter, 1)
 	defer atomic.AddUint64(&m.DialCounter, 1)
 
 	if len(m.DialMock.expectationSeries) > 0 {
 		if counter > uint64(len(m.DialMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to StreamTransportMock.Dial. %v %v", p, p1)
+			m.t.Fatalf("Unexpected call to StreamTransportMock.Dial. %v %v %v", p, p1, p2)
 			return
 		}
 
 		input := m.DialMock.expectationSeries[counter-1].input
-		testify_assert.Equal(m.t, *input, StreamTransportMockDialInput{p, p1}, "StreamTransport.Dial got unexpected parameters")
+		testify_assert.Equal(m.t, *input, StreamTransportMockDialInput{p, p1, p2}, "StreamTransport.Dial got unexpected parameters")
 
 		result := m.DialMock.expectationSeries[counter-1].result
 		if result == nil {
@@ -290,7 +292,7 @@ func (m *StreamTransportMock) Dial(p context.Context, p1 string) (r io.ReadWrite
 
 		input := m.DialMock.mainExpectation.input
 		if input != nil {
-			testify_assert.Equal(m.t, *input, StreamTransportMockDialInput{p, p1}, "StreamTransport.Dial got unexpected parameters")
+			testify_assert.Equal(m.t, *input, StreamTransportMockDialInput{p, p1, p2}, "StreamTransport.Dial got unexpected parameters")
 		}
 
 		result := m.DialMock.mainExpectation.result
@@ -305,11 +307,11 @@ func (m *StreamTransportMock) Dial(p context.Context, p1 string) (r io.ReadWrite
 	}
 
 	if m.DialFunc == nil {
-		m.t.Fatalf("Unexpected call to StreamTransportMock.Dial. %v %v", p, p1)
+		m.t.Fatalf("Unexpected call to StreamTransportMock.Dial. %v %v %v", p, p1, p2)
 		return
 	}
 
-	return m.DialFunc(p, p1)
+	return m.DialFunc(p, p1, p2)
 }
 
 //DialMinimockCounter returns a count of StreamTransportMock.DialFunc invocations